@@ -0,0 +1,104 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The synchronization state of the device group of a client, by client
+var autoConfigSyncGroups sync.Map
+
+// Synchronizations of the device groups would overlap with each other
+var autoConfigSyncLock sync.Mutex
+
+// autoConfigSyncState coalesces the synchronizations of a device group. Writes are numbered, and a write finished
+// before a synchronization starts is covered by it, so the writes finishing while a synchronization runs share the
+// next one instead of each running its own.
+type autoConfigSyncState struct {
+	group   string
+	mu      sync.Mutex
+	written uint64
+	synced  uint64
+	err     error
+}
+
+// Resources whose writes do not change the configuration synchronized to the device group, or synchronize it
+var autoConfigSyncExcluded = map[string]bool{
+	"bigip_cm_config_sync":            true,
+	"bigip_cm_traffic_group_failover": true,
+	"bigip_sys_ucs":                   true,
+}
+
+// syncAfterWrite pushes the configuration of the device to its device group when the client synchronizes its
+// changes, unless a synchronization started after the write already did. The error of the synchronization covering
+// the write is returned, so that the apply fails rather than leaving the standby units drifting.
+func syncAfterWrite(client *bigip.BigIP) error {
+	v, ok := autoConfigSyncGroups.Load(client)
+	if !ok {
+		return nil
+	}
+	state := v.(*autoConfigSyncState)
+	state.mu.Lock()
+	state.written++
+	write := state.written
+	state.mu.Unlock()
+
+	// Only one synchronization runs at a time, the writes waiting for it are covered by the next one
+	autoConfigSyncLock.Lock()
+	defer autoConfigSyncLock.Unlock()
+	state.mu.Lock()
+	if state.synced >= write {
+		err := state.err
+		state.mu.Unlock()
+		return err
+	}
+	covered := state.written
+	state.mu.Unlock()
+
+	log.Printf("[INFO] Synchronizing the changes of %s to device group %s", client.Host, state.group)
+	err := runConfigSync(client, state.group, "to-group", false)
+	if err != nil {
+		err = fmt.Errorf("The changes of %s were applied but not synchronized to device group %s: %s", client.Host, state.group, err)
+	}
+	state.mu.Lock()
+	state.synced, state.err = covered, err
+	state.mu.Unlock()
+	return err
+}
+
+// autoConfigSync wraps the Create, Update and Delete functions of a resource with the synchronization of the
+// device group once they succeed, so the standby units do not drift from the active unit. A failed synchronization
+// fails the change: an object created is then tainted, updates and deletions are planned again.
+func autoConfigSync(resourceType string, r *schema.Resource) {
+	if autoConfigSyncExcluded[resourceType] {
+		return
+	}
+	withSync := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+			return syncAfterWrite(meta.(*bigip.BigIP))
+		}
+	}
+	r.Create = withSync(r.Create)
+	r.Update = withSync(r.Update)
+	r.Delete = withSync(r.Delete)
+}
+
+func enableAutoConfigSync(client *bigip.BigIP, group string) {
+	log.Printf("[DEBUG] Synchronizing the changes of %s to device group %s", client.Host, group)
+	autoConfigSyncGroups.Store(client, &autoConfigSyncState{group: group})
+}
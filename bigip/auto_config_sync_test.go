@@ -0,0 +1,127 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoConfigSyncAfterWrite(t *testing.T) {
+	var synced []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/config-sync", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		synced = append(synced, string(b))
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	r := &schema.Resource{
+		Create: func(*schema.ResourceData, interface{}) error { return nil },
+		Delete: func(*schema.ResourceData, interface{}) error { return fmt.Errorf("failed") },
+	}
+	autoConfigSync("bigip_ltm_pool", r)
+
+	assert.Nil(t, r.Create(nil, client))
+	assert.Empty(t, synced, "clients without a device group are not synchronized")
+
+	enableAutoConfigSync(client, "/Common/failover-group")
+	defer autoConfigSyncGroups.Delete(client)
+	assert.Nil(t, r.Create(nil, client))
+	assert.NotNil(t, r.Delete(nil, client))
+	assert.Nil(t, r.Update)
+	if assert.Len(t, synced, 1, "failed writes are not synchronized") {
+		assert.JSONEq(t, `{"command":"run","utilCmdArgs":"to-group /Common/failover-group"}`, synced[0])
+	}
+}
+
+func TestAutoConfigSyncFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/config-sync", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprintf(w, `{"code":400,"message":"device group not found"}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	r := &schema.Resource{Update: func(*schema.ResourceData, interface{}) error { return nil }}
+	autoConfigSync("bigip_ltm_pool", r)
+	enableAutoConfigSync(client, "/Common/failover-group")
+	defer autoConfigSyncGroups.Delete(client)
+	err := r.Update(nil, client)
+	if assert.NotNil(t, err, "a failed synchronization fails the change") {
+		assert.Contains(t, err.Error(), "were applied but not synchronized to device group /Common/failover-group")
+	}
+}
+
+func TestAutoConfigSyncCoalesced(t *testing.T) {
+	syncs := 0
+	started, release := make(chan bool), make(chan bool)
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/config-sync", func(w http.ResponseWriter, r *http.Request) {
+		syncs++
+		if syncs == 1 {
+			started <- true
+			<-release
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	r := &schema.Resource{Create: func(*schema.ResourceData, interface{}) error { return nil }}
+	autoConfigSync("bigip_ltm_pool", r)
+	enableAutoConfigSync(client, "/Common/failover-group")
+	defer autoConfigSyncGroups.Delete(client)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Nil(t, r.Create(nil, client))
+	}()
+	<-started
+	// The writes finishing while the first synchronization runs share the next one
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, r.Create(nil, client))
+		}()
+	}
+	for {
+		v, _ := autoConfigSyncGroups.Load(client)
+		state := v.(*autoConfigSyncState)
+		state.mu.Lock()
+		written := state.written
+		state.mu.Unlock()
+		if written == 6 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 2, syncs)
+}
+
+func TestAutoConfigSyncExcluded(t *testing.T) {
+	create := func(*schema.ResourceData, interface{}) error { return nil }
+	r := &schema.Resource{Create: create}
+	autoConfigSync("bigip_cm_config_sync", r)
+	assert.Equal(t, fmt.Sprintf("%p", create), fmt.Sprintf("%p", r.Create))
+}
@@ -7,18 +7,44 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/f5devcentral/go-bigip"
 )
 
 type Config struct {
-	Address        string
-	Username       string
-	Password       string
-	LoginReference string
-	ConfigOptions  *bigip.ConfigOptions
+	Address           string
+	Port              int
+	Username          string
+	Password          string
+	LoginReference    string
+	ConfigOptions     *bigip.ConfigOptions
+	RestartTimeout    time.Duration
+	Retries           int
+	RetryMaxDelay     time.Duration
+	AuthTimeout       time.Duration
+	BasicAuthFallback bool
+	DryRun            bool
+	DryRunFile        string
+	Transactions      bool
+	Insecure          bool
+	CaBundle          string
+	ClientCert        string
+	ClientKey         string
+	MaxIdleConns      int
+	KeepAlive         time.Duration
+	Proxy             string
 }
 
 func (c *Config) Client() (*bigip.BigIP, error) {
@@ -27,15 +53,32 @@ func (c *Config) Client() (*bigip.BigIP, error) {
 		log.Println("[INFO] Initializing BigIP connection")
 		var client *bigip.BigIP
 		var err error
+		host := c.host()
 		if c.LoginReference != "" {
-			client, err = bigip.NewTokenSession(c.Address, c.Username, c.Password, c.LoginReference, c.ConfigOptions)
+			client, err = c.tokenSession(host)
+			if err != nil && c.BasicAuthFallback {
+				log.Printf("[WARN] Token authentication to %s failed (%s), falling back to basic authentication", host, err)
+				client, err = c.basicSession(host)
+			}
 			if err != nil {
 				log.Printf("[ERROR] Error creating New Token Session %s ", err)
 				return nil, err
 			}
 
 		} else {
-			client = bigip.NewSession(c.Address, c.Username, c.Password, c.ConfigOptions)
+			client, err = c.basicSession(host)
+			if err != nil {
+				return nil, err
+			}
+		}
+		enableRestartRetry(client, c.RestartTimeout, c.Retries, c.RetryMaxDelay)
+		enableTokenRefresh(client, c.LoginReference)
+		if c.DryRun {
+			if err := enableDryRun(client, c.DryRunFile); err != nil {
+				return nil, err
+			}
+		} else if c.Transactions {
+			enableTransactions(client)
 		}
 		err = c.validateConnection(client)
 		if err == nil {
@@ -46,6 +89,154 @@ func (c *Config) Client() (*bigip.BigIP, error) {
 	return nil, fmt.Errorf("BigIP provider requires address, username and password")
 }
 
+// host returns the address of the device with the port of the management interface, when one is set, e.g.
+// https://10.1.1.10:8443 for a BIG-IP tenant on F5OS
+func (c *Config) host() string {
+	if c.Port == 0 {
+		return c.Address
+	}
+	address := c.Address
+	if !strings.HasPrefix(address, "http") {
+		address = "https://" + address
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return c.Address
+	}
+	u.Host = net.JoinHostPort(u.Hostname(), strconv.Itoa(c.Port))
+	return u.String()
+}
+
+// tokenSession logs in with the login reference, allowing AuthTimeout for the login request since remote
+// authentication, e.g. on an F5OS tenant, can take much longer than other requests
+func (c *Config) tokenSession(host string) (*bigip.BigIP, error) {
+	loginOptions := c.ConfigOptions
+	if c.AuthTimeout > 0 {
+		loginOptions = &bigip.ConfigOptions{APICallTimeout: c.AuthTimeout}
+	}
+	client := bigip.NewSession(host, c.Username, c.Password, loginOptions)
+	if err := c.configureTransport(client); err != nil {
+		return nil, err
+	}
+	token, err := login(client, c.LoginReference)
+	if err != nil {
+		return nil, err
+	}
+	client.Token = token
+	client.ConfigOptions = c.ConfigOptions
+	if client.ConfigOptions == nil {
+		client.ConfigOptions = &bigip.ConfigOptions{APICallTimeout: DEFAULT_API_TIMEOUT}
+	}
+	return client, nil
+}
+
+// basicSession returns a session authenticating every request with the username and password
+func (c *Config) basicSession(host string) (*bigip.BigIP, error) {
+	client := bigip.NewSession(host, c.Username, c.Password, c.ConfigOptions)
+	if err := c.configureTransport(client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// configureTransport sets the proxy, the connection pool and the TLS settings of the transport of a client,
+// before its first request. Without a proxy, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables apply.
+func (c *Config) configureTransport(client *bigip.BigIP) error {
+	transport := client.Transport
+	transport.Proxy = http.ProxyFromEnvironment
+	if c.Proxy != "" {
+		u, err := url.Parse(c.Proxy)
+		if err != nil {
+			return fmt.Errorf("Error parsing proxy: %s", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5" {
+			return fmt.Errorf("proxy %s must be an http, https or socks5 URL", c.Proxy)
+		}
+		log.Printf("[DEBUG] Connecting to %s through %s", client.Host, u.Redacted())
+		transport.Proxy = http.ProxyURL(u)
+	}
+	// Every request goes to the same host, so all idle connections may be kept for it
+	if c.MaxIdleConns > 0 {
+		transport.MaxIdleConns = c.MaxIdleConns
+		transport.MaxIdleConnsPerHost = c.MaxIdleConns
+	}
+	keepAlive := c.KeepAlive
+	if keepAlive == 0 {
+		// A zero KeepAlive of a net.Dialer is the default period, disabling takes a negative one
+		keepAlive = -1
+	}
+	transport.DialContext = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: keepAlive}).DialContext
+	return c.configureTLS(client)
+}
+
+// configureTLS sets how the certificate of the device is verified, and the client certificate presented to
+// it. It must be called before the first request of the client, the login of a token session included.
+func (c *Config) configureTLS(client *bigip.BigIP) error {
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.Insecure}
+	if c.Insecure {
+		log.Printf("[WARN] The certificate of %s is not verified", client.Host)
+	}
+	if c.CaBundle != "" {
+		pem, err := ioutil.ReadFile(c.CaBundle)
+		if err != nil {
+			return fmt.Errorf("Error reading CA bundle: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("CA bundle %s has no PEM encoded certificate", c.CaBundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.ClientCert != "" || c.ClientKey != "" {
+		if c.ClientCert == "" || c.ClientKey == "" {
+			return fmt.Errorf("client_cert and client_key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	client.Transport.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// authnLogin is the response of mgmt/shared/authn/login
+type authnLogin struct {
+	Token struct {
+		Token string `json:"token"`
+	} `json:"token"`
+}
+
+// login returns a token of the user of the client, authenticated by loginProvider, e.g. tmos
+func login(client *bigip.BigIP, loginProvider string) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"username":          client.User,
+		"password":          client.Password,
+		"loginProviderName": loginProvider,
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.APICall(&bigip.APIRequest{
+		Method:      "post",
+		URL:         "mgmt/shared/authn/login",
+		Body:        string(body),
+		ContentType: "application/json",
+	})
+	if err != nil {
+		return "", err
+	}
+	var auth authnLogin
+	if err := json.Unmarshal(resp, &auth); err != nil {
+		return "", err
+	}
+	if auth.Token.Token == "" {
+		return "", fmt.Errorf("unable to acquire authentication token")
+	}
+	return auth.Token.Token, nil
+}
+
 func (c *Config) validateConnection(client *bigip.BigIP) error {
 	t, err := client.SelfIPs()
 	if err != nil {
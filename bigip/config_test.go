@@ -0,0 +1,184 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigHost(t *testing.T) {
+	assert.Equal(t, "10.1.1.10", (&Config{Address: "10.1.1.10"}).host())
+	assert.Equal(t, "https://10.1.1.10:8443", (&Config{Address: "10.1.1.10", Port: 8443}).host())
+	assert.Equal(t, "https://bigip.example.com:8443", (&Config{Address: "https://bigip.example.com:443", Port: 8443}).host())
+	assert.Equal(t, "https://[2001:db8::10]:8443", (&Config{Address: "[2001:db8::10]", Port: 8443}).host())
+}
+
+func loginHandler(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `{"code":%d,"message":"Authentication failed."}`, status)
+			return
+		}
+		fmt.Fprintf(w, `{"token":{"token":"XYZ"}}`)
+	}
+}
+
+func TestConfigTokenSession(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/shared/authn/login", loginHandler(http.StatusOK))
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "XYZ", r.Header.Get("X-F5-Auth-Token"))
+		fmt.Fprintf(w, `{}`)
+	})
+
+	c := Config{Address: server.URL, Username: "xxx", Password: "xxx", LoginReference: "tmos", AuthTimeout: 5 * time.Minute}
+	client, err := c.Client()
+	if assert.Nil(t, err) {
+		assert.Equal(t, DEFAULT_API_TIMEOUT, client.ConfigOptions.APICallTimeout, "the auth timeout only applies to the login")
+	}
+}
+
+func TestConfigBasicAuthFallback(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/shared/authn/login", loginHandler(http.StatusUnauthorized))
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		user, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "xxx", user)
+		fmt.Fprintf(w, `{}`)
+	})
+
+	c := Config{Address: server.URL, Username: "xxx", Password: "xxx", LoginReference: "tmos"}
+	_, err := c.Client()
+	assert.NotNil(t, err, "token login failures are returned unless basic_auth_fallback is set")
+
+	c.BasicAuthFallback = true
+	client, err := c.Client()
+	if assert.Nil(t, err) {
+		assert.Equal(t, "", client.Token)
+	}
+}
+
+func TestConfigTokenRefresh(t *testing.T) {
+	logins := 0
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/shared/authn/login", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"username":"xxx","password":"xxx","loginProviderName":"tmos"}`, string(b))
+		logins++
+		fmt.Fprintf(w, `{"token":{"token":"TOKEN%d"}}`, logins)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	var created []string
+	var mu sync.Mutex
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-F5-Auth-Token") != "TOKEN2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintf(w, `{"code":401,"message":"X-F5-Auth-Token has expired."}`)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		created = append(created, string(b))
+		mu.Unlock()
+		fmt.Fprintf(w, `{}`)
+	})
+
+	c := Config{Address: server.URL, Username: "xxx", Password: "xxx", LoginReference: "tmos"}
+	client, err := c.Client()
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Nil(t, postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node"))
+	if assert.Len(t, created, 1) {
+		assert.JSONEq(t, `{"name":"/Common/test-node"}`, created[0], "the refused request is sent again")
+	}
+	// The parallel requests of an apply read the token of the client while it is refreshed
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.Nil(t, postEntity(client, map[string]string{"name": fmt.Sprintf("/Common/node%d", i)}, "ltm", "node"))
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 2, logins, "the token is refreshed once it has expired")
+	assert.Equal(t, "TOKEN1", client.Token, "the client is not modified by the refresh")
+}
+
+func TestConfigTLSVerification(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	}))
+	defer tlsServer.Close()
+
+	c := Config{Address: tlsServer.URL, Username: "xxx", Password: "xxx"}
+	_, err := c.Client()
+	if assert.NotNil(t, err, "the certificate of the device is verified by default") {
+		assert.Contains(t, err.Error(), "certificate")
+	}
+
+	bundle, err := ioutil.TempFile("", "ca-bundle")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.Remove(bundle.Name())
+	pem.Encode(bundle, &pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	bundle.Close()
+	c.CaBundle = bundle.Name()
+	_, err = c.Client()
+	assert.Nil(t, err, "the certificate is verified with the CA bundle")
+
+	c = Config{Address: tlsServer.URL, Username: "xxx", Password: "xxx", Insecure: true}
+	_, err = c.Client()
+	assert.Nil(t, err)
+
+	c.ClientCert = bundle.Name()
+	_, err = c.Client()
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "client_cert and client_key must be set together")
+	}
+}
+
+func TestConfigProxy(t *testing.T) {
+	var hosts []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		fmt.Fprintf(w, `{}`)
+	}))
+	defer proxy.Close()
+
+	c := Config{Address: "http://bigip.invalid", Username: "xxx", Password: "xxx", Proxy: proxy.URL, MaxIdleConns: 20, KeepAlive: 30 * time.Second}
+	client, err := c.Client()
+	if assert.Nil(t, err, "the device is reached through the proxy") {
+		assert.Equal(t, []string{"bigip.invalid"}, hosts)
+		assert.Equal(t, 20, client.Transport.MaxIdleConnsPerHost)
+	}
+
+	c.Proxy = "ftp://proxy.example.com"
+	_, err = c.Client()
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "must be an http, https or socks5 URL")
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type driftReport struct {
+	Drifted bool         `json:"drifted"`
+	Missing []string     `json:"missing"`
+	Drift   []driftEntry `json:"drift"`
+}
+
+type driftEntry struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Property string `json:"property"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+func dataSourceBigipDriftReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipDriftReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"object": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Objects to check and the property values they are expected to have",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Collection of the object, e.g. ltm/pool or gtm/wideip/a",
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the object",
+							ValidateFunc: validateF5Name,
+						},
+						"properties": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Expected values by REST property name, nested properties are joined with a dot",
+						},
+					},
+				},
+			},
+			"drifted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an object is missing or has a property that differs",
+			},
+			"missing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Objects not found on the device, as <type>:<full path>",
+			},
+			"drift": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Properties whose value differs on the device",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"property": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expected": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actual": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The drifted, missing and drift attributes as JSON",
+			},
+		},
+	}
+}
+
+func dataSourceBigipDriftReportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	report := driftReport{Missing: []string{}, Drift: []driftEntry{}}
+	var ids []string
+	for _, o := range d.Get("object").([]interface{}) {
+		object := o.(map[string]interface{})
+		objectType := strings.Trim(object["type"].(string), "/")
+		name := object["name"].(string)
+		ids = append(ids, objectType+":"+name)
+
+		log.Printf("[INFO] Checking %s %s for drift", objectType, name)
+		properties := object["properties"].(map[string]interface{})
+		selected := []string{"name"}
+		for p := range properties {
+			selected = append(selected, strings.SplitN(p, ".", 2)[0])
+		}
+		path := append(strings.Split(objectType, "/"), name+selectQuery(selected...))
+		var actual map[string]interface{}
+		ok, err := getForEntity(client, &actual, path...)
+		if err != nil {
+			return fmt.Errorf("Error retrieving %s (%s): %s", objectType, name, err)
+		}
+		if !ok {
+			report.Missing = append(report.Missing, objectType+":"+name)
+			continue
+		}
+		report.Drift = append(report.Drift, findDrift(objectType, name, properties, actual)...)
+	}
+	report.Drifted = len(report.Missing) > 0 || len(report.Drift) > 0
+
+	b, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	var drift []interface{}
+	for _, e := range report.Drift {
+		drift = append(drift, map[string]interface{}{
+			"type":     e.Type,
+			"name":     e.Name,
+			"property": e.Property,
+			"expected": e.Expected,
+			"actual":   e.Actual,
+		})
+	}
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	d.Set("drifted", report.Drifted)
+	if err := d.Set("missing", report.Missing); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Missing to state for drift report: %s", err)
+	}
+	if err := d.Set("drift", drift); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Drift to state for drift report: %s", err)
+	}
+	d.Set("report", string(b))
+	return nil
+}
+
+// findDrift compares the expected properties of an object with the actual ones, sorted by property name
+func findDrift(objectType, name string, expected map[string]interface{}, actual map[string]interface{}) []driftEntry {
+	properties := make([]string, 0, len(expected))
+	for p := range expected {
+		properties = append(properties, p)
+	}
+	sort.Strings(properties)
+
+	var drift []driftEntry
+	for _, p := range properties {
+		want := strings.TrimSpace(expected[p].(string))
+		got := driftValue(lookupProperty(actual, p))
+		if want != got {
+			drift = append(drift, driftEntry{Type: objectType, Name: name, Property: p, Expected: want, Actual: got})
+		}
+	}
+	return drift
+}
+
+// lookupProperty returns the value of a property of a REST object, nested properties are joined with a dot
+func lookupProperty(object map[string]interface{}, property string) interface{} {
+	var v interface{} = object
+	for _, p := range strings.Split(property, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+// driftValue renders a REST property value the way it is written in configuration
+func driftValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
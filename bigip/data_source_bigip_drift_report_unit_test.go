@@ -0,0 +1,77 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipDriftReport(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_drift_report" "audit" {
+			object {
+				type = "ltm/pool"
+				name = "/Common/test-pool"
+				properties = {
+					loadBalancingMode = "round-robin"
+					monitor = "/Common/http"
+					slowRampTime = "10"
+				}
+			}
+			object {
+				type = "ltm/pool"
+				name = "/Common/test-gone"
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipDriftReport(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		assert.ElementsMatch(t, []string{"name", "loadBalancingMode", "monitor", "slowRampTime"}, strings.Split(r.URL.Query().Get("$select"), ","))
+		fmt.Fprintf(w, `{"name":"test-pool","fullPath":"/Common/test-pool","loadBalancingMode":"least-connections-member","monitor":"/Common/http ","slowRampTime":10}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipDriftReport(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "drifted", "true"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "missing.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "missing.0", "ltm/pool:/Common/test-gone"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "drift.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "drift.0.property", "loadBalancingMode"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "drift.0.expected", "round-robin"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "drift.0.actual", "least-connections-member"),
+					resource.TestCheckResourceAttr("data.bigip_drift_report.audit", "report",
+						`{"drifted":true,"missing":["ltm/pool:/Common/test-gone"],"drift":[{"type":"ltm/pool","name":"/Common/test-pool","property":"loadBalancingMode","expected":"round-robin","actual":"least-connections-member"}]}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// bigip_ltm_pool_health aggregates the monitor status of the members of pools, so that a deployment can check
+// in the same run that the pools it changed are available, waiting for the monitors to mark the members up
+func dataSourceBigipLtmPoolHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipLtmPoolHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"pools": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5Name},
+				Description: "Pools to report on, e.g. /Common/my-pool",
+			},
+			"require_available": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail unless all the pools are available",
+			},
+			"wait_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Seconds to wait for all the pools to become available when require_available is set",
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"all_available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the pools are available",
+			},
+			"health": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health of the pools, in the order of pools",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the pool",
+						},
+						"availability": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Availability of the pool, available, offline or unknown",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the pool is enabled",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Reason of the status of the pool, e.g. The children pool member(s) are down",
+						},
+						"members_total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of members",
+						},
+						"members_up": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of members the monitors found available",
+						},
+						"members_down": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of members the monitors found offline",
+						},
+						"members_unknown": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of members whose availability is unknown, e.g. not monitored",
+						},
+						"down_members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Members found offline, with the reason, e.g. /Common/10.1.1.1:80 (Pool member has been marked down by a monitor)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipLtmPoolHealthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pools := listToStringSlice(d.Get("pools").([]interface{}))
+	log.Printf("[INFO] Reading the health of pools %v", pools)
+
+	health, err := readPoolHealth(client, pools)
+	if err != nil {
+		return err
+	}
+	if unavailable := unavailablePools(health); len(unavailable) > 0 && d.Get("require_available").(bool) {
+		timeout := time.Duration(d.Get("wait_timeout").(int)) * time.Second
+		if timeout == 0 {
+			return fmt.Errorf("pools not available: %s", strings.Join(unavailable, ", "))
+		}
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			var readErr error
+			if health, readErr = readPoolHealth(client, pools); readErr != nil {
+				return resource.NonRetryableError(readErr)
+			}
+			if unavailable := unavailablePools(health); len(unavailable) > 0 {
+				return resource.RetryableError(fmt.Errorf("pools not available: %s", strings.Join(unavailable, ", ")))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strings.Join(pools, ","))
+	d.Set("all_available", len(unavailablePools(health)) == 0)
+	if err := d.Set("health", health); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Health to state for pools (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+// readPoolHealth returns the status of the pools and the monitor status of their members
+func readPoolHealth(client *bigip.BigIP, pools []string) ([]map[string]interface{}, error) {
+	health := make([]map[string]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		var s stats
+		ok, err := getForEntity(client, &s, "ltm", "pool", pool, "stats")
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving the status of pool (%s): %s", pool, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("pool %s not found", pool)
+		}
+		h := map[string]interface{}{"name": pool}
+		for _, e := range s.Entries {
+			h["availability"] = e.NestedStats.Entries["status.availabilityState"].Description
+			h["enabled"] = e.NestedStats.Entries["status.enabledState"].Description == "enabled"
+			h["reason"] = e.NestedStats.Entries["status.statusReason"].Description
+		}
+
+		var members stats
+		if _, err := getForEntity(client, &members, "ltm", "pool", pool, "members", "stats"); err != nil {
+			return nil, fmt.Errorf("Error retrieving the status of the members of pool (%s): %s", pool, err)
+		}
+		up, down, unknown := 0, 0, 0
+		var downMembers []string
+		for _, m := range members.Entries {
+			switch m.NestedStats.Entries["status.availabilityState"].Description {
+			case "available":
+				up++
+			case "offline":
+				down++
+				member := fmt.Sprintf("%s:%d", m.NestedStats.Entries["nodeName"].Description, m.NestedStats.Entries["port"].Value)
+				downMembers = append(downMembers, fmt.Sprintf("%s (%s)", member, m.NestedStats.Entries["status.statusReason"].Description))
+			default:
+				unknown++
+			}
+		}
+		h["members_total"] = len(members.Entries)
+		h["members_up"] = up
+		h["members_down"] = down
+		h["members_unknown"] = unknown
+		sort.Strings(downMembers)
+		h["down_members"] = downMembers
+		health = append(health, h)
+	}
+	return health, nil
+}
+
+// unavailablePools returns the pools of health that are not available, with the reason
+func unavailablePools(health []map[string]interface{}) []string {
+	var unavailable []string
+	for _, h := range health {
+		if h["availability"] != "available" {
+			unavailable = append(unavailable, fmt.Sprintf("%s (%s)", h["name"], h["reason"]))
+		}
+	}
+	return unavailable
+}
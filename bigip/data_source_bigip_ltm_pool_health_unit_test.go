@@ -0,0 +1,101 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipLtmPoolHealth(url string, waitTimeout int) string {
+	return fmt.Sprintf(`
+		data "bigip_ltm_pool_health" "web" {
+			pools = ["/Common/web-pool"]
+			require_available = true
+			wait_timeout = %d
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, waitTimeout, url)
+}
+
+// poolHealthHandlers serve a pool with three members, one of them not monitored, whose second member is
+// marked up once the members were read reads times
+func poolHealthHandlers(reads int) {
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	up := func() bool { return reads <= 0 }
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~web-pool/stats", func(w http.ResponseWriter, r *http.Request) {
+		availability, reason := "available", "The pool is available"
+		if !up() {
+			availability, reason = "offline", "The children pool member(s) are down"
+		}
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/ltm/pool/~Common~web-pool/~Common~web-pool/stats":{"nestedStats":{"entries":{
+			"status.availabilityState":{"description":"%s"},"status.enabledState":{"description":"enabled"},
+			"status.statusReason":{"description":"%s"}}}}}}`, availability, reason)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~web-pool/members/stats", func(w http.ResponseWriter, r *http.Request) {
+		second := `"status.availabilityState":{"description":"offline"},"status.statusReason":{"description":"Pool member has been marked down by a monitor"}`
+		if reads--; up() {
+			second = `"status.availabilityState":{"description":"available"},"status.statusReason":{"description":"Pool member is available"}`
+		}
+		fmt.Fprintf(w, `{"entries":{
+			"https://localhost/mgmt/tm/ltm/pool/~Common~web-pool/members/~Common~10.1.1.1:80/stats":{"nestedStats":{"entries":{
+				"nodeName":{"description":"/Common/10.1.1.1"},"port":{"value":80},"status.availabilityState":{"description":"available"}}}},
+			"https://localhost/mgmt/tm/ltm/pool/~Common~web-pool/members/~Common~10.1.1.2:80/stats":{"nestedStats":{"entries":{
+				"nodeName":{"description":"/Common/10.1.1.2"},"port":{"value":80},%s}}},
+			"https://localhost/mgmt/tm/ltm/pool/~Common~web-pool/members/~Common~10.1.1.3:80/stats":{"nestedStats":{"entries":{
+				"nodeName":{"description":"/Common/10.1.1.3"},"port":{"value":80},"status.availabilityState":{"description":"unknown"}}}}}}`, second)
+	})
+}
+
+func TestAccBigipLtmPoolHealthWait(t *testing.T) {
+	setup()
+	defer teardown()
+	poolHealthHandlers(2)
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmPoolHealth(server.URL, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "all_available", "true"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.name", "/Common/web-pool"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.availability", "available"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.members_total", "3"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.members_up", "2"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.members_down", "0"),
+					resource.TestCheckResourceAttr("data.bigip_ltm_pool_health.web", "health.0.members_unknown", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPoolHealthNotAvailable(t *testing.T) {
+	setup()
+	defer teardown()
+	poolHealthHandlers(1000)
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipLtmPoolHealth(server.URL, 0),
+				ExpectError: regexp.MustCompile(`pools not available: /Common/web-pool \(The children pool member\(s\) are down\)`),
+			},
+		},
+	})
+}
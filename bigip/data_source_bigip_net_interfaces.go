@@ -0,0 +1,119 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type netInterface struct {
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	MediaActive string `json:"mediaActive"`
+	MacAddress  string `json:"macAddress"`
+	Mtu         int    `json:"mtu"`
+}
+
+// bigip_net_interfaces lists the physical interfaces of the BIG-IP, e.g. to check the interfaces a module
+// uses exist on the platform, 1.1 on VE and hardware, eth1 nowhere
+func dataSourceBigipNetInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipNetInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the interfaces",
+			},
+			"interfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Interfaces of the BIG-IP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the interface, e.g. 1.1",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the interface is enabled",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link status, e.g. up, down or uninit",
+						},
+						"media_active": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Media the interface is running at, e.g. 10000SR-FD",
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "MAC address of the interface",
+						},
+						"mtu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "MTU of the interface",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Printf("[INFO] Reading the interfaces of %s", client.Host)
+
+	var items []netInterface
+	if _, err := getCollection(client, &items, "", "net", "interface"); err != nil {
+		return fmt.Errorf("Error retrieving interfaces: %s", err)
+	}
+	var s stats
+	if _, err := getForEntity(client, &s, "net", "interface", "stats"); err != nil {
+		return fmt.Errorf("Error retrieving the status of the interfaces: %s", err)
+	}
+	status := map[string]string{}
+	for _, e := range s.Entries {
+		status[e.NestedStats.Entries["tmName"].Description] = e.NestedStats.Entries["status"].Description
+	}
+
+	var names []string
+	var interfaces []map[string]interface{}
+	for _, i := range items {
+		names = append(names, i.Name)
+		interfaces = append(interfaces, map[string]interface{}{
+			"name":         i.Name,
+			"enabled":      i.Enabled,
+			"status":       status[i.Name],
+			"media_active": i.MediaActive,
+			"mac_address":  i.MacAddress,
+			"mtu":          i.Mtu,
+		})
+	}
+	d.SetId(client.Host)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Names to state for interfaces: %s", err)
+	}
+	if err := d.Set("interfaces", interfaces); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Interfaces to state: %s", err)
+	}
+	return nil
+}
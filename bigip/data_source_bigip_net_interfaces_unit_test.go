@@ -0,0 +1,63 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipNetInterfaces(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_net_interfaces" "all" {}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipNetInterfaces(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/interface", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"name":"1.1","enabled":true,"mediaActive":"10000T-FD","macAddress":"00:50:56:8a:12:34","mtu":1500},
+			{"name":"1.2","enabled":false,"mediaActive":"none","macAddress":"00:50:56:8a:12:35","mtu":9198},
+			{"name":"mgmt","enabled":true,"mediaActive":"1000T-FD","macAddress":"00:50:56:8a:12:30","mtu":1500}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/interface/stats", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"entries":{
+			"https://localhost/mgmt/tm/net/interface/1.1/stats":{"nestedStats":{"entries":{"tmName":{"description":"1.1"},"status":{"description":"up"}}}},
+			"https://localhost/mgmt/tm/net/interface/1.2/stats":{"nestedStats":{"entries":{"tmName":{"description":"1.2"},"status":{"description":"disabled"}}}},
+			"https://localhost/mgmt/tm/net/interface/mgmt/stats":{"nestedStats":{"entries":{"tmName":{"description":"mgmt"},"status":{"description":"up"}}}}}}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipNetInterfaces(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "names.0", "1.1"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "interfaces.0.status", "up"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "interfaces.0.media_active", "10000T-FD"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "interfaces.1.enabled", "false"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "interfaces.1.status", "disabled"),
+					resource.TestCheckResourceAttr("data.bigip_net_interfaces.all", "interfaces.1.mtu", "9198"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type netTrunk struct {
+	Name       string   `json:"name"`
+	Interfaces []string `json:"interfaces"`
+	Lacp       string   `json:"lacp"`
+	LacpMode   string   `json:"lacpMode"`
+	MacAddress string   `json:"macAddress"`
+}
+
+// bigip_net_trunks lists the trunks of the BIG-IP and their member interfaces
+func dataSourceBigipNetTrunks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipNetTrunksRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the trunks",
+			},
+			"trunks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Trunks of the BIG-IP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the trunk",
+						},
+						"interfaces": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Member interfaces of the trunk",
+						},
+						"lacp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether LACP is enabled",
+						},
+						"lacp_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LACP mode, active or passive",
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "MAC address of the trunk",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipNetTrunksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Printf("[INFO] Reading the trunks of %s", client.Host)
+
+	var items []netTrunk
+	if _, err := getCollection(client, &items, "", "net", "trunk"); err != nil {
+		return fmt.Errorf("Error retrieving trunks: %s", err)
+	}
+
+	var names []string
+	var trunks []map[string]interface{}
+	for _, t := range items {
+		names = append(names, t.Name)
+		trunks = append(trunks, map[string]interface{}{
+			"name":        t.Name,
+			"interfaces":  t.Interfaces,
+			"lacp":        t.Lacp,
+			"lacp_mode":   t.LacpMode,
+			"mac_address": t.MacAddress,
+		})
+	}
+	d.SetId(client.Host)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Names to state for trunks: %s", err)
+	}
+	if err := d.Set("trunks", trunks); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Trunks to state: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipNetTrunks(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_net_trunks" "all" {}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipNetTrunks(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/trunk", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"name":"uplink","interfaces":["1.1","1.2"],"lacp":"enabled","lacpMode":"active",
+			"macAddress":"00:94:a1:00:00:01"}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipNetTrunks(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "names.0", "uplink"),
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "trunks.0.interfaces.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "trunks.0.interfaces.1", "1.2"),
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "trunks.0.lacp", "enabled"),
+					resource.TestCheckResourceAttr("data.bigip_net_trunks.all", "trunks.0.lacp_mode", "active"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// objectAudit is the modification metadata of an object. Generation is the configuration generation the object
+// was last modified in, it increases with every change made to the device. LastUpdateMicros is only returned
+// for some objects.
+type objectAudit struct {
+	Type             string `json:"type"`
+	Name             string `json:"name"`
+	Exists           bool   `json:"exists"`
+	Generation       int64  `json:"generation"`
+	LastUpdateMicros int64  `json:"lastUpdateMicros,omitempty"`
+	LastUpdated      string `json:"lastUpdated,omitempty"`
+	Modified         bool   `json:"modified"`
+}
+
+func dataSourceBigipObjectAudit() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipObjectAuditRead,
+
+		Schema: map[string]*schema.Schema{
+			"object": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Objects to report the modification metadata of",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Collection of the object, e.g. ltm/pool or gtm/wideip/a",
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the object",
+							ValidateFunc: validateF5Name,
+						},
+					},
+				},
+			},
+			"since_generation": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Generation the objects modified after are reported as modified, e.g. the highest generation of the last apply",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Modification metadata of the objects, in the order of object",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exists": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"generation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_generation": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Highest generation of the objects, the since_generation of the next audit",
+			},
+			"modified": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Objects modified after since_generation or missing, as <type>:<full path>",
+			},
+			"report": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The objects attribute as JSON",
+			},
+		},
+	}
+}
+
+func dataSourceBigipObjectAuditRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	since := int64(d.Get("since_generation").(int))
+	audits := []objectAudit{}
+	modified := []string{}
+	var ids []string
+	var maxGeneration int64
+	for _, o := range d.Get("object").([]interface{}) {
+		object := o.(map[string]interface{})
+		objectType := strings.Trim(object["type"].(string), "/")
+		name := object["name"].(string)
+		ids = append(ids, objectType+":"+name)
+
+		log.Printf("[INFO] Reading the modification metadata of %s %s", objectType, name)
+		audit, err := getObjectAudit(client, objectType, name)
+		if err != nil {
+			return err
+		}
+		audit.Modified = !audit.Exists || (since > 0 && audit.Generation > since)
+		if audit.Modified {
+			modified = append(modified, objectType+":"+name)
+		}
+		if audit.Generation > maxGeneration {
+			maxGeneration = audit.Generation
+		}
+		audits = append(audits, *audit)
+	}
+
+	b, err := json.Marshal(audits)
+	if err != nil {
+		return err
+	}
+	var objects []interface{}
+	for _, a := range audits {
+		objects = append(objects, map[string]interface{}{
+			"type":         a.Type,
+			"name":         a.Name,
+			"exists":       a.Exists,
+			"generation":   int(a.Generation),
+			"last_updated": a.LastUpdated,
+			"modified":     a.Modified,
+		})
+	}
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Objects to state for object audit: %s", err)
+	}
+	if err := d.Set("modified", modified); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Modified to state for object audit: %s", err)
+	}
+	d.Set("max_generation", int(maxGeneration))
+	d.Set("report", string(b))
+	return nil
+}
+
+// getObjectAudit reads the modification metadata of an object, a missing object is reported rather than failing
+func getObjectAudit(client *bigip.BigIP, objectType, name string) (*objectAudit, error) {
+	var object struct {
+		Generation       int64 `json:"generation"`
+		LastUpdateMicros int64 `json:"lastUpdateMicros"`
+	}
+	path := append(strings.Split(objectType, "/"), name)
+	ok, err := getForEntity(client, &object, path...)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving %s (%s): %s", objectType, name, err)
+	}
+	audit := &objectAudit{Type: objectType, Name: name, Exists: ok}
+	if !ok {
+		return audit, nil
+	}
+	audit.Generation = object.Generation
+	if object.LastUpdateMicros > 0 {
+		audit.LastUpdateMicros = object.LastUpdateMicros
+		audit.LastUpdated = time.Unix(0, object.LastUpdateMicros*int64(time.Microsecond)).UTC().Format(time.RFC3339)
+	}
+	return audit, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipObjectAudit(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_object_audit" "audit" {
+			object {
+				type = "ltm/pool"
+				name = "/Common/test-pool"
+			}
+			object {
+				type = "ltm/virtual"
+				name = "/Common/test-vs"
+			}
+			object {
+				type = "ltm/pool"
+				name = "/Common/test-gone"
+			}
+			since_generation = 120
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipObjectAudit(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-pool", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-pool","fullPath":"/Common/test-pool","generation":118}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-vs","fullPath":"/Common/test-vs","generation":131,"lastUpdateMicros":1571130000000000}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~test-gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipObjectAudit(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.#", "3"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.0.generation", "118"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.0.last_updated", ""),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.0.modified", "false"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.1.generation", "131"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.1.last_updated", "2019-10-15T09:00:00Z"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.1.modified", "true"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "objects.2.exists", "false"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "max_generation", "131"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "modified.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "modified.0", "ltm/virtual:/Common/test-vs"),
+					resource.TestCheckResourceAttr("data.bigip_object_audit.audit", "modified.1", "ltm/pool:/Common/test-gone"),
+				),
+			},
+		},
+	})
+}
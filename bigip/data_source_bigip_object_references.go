@@ -0,0 +1,209 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// referenceCollections are searched for references unless search_in is given
+var referenceCollections = []string{
+	"ltm/virtual",
+	"ltm/pool",
+	"ltm/node",
+	"ltm/policy",
+	"ltm/rule",
+	"ltm/monitor/http",
+	"ltm/monitor/https",
+	"ltm/monitor/tcp",
+	"ltm/profile/http",
+	"ltm/profile/client-ssl",
+	"ltm/profile/server-ssl",
+	"ltm/profile/tcp",
+}
+
+// Properties naming the object itself rather than another one
+var identityProperties = map[string]bool{
+	"name":       true,
+	"partition":  true,
+	"subPath":    true,
+	"fullPath":   true,
+	"selfLink":   true,
+	"kind":       true,
+	"generation": true,
+}
+
+type objectReference struct {
+	Type      string
+	Name      string
+	Attribute string
+}
+
+func dataSourceBigipObjectReferences() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipObjectReferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the object to find the references to, e.g. a pool, monitor or profile",
+				ValidateFunc: validateF5Name,
+			},
+			"search_in": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Collections searched for references, e.g. ltm/virtual or gtm/wideip/a",
+			},
+			"references": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects referencing the object",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection of the referencing object, e.g. ltm/virtual",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the referencing object",
+						},
+						"attribute": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property holding the reference, e.g. pool or profilesReference.items.fullPath",
+						},
+					},
+				},
+			},
+			"referenced_by": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Full paths of the objects referencing the object",
+			},
+		},
+	}
+}
+
+func dataSourceBigipObjectReferencesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	collections := listToStringSlice(d.Get("search_in").([]interface{}))
+	if len(collections) == 0 {
+		collections = referenceCollections
+	}
+
+	log.Printf("[INFO] Searching references to %s in %s", name, strings.Join(collections, ", "))
+
+	references, err := findObjectReferences(client, name, collections)
+	if err != nil {
+		return err
+	}
+
+	var refs []interface{}
+	var names []string
+	seen := map[string]bool{}
+	for _, r := range references {
+		refs = append(refs, map[string]interface{}{
+			"type":      r.Type,
+			"name":      r.Name,
+			"attribute": r.Attribute,
+		})
+		if !seen[r.Name] {
+			seen[r.Name] = true
+			names = append(names, r.Name)
+		}
+	}
+	d.SetId(name)
+	if err := d.Set("references", refs); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving References to state for object (%s): %s", name, err)
+	}
+	if err := d.Set("referenced_by", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ReferencedBy to state for object (%s): %s", name, err)
+	}
+	return nil
+}
+
+// findObjectReferences returns the objects of the given collections with a property naming fullPath,
+// sorted by collection, name and property
+func findObjectReferences(client *bigip.BigIP, fullPath string, collections []string) ([]objectReference, error) {
+	var references []objectReference
+	for _, collection := range collections {
+		var items []map[string]interface{}
+		_, err := getCollection(client, &items, "?expandSubcollections=true", strings.Split(strings.Trim(collection, "/"), "/")...)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving %s: %s", collection, err)
+		}
+		for _, item := range items {
+			itemPath, _ := item["fullPath"].(string)
+			if itemPath == fullPath {
+				continue
+			}
+			attributes := map[string]bool{}
+			for k, v := range item {
+				if !identityProperties[k] {
+					findReferenceAttributes(v, fullPath, k, attributes)
+				}
+			}
+			for attribute := range attributes {
+				references = append(references, objectReference{Type: collection, Name: itemPath, Attribute: attribute})
+			}
+		}
+	}
+	sort.Slice(references, func(i, j int) bool {
+		a, b := references[i], references[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Attribute < b.Attribute
+	})
+	return references, nil
+}
+
+// findReferenceAttributes adds the path of every property below v naming fullPath to attributes, a property
+// names it when it is fullPath or one of its words is, as in monitor rules or iRule code
+func findReferenceAttributes(v interface{}, fullPath, path string, attributes map[string]bool) {
+	switch v := v.(type) {
+	case string:
+		if v == fullPath {
+			attributes[path] = true
+			return
+		}
+		for _, word := range strings.FieldsFunc(v, isReferenceSeparator) {
+			if word == fullPath {
+				attributes[path] = true
+				return
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			findReferenceAttributes(e, fullPath, path, attributes)
+		}
+	case map[string]interface{}:
+		for k, e := range v {
+			findReferenceAttributes(e, fullPath, path+"."+k, attributes)
+		}
+	}
+}
+
+func isReferenceSeparator(r rune) bool {
+	return strings.ContainsRune(" \t\r\n{}[];\"", r)
+}
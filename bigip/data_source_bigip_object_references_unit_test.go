@@ -0,0 +1,122 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipObjectReferences(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_object_references" "test-pool" {
+			name = "/Common/test-pool"
+			search_in = ["ltm/virtual", "ltm/rule", "ltm/pool"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipObjectReferences(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		fmt.Fprintf(w, `{"items":[
+			{"name":"vs1","fullPath":"/Common/vs1","pool":"/Common/test-pool"},
+			{"name":"vs2","fullPath":"/Common/vs2","pool":"/Common/test-pool-2"},
+			{"name":"vs3","fullPath":"/Common/vs3","policiesReference":{"items":[{"name":"test-pool","fullPath":"/Other/test-pool"}]}}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/rule", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"name":"rule1","fullPath":"/Common/rule1","apiAnonymous":"when HTTP_REQUEST {\n pool /Common/test-pool\n}"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/pool", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"name":"test-pool","fullPath":"/Common/test-pool","description":"/Common/test-pool"}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipObjectReferences(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.0.type", "ltm/rule"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.0.name", "/Common/rule1"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.0.attribute", "apiAnonymous"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.1.type", "ltm/virtual"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.1.name", "/Common/vs1"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "references.1.attribute", "pool"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "referenced_by.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipObjectReferencesPaged(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	var skips []string
+	mux.HandleFunc("/mgmt/tm/ltm/virtual", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "500", r.URL.Query().Get("$top"))
+		skip := r.URL.Query().Get("$skip")
+		skips = append(skips, skip)
+		if skip == "0" {
+			fmt.Fprintf(w, `{"items":[{"name":"vs1","fullPath":"/Common/vs1","pool":"/Common/test-pool"}],
+				"nextLink":"https://localhost/mgmt/tm/ltm/virtual?$top=500&$skip=500"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"items":[{"name":"vs501","fullPath":"/Common/vs501","pool":"/Common/test-pool"}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipObjectReferencesPaged(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "referenced_by.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_object_references.test-pool", "referenced_by.1", "/Common/vs501"),
+					func(*terraform.State) error {
+						assert.Contains(t, skips, "500")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testBigipObjectReferencesPaged(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_object_references" "test-pool" {
+			name = "/Common/test-pool"
+			search_in = ["ltm/virtual"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
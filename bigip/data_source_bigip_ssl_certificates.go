@@ -0,0 +1,184 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type sslCertificate struct {
+	FullPath       string `json:"fullPath"`
+	Partition      string `json:"partition"`
+	Subject        string `json:"subject"`
+	Issuer         string `json:"issuer"`
+	SerialNumber   string `json:"serialNumber"`
+	ExpirationDate int64  `json:"expirationDate"`
+}
+
+type clientSslProfileCerts struct {
+	FullPath     string `json:"fullPath"`
+	Cert         string `json:"cert"`
+	Chain        string `json:"chain"`
+	CertKeyChain []struct {
+		Cert  string `json:"cert"`
+		Chain string `json:"chain"`
+	} `json:"certKeyChain"`
+}
+
+// bigip_ssl_certificates lists the certificates installed on the BIG-IP with their expiration, and the client SSL
+// profiles presenting them, so that a pipeline can fail or alert on the certificates of virtual servers expiring
+func dataSourceBigipSslCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipSslCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Partition of the certificates listed, all of them when not set",
+			},
+			"expiring_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Only list the certificates expiring within this number of days, or expired",
+				ValidateFunc: validation.IntBetween(0, 36500),
+			},
+			"in_use": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the certificates of client SSL profiles",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Full paths of the certificates listed",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Certificates listed, by full path",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the certificate, e.g. /Common/www.example.com.crt",
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subject of the certificate",
+						},
+						"issuer": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Issuer of the certificate",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Serial number of the certificate",
+						},
+						"not_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the certificate, in RFC 3339 format",
+						},
+						"days_remaining": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Days until the certificate expires, negative once expired",
+						},
+						"expired": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the certificate is expired",
+						},
+						"client_ssl_profiles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Client SSL profiles presenting the certificate, or its chain",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipSslCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Printf("[INFO] Reading the certificates of %s", client.Host)
+
+	var items []sslCertificate
+	query := selectQuery("fullPath", "partition", "subject", "issuer", "serialNumber", "expirationDate")
+	if _, err := getCollection(client, &items, query, "sys", "file", "ssl-cert"); err != nil {
+		return fmt.Errorf("Error retrieving certificates: %s", err)
+	}
+	var profiles []clientSslProfileCerts
+	query = selectQuery("fullPath", "cert", "chain", "certKeyChain")
+	if _, err := getCollection(client, &profiles, query, uriLtm, uriProfile, "client-ssl"); err != nil {
+		return fmt.Errorf("Error retrieving client SSL profiles: %s", err)
+	}
+	presentedBy := map[string][]string{}
+	for _, p := range profiles {
+		certs := map[string]bool{p.Cert: true, p.Chain: true}
+		for _, c := range p.CertKeyChain {
+			certs[c.Cert], certs[c.Chain] = true, true
+		}
+		for cert := range certs {
+			if cert != "" && cert != "none" {
+				presentedBy[cert] = append(presentedBy[cert], p.FullPath)
+			}
+		}
+	}
+
+	partition := d.Get("partition").(string)
+	within := d.Get("expiring_within_days").(int)
+	inUse := d.Get("in_use").(bool)
+	now := time.Now()
+	names := []string{}
+	certificates := []map[string]interface{}{}
+	for _, c := range items {
+		notAfter := time.Unix(c.ExpirationDate, 0).UTC()
+		days := int(math.Floor(notAfter.Sub(now).Hours() / 24))
+		if partition != "" && c.Partition != partition || within > 0 && days >= within || inUse && len(presentedBy[c.FullPath]) == 0 {
+			continue
+		}
+		profiles := presentedBy[c.FullPath]
+		sort.Strings(profiles)
+		names = append(names, c.FullPath)
+		certificates = append(certificates, map[string]interface{}{
+			"name":                c.FullPath,
+			"subject":             c.Subject,
+			"issuer":              c.Issuer,
+			"serial_number":       c.SerialNumber,
+			"not_after":           notAfter.Format(time.RFC3339),
+			"days_remaining":      days,
+			"expired":             notAfter.Before(now),
+			"client_ssl_profiles": profiles,
+		})
+	}
+	d.SetId(client.Host)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Names to state for certificates: %s", err)
+	}
+	if err := d.Set("certificates", certificates); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Certificates to state: %s", err)
+	}
+	return nil
+}
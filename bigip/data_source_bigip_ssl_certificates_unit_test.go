@@ -0,0 +1,88 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipSslCertificates(url, arguments string) string {
+	return fmt.Sprintf(`
+		data "bigip_ssl_certificates" "certs" {
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, arguments, url)
+}
+
+func TestAccBigipSslCertificates(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"fullPath":"/Common/ca-bundle.crt","partition":"Common","subject":"CN=Root CA","issuer":"CN=Root CA","expirationDate":4102444800},
+			{"fullPath":"/Common/www.crt","partition":"Common","subject":"CN=www.example.com","issuer":"CN=Example CA",
+				"serialNumber":"0a:1b","expirationDate":%d},
+			{"fullPath":"/Tenant/api.crt","partition":"Tenant","subject":"CN=api.example.com","issuer":"CN=Example CA","expirationDate":978307200}]}`,
+			time.Now().Add(10*24*time.Hour+time.Hour).Unix())
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/client-ssl", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"fullPath":"/Common/clientssl","cert":"/Common/default.crt","chain":"none"},
+			{"fullPath":"/Common/www","cert":"/Common/www.crt","chain":"/Common/ca-bundle.crt",
+				"certKeyChain":[{"cert":"/Common/www.crt","chain":"/Common/ca-bundle.crt"}]},
+			{"fullPath":"/Tenant/api","certKeyChain":[{"cert":"/Tenant/api.crt","chain":"/Common/ca-bundle.crt"}]}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSslCertificates(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.0.not_after", "2100-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.0.expired", "false"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.0.client_ssl_profiles.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.0.client_ssl_profiles.0", "/Common/www"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.0.client_ssl_profiles.1", "/Tenant/api"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.1.days_remaining", "10"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.1.serial_number", "0a:1b"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.1.client_ssl_profiles.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.2.expired", "true"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "certificates.2.issuer", "CN=Example CA"),
+				),
+			},
+			{
+				Config: testBigipSslCertificates(server.URL, "expiring_within_days = 30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.0", "/Common/www.crt"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.1", "/Tenant/api.crt"),
+				),
+			},
+			{
+				Config: testBigipSslCertificates(server.URL, "partition = \"Common\"\nexpiring_within_days = 30\nin_use = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.bigip_ssl_certificates.certs", "names.0", "/Common/www.crt"),
+				),
+			},
+		},
+	})
+}
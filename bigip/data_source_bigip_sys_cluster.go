@@ -0,0 +1,163 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type sysCluster struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// sysClusterStats are the statistics of a cluster, the statistics of its members are nested one level deeper
+// than those of the cluster
+type sysClusterStats struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries map[string]struct {
+				statsValue
+				NestedStats stats `json:"nestedStats"`
+			} `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+// bigip_sys_cluster describes the cluster of a VIPRION chassis and the state of its blades, a BIG-IP that is
+// not a chassis has no cluster
+func dataSourceBigipSysCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipSysClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"chassis": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the BIG-IP is a chassis with a cluster of blades",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the cluster",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Floating management address of the cluster",
+			},
+			"primary_slot": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Slot of the primary blade, 0 when there is none",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Blades of the cluster, by slot",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Slot of the blade",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the blade, e.g. running, quorum or failed",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the blade is enabled",
+						},
+						"available": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the blade is available to process traffic",
+						},
+						"licensed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the blade is licensed",
+						},
+						"primary": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the blade is the primary of the cluster",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipSysClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Printf("[INFO] Reading the cluster of %s", client.Host)
+
+	var clusters []sysCluster
+	if _, err := getCollection(client, &clusters, "", "sys", "cluster"); err != nil {
+		return fmt.Errorf("Error retrieving cluster: %s", err)
+	}
+	d.SetId(client.Host)
+	if len(clusters) == 0 {
+		d.Set("chassis", false)
+		d.Set("name", "")
+		d.Set("address", "")
+		d.Set("primary_slot", 0)
+		d.Set("members", nil)
+		return nil
+	}
+	cluster := clusters[0]
+
+	var s sysClusterStats
+	if _, err := getForEntity(client, &s, "sys", "cluster", cluster.Name, "stats"); err != nil {
+		return fmt.Errorf("Error retrieving the status of cluster (%s): %s", cluster.Name, err)
+	}
+	primarySlot := 0
+	var members []map[string]interface{}
+	for _, c := range s.Entries {
+		for _, m := range c.NestedStats.Entries {
+			for _, e := range m.NestedStats.Entries {
+				slot := e.NestedStats.Entries["slotId"].Value
+				if slot == 0 {
+					continue
+				}
+				primary := e.NestedStats.Entries["primary"].Description == "true"
+				if primary {
+					primarySlot = slot
+				}
+				members = append(members, map[string]interface{}{
+					"slot":      slot,
+					"state":     e.NestedStats.Entries["state"].Description,
+					"enabled":   e.NestedStats.Entries["enabled"].Description == "true",
+					"available": e.NestedStats.Entries["available"].Description == "true",
+					"licensed":  e.NestedStats.Entries["licensed"].Description == "true",
+					"primary":   primary,
+				})
+			}
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i]["slot"].(int) < members[j]["slot"].(int) })
+
+	d.Set("chassis", true)
+	d.Set("name", cluster.Name)
+	d.Set("address", cluster.Address)
+	d.Set("primary_slot", primarySlot)
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Members to state for cluster (%s): %s", cluster.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipSysCluster(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_sys_cluster" "chassis" {}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysCluster(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/cluster", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"name":"default","address":"10.0.0.10/24"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/cluster/default/stats", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/cluster/default/stats":{"nestedStats":{"entries":{
+			"clusterName":{"description":"default"},
+			"https://localhost/mgmt/tm/sys/cluster/default/members/stats":{"nestedStats":{"entries":{
+				"https://localhost/mgmt/tm/sys/cluster/default/members/2/stats":{"nestedStats":{"entries":{
+					"slotId":{"value":2},"state":{"description":"running"},"enabled":{"description":"true"},
+					"available":{"description":"true"},"licensed":{"description":"true"},"primary":{"description":"false"}}}},
+				"https://localhost/mgmt/tm/sys/cluster/default/members/1/stats":{"nestedStats":{"entries":{
+					"slotId":{"value":1},"state":{"description":"running"},"enabled":{"description":"true"},
+					"available":{"description":"true"},"licensed":{"description":"true"},"primary":{"description":"true"}}}},
+				"https://localhost/mgmt/tm/sys/cluster/default/members/3/stats":{"nestedStats":{"entries":{
+					"slotId":{"value":3},"state":{"description":"failed"},"enabled":{"description":"false"},
+					"available":{"description":"false"},"licensed":{"description":"true"},"primary":{"description":"false"}}}}}}}}}}}}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysCluster(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "chassis", "true"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "address", "10.0.0.10/24"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "primary_slot", "1"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.#", "3"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.0.slot", "1"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.0.primary", "true"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.2.state", "failed"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.2.available", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysClusterNotChassis(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/cluster", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"kind":"tm:sys:cluster:clustercollectionstate"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysCluster(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "chassis", "false"),
+					resource.TestCheckResourceAttr("data.bigip_sys_cluster.chassis", "members.#", "0"),
+				),
+			},
+		},
+	})
+}
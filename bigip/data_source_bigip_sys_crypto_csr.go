@@ -0,0 +1,125 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_sys_crypto_csr reads a CSR of the BIG-IP, e.g. one generated outside of terraform or by another
+// configuration, with its PEM and subject, so that it can be signed externally
+func dataSourceBigipSysCryptoCsr() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipSysCryptoCsrRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the CSR",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition of the CSR",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the key of the CSR",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Common name of the subject",
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organizational_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Subject alternative names, e.g. DNS:www.example.com",
+			},
+			"csr_pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM of the CSR",
+			},
+		},
+	}
+}
+
+func dataSourceBigipSysCryptoCsrRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := fmt.Sprintf("/%s/%s", d.Get("partition").(string), d.Get("name").(string))
+	log.Println("[INFO] Reading CSR " + name)
+
+	var csr cryptoCsr
+	ok, err := getForEntity(client, &csr, "sys", "crypto", "csr", name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CSR (%s): %s", name, err)
+	}
+	if !ok {
+		return fmt.Errorf("CSR (%s) not found", name)
+	}
+	out, err := runBash(client, "tmsh list sys crypto csr "+name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the PEM of CSR (%s): %s", name, err)
+	}
+	pem := csrPemRegexp.FindString(out)
+	if pem == "" {
+		return fmt.Errorf("CSR (%s) has no PEM: %s", name, strings.TrimSpace(out))
+	}
+
+	sans := []string{}
+	for _, san := range strings.Split(csr.SubjectAlternativeName, ",") {
+		if san = strings.TrimSpace(san); san != "" {
+			sans = append(sans, san)
+		}
+	}
+	d.SetId(name)
+	d.Set("key_name", csr.Key)
+	d.Set("common_name", csr.CommonName)
+	d.Set("organization", csr.Organization)
+	d.Set("organizational_unit", csr.Ou)
+	d.Set("city", csr.City)
+	d.Set("state", csr.State)
+	d.Set("country", csr.Country)
+	d.Set("email_address", csr.EmailAddress)
+	d.Set("subject_alternative_names", sans)
+	d.Set("csr_pem", pem+"\n")
+	return nil
+}
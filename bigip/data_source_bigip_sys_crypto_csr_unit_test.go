@@ -0,0 +1,66 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSysCryptoCsrDataSource(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/crypto/csr/~Tenant~www.example.com", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"www.example.com","partition":"Tenant","key":"/Tenant/www.example.com.key",
+			"commonName":"www.example.com","organization":"Example","country":"US",
+			"subjectAlternativeName":"DNS:www.example.com, DNS:example.com"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "-c 'tmsh list sys crypto csr /Tenant/www.example.com'", body["utilCmdArgs"])
+		out, _ := json.Marshal(map[string]string{"commandResult": "sys crypto csr /Tenant/www.example.com {\n}\n" + testCsrPem + "\n"})
+		w.Write(out)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bigip_sys_crypto_csr" "www" {
+						name = "www.example.com"
+						partition = "Tenant"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "id", "/Tenant/www.example.com"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "key_name", "/Tenant/www.example.com.key"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "common_name", "www.example.com"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "organization", "Example"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "subject_alternative_names.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "subject_alternative_names.1", "DNS:example.com"),
+					resource.TestCheckResourceAttr("data.bigip_sys_crypto_csr.www", "csr_pem", testCsrPem+"\n"),
+				),
+			},
+		},
+	})
+}
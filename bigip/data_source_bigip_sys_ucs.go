@@ -0,0 +1,111 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_sys_ucs lists the UCS archives saved on the BIG-IP, e.g. to check a bigip_sys_ucs_schedule saved a
+// recent one before a change
+func dataSourceBigipSysUcs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipSysUcsRead,
+
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the archives whose name starts with the prefix are listed",
+			},
+			"archives": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Archives from the oldest to the latest",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the archive",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the archive in bytes",
+						},
+						"created_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date the archive was saved",
+						},
+						"encrypted": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the archive is encrypted",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "BIG-IP version the archive was saved with",
+						},
+					},
+				},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the latest archive, empty when there is none",
+			},
+		},
+	}
+}
+
+func dataSourceBigipSysUcsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	prefix := d.Get("prefix").(string)
+	log.Printf("[INFO] Reading UCS archives starting with %q", prefix)
+
+	all, err := getUcsArchives(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving UCS archives: %s", err)
+	}
+	var archives []ucsArchive
+	for _, a := range all {
+		if strings.HasPrefix(a.Name, prefix) {
+			archives = append(archives, a)
+		}
+	}
+	sort.SliceStable(archives, func(i, j int) bool {
+		return archives[i].CreatedDate < archives[j].CreatedDate
+	})
+
+	var list []map[string]interface{}
+	latest := ""
+	for _, a := range archives {
+		list = append(list, map[string]interface{}{
+			"name":         a.Name,
+			"size":         a.Size,
+			"created_date": a.CreatedDate,
+			"encrypted":    a.Encrypted,
+			"version":      a.Version,
+		})
+		latest = a.Name
+	}
+	d.SetId("ucs/" + prefix)
+	if err := d.Set("archives", list); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Archives to state: %s", err)
+	}
+	d.Set("latest", latest)
+	return nil
+}
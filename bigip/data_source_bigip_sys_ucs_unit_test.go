@@ -0,0 +1,60 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipSysUcsArchives(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_sys_ucs" "nightly" {
+			prefix = "nightly-"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysUcsArchives(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ucs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"apiRawValues":{"filename":"/var/local/ucs/nightly-20190602-020000.ucs","file_size":"2048 (in bytes)",
+				"file_created_date":"2019-06-02T02:00:00Z","encrypted":"yes","version":"14.1.0"}},
+			{"apiRawValues":{"filename":"/var/local/ucs/pre-change.ucs","file_size":"1024 (in bytes)",
+				"file_created_date":"2019-06-03T10:00:00Z","encrypted":"no","version":"14.1.0"}},
+			{"apiRawValues":{"filename":"/var/local/ucs/nightly-20190601-020000.ucs","file_size":"1024 (in bytes)",
+				"file_created_date":"2019-06-01T02:00:00Z","encrypted":"yes","version":"14.1.0"}}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUcsArchives(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_sys_ucs.nightly", "archives.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_sys_ucs.nightly", "archives.0.name", "nightly-20190601-020000.ucs"),
+					resource.TestCheckResourceAttr("data.bigip_sys_ucs.nightly", "archives.1.size", "2048"),
+					resource.TestCheckResourceAttr("data.bigip_sys_ucs.nightly", "archives.1.encrypted", "true"),
+					resource.TestCheckResourceAttr("data.bigip_sys_ucs.nightly", "latest", "nightly-20190602-020000.ucs"),
+				),
+			},
+		},
+	})
+}
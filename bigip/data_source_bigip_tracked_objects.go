@@ -0,0 +1,119 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_tracked_objects lists the objects tagged with a tracking id by track_renames, and flags as orphans those
+// whose tracking id is not one of the resources of the state, e.g. objects left by an interrupted apply
+func dataSourceBigipTrackedObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipTrackedObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"partitions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Partitions the objects are listed in, all when not set",
+			},
+			"known_tracking_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Tracking ids of the resources of the state, the objects tagged with another one are orphans",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects tagged with a tracking id",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection of the object, e.g. ltm/pool",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full path of the object",
+						},
+						"tracking_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"orphaned": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"orphans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Objects whose tracking id is not known, as <type>:<full path>",
+			},
+		},
+	}
+}
+
+func dataSourceBigipTrackedObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	partitions := setToSortedStringSlice(d.Get("partitions").(*schema.Set))
+	log.Printf("[INFO] Listing the objects tagged with a tracking id in %v", partitions)
+	tagged, err := listTaggedObjects(client, partitions)
+	if err != nil {
+		return err
+	}
+	known := trackingIDSet(d.Get("known_tracking_ids").(*schema.Set))
+
+	objects := []interface{}{}
+	orphans := []string{}
+	for _, o := range tagged {
+		objectType := strings.Join(o.Collection, "/")
+		orphaned := !known[o.TrackingID]
+		objects = append(objects, map[string]interface{}{
+			"type":        objectType,
+			"name":        o.FullPath,
+			"tracking_id": o.TrackingID,
+			"orphaned":    orphaned,
+		})
+		if orphaned {
+			orphans = append(orphans, objectType+":"+o.FullPath)
+		}
+	}
+	d.SetId(strconv.Itoa(hashcode.String(client.Host + ":" + strings.Join(partitions, ","))))
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Objects to state for tracked objects: %s", err)
+	}
+	if err := d.Set("orphans", orphans); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Orphans to state for tracked objects: %s", err)
+	}
+	return nil
+}
+
+func trackingIDSet(s *schema.Set) map[string]bool {
+	ids := map[string]bool{}
+	for _, id := range setToStringSlice(s) {
+		ids[id] = true
+	}
+	return ids
+}
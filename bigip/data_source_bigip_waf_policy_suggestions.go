@@ -0,0 +1,168 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type wafSuggestion struct {
+	ID            string  `json:"id"`
+	Action        string  `json:"action"`
+	Description   string  `json:"description"`
+	EntityType    string  `json:"entityType"`
+	LearningScore float64 `json:"learningScore"`
+	Entity        struct {
+		Name string `json:"name"`
+	} `json:"entity"`
+}
+
+func dataSourceBigipWafPolicySuggestions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBigipWafPolicySuggestionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the WAF policy",
+				ValidateFunc: validateF5Name,
+			},
+			"min_learning_score": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Only suggestions with at least this learning score, between 0 and 100, are counted",
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"top": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Number of suggestions listed, those with the highest learning score first",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the policy on the BIG-IP",
+			},
+			"suggestion_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of pending learning suggestions",
+			},
+			"entity_type_counts": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Number of pending learning suggestions by entity type, e.g. url or parameter",
+			},
+			"suggestions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Pending learning suggestions with the highest learning score",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind of entity the suggestion is about, e.g. url or parameter",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the entity the suggestion is about",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Change suggested, e.g. add-or-update",
+						},
+						"learning_score": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Confidence in the suggestion, between 0 and 100",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipWafPolicySuggestionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Reading learning suggestions of WAF policy " + name)
+
+	p, err := getWafPolicy(client, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving WAF policy (%s): %s", name, err)
+	}
+	if p == nil {
+		return fmt.Errorf("WAF policy %s does not exist", name)
+	}
+
+	var all []wafSuggestion
+	_, err = getCollection(client, &all, "", uriAsm, "policies", p.ID, "suggestions")
+	if err != nil {
+		return fmt.Errorf("Error retrieving learning suggestions of WAF policy (%s): %s", name, err)
+	}
+
+	minScore := float64(d.Get("min_learning_score").(int))
+	var suggestions []wafSuggestion
+	counts := map[string]int{}
+	for _, s := range all {
+		if s.LearningScore >= minScore {
+			suggestions = append(suggestions, s)
+			counts[s.EntityType]++
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].LearningScore > suggestions[j].LearningScore
+	})
+
+	var top []interface{}
+	for i, s := range suggestions {
+		if i == d.Get("top").(int) {
+			break
+		}
+		top = append(top, map[string]interface{}{
+			"id":             s.ID,
+			"entity_type":    s.EntityType,
+			"entity_name":    s.Entity.Name,
+			"action":         s.Action,
+			"learning_score": s.LearningScore,
+			"description":    s.Description,
+		})
+	}
+
+	d.SetId(name)
+	d.Set("policy_id", p.ID)
+	d.Set("suggestion_count", len(suggestions))
+	if err := d.Set("entity_type_counts", counts); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving EntityTypeCounts to state for WAF policy (%s): %s", name, err)
+	}
+	if err := d.Set("suggestions", top); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Suggestions to state for WAF policy (%s): %s", name, err)
+	}
+	return nil
+}
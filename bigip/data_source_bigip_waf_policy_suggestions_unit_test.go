@@ -0,0 +1,68 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testBigipWafPolicySuggestions(url string) string {
+	return fmt.Sprintf(`
+		data "bigip_waf_policy_suggestions" "test-waf" {
+			name = "/Common/test-waf"
+			min_learning_score = 50
+			top = 2
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipWafPolicySuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/asm/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"id":"P1","fullPath":"/Common/test-waf"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/policies/P1/suggestions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[
+			{"id":"S1","action":"add-or-update","entityType":"url","entity":{"name":"/login"},"learningScore":60},
+			{"id":"S2","action":"add-or-update","entityType":"parameter","entity":{"name":"user"},"learningScore":100},
+			{"id":"S3","action":"delete","entityType":"url","entity":{"name":"/old"},"learningScore":80},
+			{"id":"S4","action":"add-or-update","entityType":"cookie","entity":{"name":"sid"},"learningScore":10}]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipWafPolicySuggestions(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "policy_id", "P1"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestion_count", "3"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "entity_type_counts.url", "2"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "entity_type_counts.parameter", "1"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestions.#", "2"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestions.0.id", "S2"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestions.0.entity_name", "user"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestions.1.id", "S3"),
+					resource.TestCheckResourceAttr("data.bigip_waf_policy_suggestions.test-waf", "suggestions.1.action", "delete"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+)
+
+// dryRunTransport answers the writes of a client without sending them to the device. Each write is logged
+// with its method, path and body, and appended to a file when one is set, so the literal REST calls of an
+// apply can be reviewed. Reads are sent, and see the objects written before them as if they were applied,
+// so the resources read after a create or update find their object.
+type dryRunTransport struct {
+	next http.RoundTripper
+	out  io.Writer
+
+	mu sync.Mutex
+	// Written objects by path, nil once deleted
+	objects map[string]json.RawMessage
+}
+
+// The dry run transports of clients in dry run mode, by client
+var dryRunClients sync.Map
+
+// dryRunCall is the record of a write appended to the dry run file, one JSON object per line
+type dryRunCall struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+// enableDryRun makes the client report its writes instead of sending them, appending them to file when it is set
+func enableDryRun(client *bigip.BigIP, file string) error {
+	if client.Transport == nil {
+		return fmt.Errorf("dry run is not supported by the connection to %s", client.Host)
+	}
+	t := &dryRunTransport{objects: map[string]json.RawMessage{}}
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		t.out = f
+	}
+	log.Printf("[WARN] Dry run: the changes to %s are reported, not applied", client.Host)
+	dryRunClients.Store(client, t)
+	wrapClientTransport(client, func(next http.RoundTripper) http.RoundTripper {
+		t.next = next
+		return t
+	})
+	return nil
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := req.URL.EscapedPath()
+	// Logging in and refreshing tokens changes nothing on the device
+	if req.Method == http.MethodGet || strings.HasPrefix(path, "/mgmt/shared/authn/") || strings.HasPrefix(path, "/mgmt/shared/authz/") {
+		return t.read(req, path)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+	if err := t.report(req.Method, path, body); err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		body = []byte(`{}`)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch req.Method {
+	case http.MethodPost:
+		if name := dryRunObjectName(body); name != "" {
+			path += "/" + name
+			t.objects[path] = body
+		}
+	case http.MethodPut:
+		t.objects[path] = body
+	case http.MethodPatch:
+		t.objects[path] = mergeJSONObjects(t.objects[path], body)
+	case http.MethodDelete:
+		t.objects[path] = nil
+		return dryRunResponse(req, http.StatusOK, nil), nil
+	}
+	return dryRunResponse(req, http.StatusOK, t.objects[path]), nil
+}
+
+// read sends a read, answering it with the object written at its path when there is one
+func (t *dryRunTransport) read(req *http.Request, path string) (*http.Response, error) {
+	t.mu.Lock()
+	written, ok := t.objects[path]
+	t.mu.Unlock()
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	if written == nil {
+		return dryRunResponse(req, http.StatusNotFound, []byte(`{"code":404,"message":"Object deleted by the dry run"}`)), nil
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	current, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		current = nil
+	}
+	return dryRunResponse(req, http.StatusOK, mergeJSONObjects(current, written)), nil
+}
+
+// The properties whose values are masked in the reports of a dry run, e.g. the password of a user or the secret
+// of an authentication server, and the private keys in PEM format found in any value
+var (
+	dryRunSecretProperty = regexp.MustCompile(`(?i)password|passphrase|secret|presharedkey|bindpw|privatekey|registrationkey|token`)
+	dryRunPrivateKey     = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+const dryRunMask = "***"
+
+// maskSecrets returns the body of a write with the values of its secret properties masked
+func maskSecrets(body []byte) []byte {
+	var v interface{}
+	if json.Unmarshal(body, &v) != nil {
+		return dryRunPrivateKey.ReplaceAll(body, []byte(dryRunMask))
+	}
+	masked, err := json.Marshal(maskSecretValues(v))
+	if err != nil {
+		return body
+	}
+	return masked
+}
+
+func maskSecretValues(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if dryRunSecretProperty.MatchString(k) && value != nil && value != "" {
+				v[k] = dryRunMask
+			} else {
+				v[k] = maskSecretValues(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = maskSecretValues(value)
+		}
+	case string:
+		return dryRunPrivateKey.ReplaceAllString(v, dryRunMask)
+	}
+	return v
+}
+
+func (t *dryRunTransport) report(method, path string, body []byte) error {
+	body = maskSecrets(body)
+	log.Printf("[INFO] Dry run, not sent: %s %s %s", method, path, body)
+	if t.out == nil {
+		return nil
+	}
+	call := dryRunCall{Method: method, Path: path}
+	if json.Valid(body) {
+		call.Body = json.RawMessage(body)
+	} else if len(body) > 0 {
+		call.Body = string(body)
+	}
+	line, err := json.Marshal(call)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err = t.out.Write(append(line, '\n'))
+	return err
+}
+
+// reportDryRun reports a write made for client with another connection, e.g. to a BIG-IQ, when client is in
+// dry run mode. It returns whether the write must be skipped.
+func reportDryRun(client *bigip.BigIP, method, url string, body interface{}) (bool, error) {
+	t, ok := dryRunClients.Load(client)
+	if !ok {
+		return false, nil
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return true, err
+	}
+	return true, t.(*dryRunTransport).report(method, url, b)
+}
+
+// dryRunObjectName returns the name of the object a POST creates, as it appears in its path
+func dryRunObjectName(body []byte) string {
+	var o struct {
+		Name      string `json:"name"`
+		Partition string `json:"partition"`
+	}
+	if json.Unmarshal(body, &o) != nil || o.Name == "" {
+		return ""
+	}
+	if o.Partition != "" && !strings.HasPrefix(o.Name, "/") {
+		o.Name = "/" + o.Partition + "/" + o.Name
+	}
+	return strings.Replace(o.Name, "/", "~", -1)
+}
+
+// mergeJSONObjects returns the JSON object a with the properties of b set on it
+func mergeJSONObjects(a, b []byte) []byte {
+	merged := map[string]json.RawMessage{}
+	json.Unmarshal(a, &merged)
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(b, &properties) != nil {
+		return b
+	}
+	for k, v := range properties {
+		merged[k] = v
+	}
+	out, _ := json.Marshal(merged)
+	return out
+}
+
+func dryRunResponse(req *http.Request, status int, body []byte) *http.Response {
+	if body == nil {
+		body = []byte(`{}`)
+	}
+	return &http.Response{
+		Status:        http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// extraAttributesTarget is the collection holding the object of a resource, and the fields of the object the
+// resource models under an attribute other than their name in snake case, e.g. rules for irules
+type extraAttributesTarget struct {
+	collection func(client *bigip.BigIP, d *schema.ResourceData) ([]string, error)
+	modeled    []string
+}
+
+// staticCollection returns the collection of a resource whose objects are all in the same collection
+func staticCollection(path ...string) func(*bigip.BigIP, *schema.ResourceData) ([]string, error) {
+	return func(*bigip.BigIP, *schema.ResourceData) ([]string, error) {
+		return path, nil
+	}
+}
+
+// The resources accepting extra_attributes_json, by resource type. The other resources don't have the attribute.
+var extraAttributesTargets = map[string]extraAttributesTarget{
+	"bigip_ltm_pool": {
+		collection: staticCollection(uriLtm, "pool"),
+		modeled:    []string{"monitor", "members"},
+	},
+	"bigip_ltm_virtual_server": {
+		collection: staticCollection(uriLtm, "virtual"),
+		modeled:    []string{"rules", "persist", "fallbackPersistence", "vlansDisabled", "enabled", "disabled"},
+	},
+	"bigip_ltm_node": {
+		collection: staticCollection(uriLtm, "node"),
+		modeled:    []string{"session"},
+	},
+	"bigip_ltm_monitor": {
+		collection: func(client *bigip.BigIP, d *schema.ResourceData) ([]string, error) {
+			t, err := getMonitorType(client, d.Get("parent").(string))
+			return []string{uriLtm, "monitor", t}, err
+		},
+		modeled: []string{"recv", "recvDisable"},
+	},
+	"bigip_ltm_profile_http": {
+		collection: staticCollection(uriLtm, uriProfile, "http"),
+	},
+	"bigip_ltm_profile_tcp": {
+		collection: staticCollection(uriLtm, uriProfile, "tcp"),
+		modeled:    []string{"finWait_2Timeout", "finWaitTimeout", "keepAliveInterval"},
+	},
+	"bigip_ltm_profile_fastl4": {
+		collection: staticCollection(uriLtm, uriProfile, "fastl4"),
+		modeled:    []string{"explicitFlowMigration", "hardwareSynCookie", "ipTosToClient", "ipTosToServer", "keepAliveInterval"},
+	},
+	"bigip_ltm_profile_oneconnect": {
+		collection: staticCollection(uriLtm, uriProfile, "one-connect"),
+	},
+	"bigip_ltm_profile_client_ssl": {
+		collection: staticCollection(uriLtm, uriProfile, "client-ssl"),
+		modeled: []string{"options", "certLifespan", "inheritCertkeychain",
+			"sslC3d", "c3dClientFallbackCert", "c3dDropUnknownOcspStatus", "c3dOcsp"},
+	},
+	"bigip_ltm_profile_server_ssl": {
+		collection: staticCollection(uriLtm, uriProfile, "server-ssl"),
+		modeled: []string{"options", "sslC3d", "c3dCaCert", "c3dCaKey", "c3dCaPassphrase", "c3dCertExtensionIncludes",
+			"c3dCertExtensionCustomOids", "c3dCertLifespan"},
+	},
+}
+
+// The fields identifying an object, they can't be set as extra attributes of any resource
+var identityFields = []string{"name", "partition", "fullPath", "kind", "selfLink", "generation"}
+
+var upperCaseRegexp = regexp.MustCompile(`[A-Z]`)
+
+// snakeCase returns the attribute name of a field of an object, e.g. load_balancing_mode for loadBalancingMode
+func snakeCase(field string) string {
+	return upperCaseRegexp.ReplaceAllStringFunc(field, func(s string) string {
+		return "_" + strings.ToLower(s)
+	})
+}
+
+// extraAttributesTransport merges the extra attributes of an object in the body of the requests an operation
+// sends to create it, a POST to its collection, or to modify it, a PUT or PATCH of the object
+type extraAttributesTransport struct {
+	next       http.RoundTripper
+	collection string
+	object     string
+	extra      map[string]interface{}
+
+	mu sync.Mutex
+	// Whether a request of the operation set the extra attributes
+	merged bool
+}
+
+func (t *extraAttributesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	write := req.Method == http.MethodPost && req.URL.Path == t.collection ||
+		(req.Method == http.MethodPut || req.Method == http.MethodPatch) && req.URL.Path == t.object
+	if !write || req.Body == nil {
+		return t.next.RoundTrip(req)
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(b, &body); err == nil && body != nil {
+		for field, value := range t.extra {
+			body[field] = value
+		}
+		if merged, err := json.Marshal(body); err == nil {
+			b = merged
+			t.mu.Lock()
+			t.merged = true
+			t.mu.Unlock()
+		}
+	}
+	req = req.Clone(req.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+	req.ContentLength = int64(len(b))
+	return t.next.RoundTrip(req)
+}
+
+// extraAttributes adds extra_attributes_json to a resource, a JSON object of fields of its object that the
+// resource doesn't model. They are merged in the body of the request creating or modifying the object, patched
+// on the object when the resource doesn't send one, and checked when the object is read: a field set to another
+// value on the device is planned to be set again. A plan fails with the fields that the resource models, they
+// must be set by their attribute. Only the resources of extraAttributesTargets get the attribute.
+func extraAttributes(resourceType string, r *schema.Resource) {
+	target, ok := extraAttributesTargets[resourceType]
+	if !ok {
+		return
+	}
+	r.Schema["extra_attributes_json"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "JSON object of fields of the object the resource doesn't model, sent with the ones it models",
+		ValidateFunc:     validateJSONObject,
+		DiffSuppressFunc: suppressEquivalentJSON,
+	}
+
+	modeled := map[string]bool{}
+	for _, field := range append(append([]string{}, identityFields...), target.modeled...) {
+		modeled[field] = true
+	}
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+		if !d.NewValueKnown("extra_attributes_json") {
+			return nil
+		}
+		var extra map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("extra_attributes_json").(string)), &extra); err != nil {
+			return nil
+		}
+		var conflicts []string
+		for field := range extra {
+			if _, ok := r.Schema[snakeCase(field)]; ok || modeled[field] {
+				conflicts = append(conflicts, field)
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			return fmt.Errorf("extra_attributes_json of %s %s sets fields modeled by its attributes: %s",
+				resourceType, d.Get("name"), strings.Join(conflicts, ", "))
+		}
+		return nil
+	}
+
+	// write runs the create or update of an object named name with its extra attributes
+	write := func(f func(*schema.ResourceData, interface{}) error, d *schema.ResourceData, client *bigip.BigIP, name string) error {
+		var extra map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("extra_attributes_json").(string)), &extra); err != nil || len(extra) == 0 {
+			return f(d, client)
+		}
+		collection, err := target.collection(client, d)
+		if err != nil {
+			return err
+		}
+		t := &extraAttributesTransport{
+			collection: "/mgmt/tm/" + iControlPath(collection),
+			object:     "/mgmt/tm/" + iControlPath(append(collection, name)),
+			extra:      extra,
+		}
+		opClient := newOperationClient(client, false, func(next http.RoundTripper) http.RoundTripper {
+			t.next = next
+			return t
+		})
+		defer operationClients.Delete(opClient)
+		err = f(d, opClient)
+		t.mu.Lock()
+		merged := t.merged
+		t.mu.Unlock()
+		if err != nil || merged || d.Id() == "" {
+			return err
+		}
+		// The resource didn't write the object, e.g. an update of its pool members only
+		log.Printf("[INFO] Patching the extra attributes of %s", d.Id())
+		if err := patchEntity(client, extra, append(collection, d.Id())...); err != nil {
+			return fmt.Errorf("Error patching the extra attributes of %s: %s", d.Id(), err)
+		}
+		return nil
+	}
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		return write(create, d, meta.(*bigip.BigIP), d.Get("name").(string))
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return write(update, d, meta.(*bigip.BigIP), d.Id())
+		}
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		var extra map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("extra_attributes_json").(string)), &extra); err != nil || d.Id() == "" {
+			return nil
+		}
+		client := meta.(*bigip.BigIP)
+		collection, err := target.collection(client, d)
+		if err != nil {
+			return err
+		}
+		var object map[string]interface{}
+		ok, err := getForEntity(client, &object, append(collection, d.Id())...)
+		if err != nil || !ok {
+			return err
+		}
+		// The fields the device doesn't report are kept as configured
+		changed := false
+		for field, value := range extra {
+			if actual, ok := object[field]; ok && !reflect.DeepEqual(actual, value) {
+				log.Printf("[WARN] %s of %s is %v on the device, not %v", field, d.Id(), actual, value)
+				extra[field] = actual
+				changed = true
+			}
+		}
+		if changed {
+			b, err := json.Marshal(extra)
+			if err != nil {
+				return err
+			}
+			d.Set("extra_attributes_json", string(b))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnakeCase(t *testing.T) {
+	data := map[string]string{
+		"loadBalancingMode": "load_balancing_mode",
+		"description":       "description",
+		"finWait_2Timeout":  "fin_wait_2_timeout",
+	}
+
+	for field, expected := range data {
+		assert.Equal(t, expected, snakeCase(field))
+	}
+}
+
+func testBigipExtraAttributesNode(url, extra string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_node" "web" {
+			name = "/Common/web"
+			address = "10.1.1.1"
+			extra_attributes_json = %q
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, extra, url)
+}
+
+func TestAccBigipExtraAttributes(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	var writes []string
+	mux.HandleFunc("/mgmt/tm/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			b, _ := ioutil.ReadAll(r.Body)
+			writes = append(writes, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, b))
+			r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		}
+		objectsHandler(objects).ServeHTTP(w, r)
+	})
+	node := "/mgmt/tm/ltm/node/~Common~web"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipExtraAttributesNode(server.URL, `{"logging":"enabled","ipDscp":46}`),
+				Check: func(*terraform.State) error {
+					assert.Equal(t, "enabled", objects[node]["logging"])
+					assert.Equal(t, float64(46), objects[node]["ipDscp"])
+					// Created with its extra attributes, not patched after
+					if assert.Len(t, writes, 1) {
+						assert.Contains(t, writes[0], "POST /mgmt/tm/ltm/node ")
+						assert.Contains(t, writes[0], `"logging":"enabled"`)
+					}
+					return nil
+				},
+			},
+			{
+				// A field changed on the device is planned to be set again
+				PreConfig: func() {
+					objects[node]["logging"] = "disabled"
+				},
+				Config:             testBigipExtraAttributesNode(server.URL, `{"ipDscp": 46, "logging": "enabled"}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					writes = nil
+				},
+				Config: testBigipExtraAttributesNode(server.URL, `{"ipDscp": 46, "logging": "enabled"}`),
+				Check: func(*terraform.State) error {
+					assert.Equal(t, "enabled", objects[node]["logging"])
+					for _, write := range writes {
+						assert.Contains(t, write, `"logging":"enabled"`, "the extra attributes are set by the update of the node")
+					}
+					return nil
+				},
+			},
+			{
+				Config:      testBigipExtraAttributesNode(server.URL, `{"logging":"enabled","connectionLimit":5,"session":"user-disabled"}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`extra_attributes_json of bigip_ltm_node /Common/web sets fields modeled by its attributes: connectionLimit, session`),
+			},
+		},
+	})
+}
+
+func TestExtraAttributesOnlyOnSupportedResources(t *testing.T) {
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		_, supported := extraAttributesTargets[name]
+		_, ok := r.Schema["extra_attributes_json"]
+		assert.Equal(t, supported, ok, "extra_attributes_json of %s", name)
+	}
+}
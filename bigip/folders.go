@@ -0,0 +1,212 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Clients that create the partition and folders of an object's full path when they are missing,
+// e.g. /Tenant and /Tenant/app1 for /Tenant/app1/my-pool
+var autoCreateFolderClients sync.Map
+
+// ensureFolders creates the partition and folders fullPath lives in, unless they exist
+func ensureFolders(client *bigip.BigIP, fullPath string) error {
+	parts := strings.Split(strings.TrimPrefix(fullPath, "/"), "/")
+	for i := 1; i < len(parts); i++ {
+		folder := "/" + strings.Join(parts[:i], "/")
+		if i == 1 {
+			ok, err := getForEntity(client, &struct{}{}, "auth", "partition", parts[0])
+			if err != nil {
+				return fmt.Errorf("Error retrieving partition (%s): %s", parts[0], err)
+			}
+			if !ok {
+				log.Println("[INFO] Creating partition " + parts[0])
+				err = postEntity(client, map[string]string{"name": parts[0]}, "auth", "partition")
+				if err != nil {
+					return fmt.Errorf("Error creating partition (%s): %s", parts[0], err)
+				}
+			}
+			continue
+		}
+		ok, err := getForEntity(client, &struct{}{}, "sys", "folder", folder)
+		if err != nil {
+			return fmt.Errorf("Error retrieving folder (%s): %s", folder, err)
+		}
+		if !ok {
+			log.Println("[INFO] Creating folder " + folder)
+			err = postEntity(client, map[string]string{"name": folder}, "sys", "folder")
+			if err != nil {
+				return fmt.Errorf("Error creating folder (%s): %s", folder, err)
+			}
+		}
+	}
+	return nil
+}
+
+// autoCreateFolders wraps the Create function of a resource named by full path so that the
+// partition and folders of the name are created first, when the provider is configured to
+func autoCreateFolders(r *schema.Resource) {
+	if s, ok := r.Schema["name"]; !ok || s.Type != schema.TypeString || r.Create == nil {
+		return
+	}
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*bigip.BigIP)
+		name := d.Get("name").(string)
+		if _, ok := autoCreateFolderClients.Load(providerClient(client)); ok && strings.HasPrefix(name, "/") {
+			if err := ensureFolders(client, name); err != nil {
+				return err
+			}
+		}
+		return create(d, meta)
+	}
+}
+
+var (
+	partitionPattern = regexp.MustCompile(`^[\w_\-.]+$`)
+	subPathPattern   = regexp.MustCompile(`^[\w_\-.]+(/[\w_\-.]+)*$`)
+)
+
+// objectFullPath returns the full path of an object named name, with the partition and sub_path of d when name
+// is not a full path
+func objectFullPath(d interface{ Get(string) interface{} }, name string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	partition, subPath := d.Get("partition").(string), d.Get("sub_path").(string)
+	if partition == "" {
+		return name
+	}
+	if subPath != "" {
+		return fmt.Sprintf("/%s/%s/%s", partition, subPath, name)
+	}
+	return fmt.Sprintf("/%s/%s", partition, name)
+}
+
+// partitionAttributes adds the partition and sub_path attributes, the partition and folders of the object, to a
+// resource named by full path. The name may be given without them when they are set, e.g. name web_pool,
+// partition Tenant and sub_path app1 for /Tenant/app1/web_pool. The name read is the full path.
+func partitionAttributes(r *schema.Resource) {
+	s, ok := r.Schema["name"]
+	if !ok || s.Type != schema.TypeString || s.ValidateFunc == nil || r.Create == nil ||
+		reflect.ValueOf(s.ValidateFunc).Pointer() != reflect.ValueOf(validateF5Name).Pointer() {
+		return
+	}
+	if _, ok := r.Schema["partition"]; ok {
+		return
+	}
+	r.Schema["partition"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: func(value interface{}, field string) (ws []string, errors []error) {
+			if !partitionPattern.MatchString(value.(string)) {
+				errors = append(errors, fmt.Errorf("%q must be the name of a partition, e.g. Common", field))
+			}
+			return
+		},
+		Description: "Partition of the object, the name may be given without it when set",
+	}
+	r.Schema["sub_path"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: func(value interface{}, field string) (ws []string, errors []error) {
+			if !subPathPattern.MatchString(value.(string)) {
+				errors = append(errors, fmt.Errorf("%q must be the folders of the object in its partition, e.g. app1 or app1/web", field))
+			}
+			return
+		},
+		Description: "Folders of the object in its partition, the name may be given without them when set with partition",
+	}
+
+	validate := s.ValidateFunc
+	s.ValidateFunc = func(value interface{}, field string) ([]string, []error) {
+		if name, ok := value.(string); ok && partitionPattern.MatchString(name) {
+			// Checked with the partition at plan time
+			return nil, nil
+		}
+		return validate(value, field)
+	}
+	suppress := s.DiffSuppressFunc
+	s.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if old != "" && objectFullPath(d, new) == old {
+			return true
+		}
+		return suppress != nil && suppress(k, old, new, d)
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+		if !d.NewValueKnown("name") {
+			return nil
+		}
+		name := d.Get("name").(string)
+		if !strings.HasPrefix(name, "/") {
+			if d.NewValueKnown("partition") && d.Get("partition").(string) == "" {
+				return fmt.Errorf("%s is not a full path, /Partition/Name, the partition of the object must be set", name)
+			}
+			return nil
+		}
+		partition, subPath, _ := splitF5Path(name)
+		for attr, value := range map[string]string{"partition": partition, "sub_path": subPath} {
+			if d.NewValueKnown(attr) && d.Get(attr).(string) == value {
+				continue
+			}
+			if d.NewValueKnown(attr) && d.HasChange(attr) && d.Get(attr).(string) != "" {
+				return fmt.Errorf("%s of %s is %s, not %s", attr, name, value, d.Get(attr))
+			}
+			if err := d.SetNew(attr, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if name := d.Get("name").(string); !strings.HasPrefix(name, "/") {
+			d.Set("name", objectFullPath(d, name))
+		}
+		err := create(d, meta)
+		setPartitionAttributes(d)
+		return err
+	}
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		err := read(d, meta)
+		setPartitionAttributes(d)
+		return err
+	}
+}
+
+// setPartitionAttributes sets the partition and sub_path attributes of a resource from the full path of its name
+func setPartitionAttributes(d *schema.ResourceData) {
+	if name := d.Get("name").(string); d.Id() != "" && strings.HasPrefix(name, "/") {
+		partition, subPath, _ := splitF5Path(name)
+		d.Set("partition", partition)
+		d.Set("sub_path", subPath)
+	}
+}
+
+func enableAutoCreateFolders(client *bigip.BigIP) {
+	log.Printf("[DEBUG] Creating missing partitions and folders on %s", client.Host)
+	autoCreateFolderClients.Store(client, true)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureFoldersCreatesMissing(t *testing.T) {
+	var created []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/auth/partition/Tenant", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"Tenant"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/folder/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/folder", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		created = append(created, string(b))
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	assert.Nil(t, ensureFolders(client, "/Tenant/app1/web/my-pool"))
+	if assert.Len(t, created, 2) {
+		assert.JSONEq(t, `{"name":"/Tenant/app1"}`, created[0])
+		assert.JSONEq(t, `{"name":"/Tenant/app1/web"}`, created[1])
+	}
+}
+
+func TestEnsureFoldersCreatesPartition(t *testing.T) {
+	var created bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/auth/partition/Tenant", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/auth/partition", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"Tenant"}`, string(b))
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	assert.Nil(t, ensureFolders(client, "/Tenant/my-pool"))
+	assert.True(t, created, "partition was not created")
+}
+
+func testBigipPartitionAttributes(url, node string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_node" "web" {
+			%s
+			address = "10.1.1.1"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, node, url)
+}
+
+func TestAccBigipPartitionAttributes(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/tm/ltm/node", objectsHandler(objects))
+	mux.Handle("/mgmt/tm/ltm/node/", objectsHandler(objects))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipPartitionAttributes(server.URL, `
+					name = "web"
+					partition = "Tenant"
+					sub_path = "app1"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_node.web", "id", "/Tenant/app1/web"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.web", "name", "/Tenant/app1/web"),
+					func(s *terraform.State) error {
+						if _, ok := objects["/mgmt/tm/ltm/node/~Tenant~app1~web"]; !ok {
+							return fmt.Errorf("node created in %v", objects)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The same node by its full path
+				Config: testBigipPartitionAttributes(server.URL, `name = "/Tenant/app1/web"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_node.web", "partition", "Tenant"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.web", "sub_path", "app1"),
+				),
+			},
+			{
+				Config: testBigipPartitionAttributes(server.URL, `
+					name = "/Tenant/app1/web"
+					partition = "Common"
+				`),
+				ExpectError: regexp.MustCompile("partition of /Tenant/app1/web is Tenant, not Common"),
+			},
+			{
+				Config: testBigipPartitionAttributes(server.URL, `name = "/Tenant/app1/web"`) + `
+					resource "bigip_ltm_node" "api" {
+						name = "api"
+						address = "10.1.1.2"
+					}
+				`,
+				ExpectError: regexp.MustCompile("api is not a full path, /Partition/Name, the partition of the object must be set"),
+			},
+		},
+	})
+}
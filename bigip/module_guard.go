@@ -0,0 +1,88 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// requiredModules is the module a resource or data source needs to be provisioned, by type. Without it the
+// REST namespace of its objects does not exist and every request fails with a 404.
+var requiredModules = map[string]string{
+	"bigip_gtm_wideip":                       "gtm",
+	"bigip_gtm_pool":                         "gtm",
+	"bigip_gtm_pool_attachment":              "gtm",
+	"bigip_gtm_datacenter":                   "gtm",
+	"bigip_gtm_server":                       "gtm",
+	"bigip_gtm_region":                       "gtm",
+	"bigip_gtm_topology":                     "gtm",
+	"bigip_gtm_monitor":                      "gtm",
+	"bigip_gtm_zonerunner_view":              "gtm",
+	"bigip_gtm_zonerunner_zone":              "gtm",
+	"bigip_waf_policy":                       "asm",
+	"bigip_waf_policy_suggestions":           "asm",
+	"bigip_ltm_virtual_server_asm_policy":    "asm",
+	"bigip_security_profile_http":            "asm",
+	"bigip_ltm_virtual_server_fraud_profile": "fps",
+	"bigip_ltm_profile_analytics":            "avr",
+}
+
+type provisionedModule struct {
+	client *bigip.BigIP
+	module string
+}
+
+// Modules found provisioned, only they are cached so a module provisioned during the apply is seen
+var provisionedModules sync.Map
+
+// checkModuleProvisioned returns an error naming the module if it is not provisioned on the device
+func checkModuleProvisioned(client *bigip.BigIP, module string) error {
+	key := provisionedModule{providerClient(client), module}
+	if _, ok := provisionedModules.Load(key); ok {
+		return nil
+	}
+	var p sysProvision
+	ok, err := getForEntity(client, &p, "sys", uriProvision, module)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the provisioning of module %s: %s", module, err)
+	}
+	if !ok {
+		return fmt.Errorf("module %s is not available on %s", module, client.Host)
+	}
+	if p.Level == "" || p.Level == "none" {
+		return fmt.Errorf("module %s is not provisioned on %s, provision it with bigip_sys_provision", module, client.Host)
+	}
+	provisionedModules.Store(key, true)
+	return nil
+}
+
+// guardModuleProvisioning wraps the Create, Read and Update functions of a resource or data source needing a
+// module with the provisioning check. The check precedes the reads so that the objects of a module that is
+// no longer provisioned are not removed from the state as if they had been deleted.
+func guardModuleProvisioning(resourceType string, r *schema.Resource) {
+	module, ok := requiredModules[resourceType]
+	if !ok {
+		return
+	}
+	guard := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkModuleProvisioned(meta.(*bigip.BigIP), module); err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+	r.Create = guard(r.Create)
+	r.Read = guard(r.Read)
+	r.Update = guard(r.Update)
+}
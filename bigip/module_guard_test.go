@@ -0,0 +1,86 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// provisionHandler answers the provisioning of a module with the given level
+func provisionHandler(level string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"level":"%s","cpuRatio":0,"diskRatio":0,"memoryRatio":0}`, level)
+	}
+}
+
+func testModuleGuardDatacenter(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_datacenter" "test-dc" {
+			name = "/Common/test-dc"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestModuleGuardRefusesUnprovisionedModule(t *testing.T) {
+	written := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("none"))
+	mux.HandleFunc("/mgmt/tm/gtm/datacenter", func(w http.ResponseWriter, r *http.Request) {
+		written = true
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testModuleGuardDatacenter(server.URL),
+				ExpectError: regexp.MustCompile("module gtm is not provisioned on .*, provision it with bigip_sys_provision"),
+			},
+		},
+	})
+	assert.False(t, written, "A datacenter was created without GTM provisioned")
+}
+
+func TestModuleGuardUnavailableModule(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testModuleGuardDatacenter(server.URL),
+				ExpectError: regexp.MustCompile("module gtm is not available on"),
+			},
+		},
+	})
+}
@@ -7,18 +7,31 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 const DEFAULT_PARTITION = "Common"
 
+// How long a freshly created object may take to become visible to a GET,
+// mcpd can briefly 404 an object right after the POST that created it.
+const READ_AFTER_CREATE_TIMEOUT = 30 * time.Second
+
+// How long a request to the BIG-IP may take unless api_timeout is set
+const DEFAULT_API_TIMEOUT = 60 * time.Second
+
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"address": {
 				Type:        schema.TypeString,
@@ -38,6 +51,12 @@ func Provider() terraform.ResourceProvider {
 				Description: "The user's password",
 				DefaultFunc: schema.EnvDefaultFunc("BIGIP_PASSWORD", nil),
 			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Port of the management interface, e.g. 8443 for a BIG-IP tenant on F5OS when address has none",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_PORT", 0),
+			},
 			"token_auth": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -52,65 +71,345 @@ func Provider() terraform.ResourceProvider {
 				Description: "Login reference for token authentication (see BIG-IP REST docs for details)",
 				DefaultFunc: schema.EnvDefaultFunc("BIGIP_LOGIN_REF", nil),
 			},
+			"auth_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds the token login may take, remote authentication on F5OS tenants can be slow",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_AUTH_TIMEOUT", 0),
+			},
+			"basic_auth_fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use basic authentication when the token login fails",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_BASIC_AUTH_FALLBACK", false),
+			},
+			"api_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds a request to the BigIP may take",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_API_TIMEOUT", int(DEFAULT_API_TIMEOUT/time.Second)),
+			},
+			"max_idle_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Idle connections to the BigIP kept open for the next requests",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_MAX_IDLE_CONNECTIONS", 10),
+			},
+			"keepalive": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds between the TCP keepalive probes of the connections to the BigIP, 0 disables them",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_KEEPALIVE", 30),
+			},
+			"proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the HTTP or SOCKS5 proxy the BigIP is reached through, e.g. http://proxy.example.com:3128 or socks5://127.0.0.1:1080",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_PROXY", ""),
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the verification of the certificate of the BigIP",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_INSECURE", false),
+			},
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File of the PEM encoded CA certificates the certificate of the BigIP is verified with, the ones of the system when empty",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CA_BUNDLE", ""),
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File of the PEM encoded certificate presented to the BigIP",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CLIENT_CERT", ""),
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File of the PEM encoded private key of client_cert",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CLIENT_KEY", ""),
+			},
+			"allow_standby_writes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow changes to a device whose failover state is STANDBY",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_ALLOW_STANDBY_WRITES", false),
+			},
+			"auto_create_folders": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Create the missing partition and folders of resources named /Partition/Folder/Name",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_AUTO_CREATE_FOLDERS", false),
+			},
+			"track_renames": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Tag created pools, virtual servers, nodes and GTM objects with a tracking id to follow renames made outside of terraform",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_TRACK_RENAMES", false),
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Report the REST calls changing the BIG-IP instead of sending them",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_DRY_RUN", false),
+			},
+			"dry_run_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File the REST calls of a dry run are appended to, one JSON object per line",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_DRY_RUN_FILE", ""),
+			},
+			"transactions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply the changes of each resource in an iControl REST transaction of its own, committed when the change succeeds, so that a failed change leaves no partial objects",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_TRANSACTIONS", false),
+			},
+			"check_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail the plan of the resources referencing pools, monitors, iRules, policies, certificates or keys that neither exist nor are managed by the configuration",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CHECK_REFERENCES", false),
+			},
+			"api_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Times a request failing while the REST framework restarts or is busy is retried, 0 disables retrying",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_API_RETRIES", 20),
+			},
+			"api_retry_max_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Most seconds between two attempts of a request, the delay doubles from 1 second",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_API_RETRY_MAX_DELAY", 30),
+			},
+			"config_sync_device_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Device group the configuration is synchronized to after each change, none when empty",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_CONFIG_SYNC_DEVICE_GROUP", ""),
+			},
+			"rest_restart_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds a request is retried while restjavad or restnoded restart, 0 disables retrying",
+				DefaultFunc: schema.EnvDefaultFunc("BIGIP_REST_RESTART_TIMEOUT", 300),
+			},
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"bigip_object_references":      dataSourceBigipObjectReferences(),
+			"bigip_drift_report":           dataSourceBigipDriftReport(),
+			"bigip_waf_policy_suggestions": dataSourceBigipWafPolicySuggestions(),
+			"bigip_sys_ucs":                dataSourceBigipSysUcs(),
+			"bigip_net_interfaces":         dataSourceBigipNetInterfaces(),
+			"bigip_net_trunks":             dataSourceBigipNetTrunks(),
+			"bigip_sys_cluster":            dataSourceBigipSysCluster(),
+			"bigip_ltm_pool_health":        dataSourceBigipLtmPoolHealth(),
+			"bigip_object_audit":           dataSourceBigipObjectAudit(),
+			"bigip_tracked_objects":        dataSourceBigipTrackedObjects(),
+			"bigip_sys_crypto_csr":         dataSourceBigipSysCryptoCsr(),
+			"bigip_ssl_certificates":       dataSourceBigipSslCertificates(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"bigip_cm_device":                       resourceBigipCmDevice(),
-			"bigip_cm_devicegroup":                  resourceBigipCmDevicegroup(),
-			"bigip_net_route":                       resourceBigipNetRoute(),
-			"bigip_net_selfip":                      resourceBigipNetSelfIP(),
-			"bigip_net_vlan":                        resourceBigipNetVlan(),
-			"bigip_ltm_irule":                       resourceBigipLtmIRule(),
-			"bigip_ltm_datagroup":                   resourceBigipLtmDataGroup(),
-			"bigip_ltm_monitor":                     resourceBigipLtmMonitor(),
-			"bigip_ltm_node":                        resourceBigipLtmNode(),
-			"bigip_ltm_pool":                        resourceBigipLtmPool(),
-			"bigip_ltm_pool_attachment":             resourceBigipLtmPoolAttachment(),
-			"bigip_ltm_policy":                      resourceBigipLtmPolicy(),
-			"bigip_ltm_profile_fasthttp":            resourceBigipLtmProfileFasthttp(),
-			"bigip_ltm_profile_fastl4":              resourceBigipLtmProfileFastl4(),
-			"bigip_ltm_profile_http2":               resourceBigipLtmProfileHttp2(),
-			"bigip_ltm_profile_httpcompress":        resourceBigipLtmProfileHttpcompress(),
-			"bigip_ltm_profile_oneconnect":          resourceBigipLtmProfileOneconnect(),
-			"bigip_ltm_profile_tcp":                 resourceBigipLtmProfileTcp(),
-			"bigip_ltm_profile_http":                resourceBigipLtmProfileHttp(),
-			"bigip_ltm_persistence_profile_srcaddr": resourceBigipLtmPersistenceProfileSrcAddr(),
-			"bigip_ltm_persistence_profile_dstaddr": resourceBigipLtmPersistenceProfileDstAddr(),
-			"bigip_ltm_persistence_profile_ssl":     resourceBigipLtmPersistenceProfileSSL(),
-			"bigip_ltm_persistence_profile_cookie":  resourceBigipLtmPersistenceProfileCookie(),
-			"bigip_ltm_profile_server_ssl":          resourceBigipLtmProfileServerSsl(),
-			"bigip_ltm_profile_client_ssl":          resourceBigipLtmProfileClientSsl(),
-			"bigip_ltm_snat":                        resourceBigipLtmSnat(),
-			"bigip_ltm_snatpool":                    resourceBigipLtmSnatpool(),
-			"bigip_ltm_virtual_address":             resourceBigipLtmVirtualAddress(),
-			"bigip_ltm_virtual_server":              resourceBigipLtmVirtualServer(),
-			"bigip_sys_dns":                         resourceBigipSysDns(),
-			"bigip_sys_iapp":                        resourceBigipSysIapp(),
-			"bigip_sys_ntp":                         resourceBigipSysNtp(),
-			"bigip_sys_provision":                   resourceBigipSysProvision(),
-			"bigip_sys_snmp":                        resourceBigipSysSnmp(),
-			"bigip_sys_snmp_traps":                  resourceBigipSysSnmpTraps(),
-			"bigip_sys_bigiplicense":                resourceBigipSysBigiplicense(),
-			"bigip_as3":                             resourceBigipAs3(),
-			"bigip_ssl_certificate":                 resourceBigipSslCertificate(),
-			"bigip_ssl_key":                         resourceBigipSslKey(),
+			"bigip_cm_device":                         resourceBigipCmDevice(),
+			"bigip_cm_devicegroup":                    resourceBigipCmDevicegroup(),
+			"bigip_net_route":                         resourceBigipNetRoute(),
+			"bigip_net_selfip":                        resourceBigipNetSelfIP(),
+			"bigip_net_vlan":                          resourceBigipNetVlan(),
+			"bigip_ltm_irule":                         resourceBigipLtmIRule(),
+			"bigip_ltm_datagroup":                     resourceBigipLtmDataGroup(),
+			"bigip_ltm_monitor":                       resourceBigipLtmMonitor(),
+			"bigip_ltm_node":                          resourceBigipLtmNode(),
+			"bigip_ltm_pool":                          resourceBigipLtmPool(),
+			"bigip_ltm_pool_attachment":               resourceBigipLtmPoolAttachment(),
+			"bigip_ltm_policy":                        resourceBigipLtmPolicy(),
+			"bigip_ltm_profile_fasthttp":              resourceBigipLtmProfileFasthttp(),
+			"bigip_ltm_profile_fastl4":                resourceBigipLtmProfileFastl4(),
+			"bigip_ltm_profile_http2":                 resourceBigipLtmProfileHttp2(),
+			"bigip_ltm_profile_httpcompress":          resourceBigipLtmProfileHttpcompress(),
+			"bigip_ltm_profile_oneconnect":            resourceBigipLtmProfileOneconnect(),
+			"bigip_ltm_profile_web_acceleration":      resourceBigipLtmProfileWebAcceleration(),
+			"bigip_ltm_profile_tcp":                   resourceBigipLtmProfileTcp(),
+			"bigip_ltm_profile_http":                  resourceBigipLtmProfileHttp(),
+			"bigip_ltm_persistence_profile_srcaddr":   resourceBigipLtmPersistenceProfileSrcAddr(),
+			"bigip_ltm_persistence_profile_dstaddr":   resourceBigipLtmPersistenceProfileDstAddr(),
+			"bigip_ltm_persistence_profile_ssl":       resourceBigipLtmPersistenceProfileSSL(),
+			"bigip_ltm_persistence_profile_cookie":    resourceBigipLtmPersistenceProfileCookie(),
+			"bigip_ltm_persistence_profile_universal": resourceBigipLtmPersistenceProfileUniversal(),
+			"bigip_ltm_profile_server_ssl":            resourceBigipLtmProfileServerSsl(),
+			"bigip_ltm_profile_client_ssl":            resourceBigipLtmProfileClientSsl(),
+			"bigip_ltm_snat":                          resourceBigipLtmSnat(),
+			"bigip_ltm_snatpool":                      resourceBigipLtmSnatpool(),
+			"bigip_ltm_virtual_address":               resourceBigipLtmVirtualAddress(),
+			"bigip_ltm_virtual_server":                resourceBigipLtmVirtualServer(),
+			"bigip_sys_dns":                           resourceBigipSysDns(),
+			"bigip_sys_iapp":                          resourceBigipSysIapp(),
+			"bigip_sys_ntp":                           resourceBigipSysNtp(),
+			"bigip_sys_provision":                     resourceBigipSysProvision(),
+			"bigip_sys_snmp":                          resourceBigipSysSnmp(),
+			"bigip_sys_snmp_traps":                    resourceBigipSysSnmpTraps(),
+			"bigip_sys_bigiplicense":                  resourceBigipSysBigiplicense(),
+			"bigip_as3":                               resourceBigipAs3(),
+			"bigip_ssl_certificate":                   resourceBigipSslCertificate(),
+			"bigip_ssl_key":                           resourceBigipSslKey(),
+			"bigip_ltm_profile_stream":                resourceBigipLtmProfileStream(),
+			"bigip_ltm_profile_request_log":           resourceBigipLtmProfileRequestLog(),
+			"bigip_cm_traffic_group_failover":         resourceBigipCmTrafficGroupFailover(),
+			"bigip_ltm_profile_websocket":             resourceBigipLtmProfileWebsocket(),
+			"bigip_ltm_profile_rewrite":               resourceBigipLtmProfileRewrite(),
+			"bigip_ltm_profile_dns":                   resourceBigipLtmProfileDns(),
+			"bigip_ltm_profile_ftp":                   resourceBigipLtmProfileFtp(),
+			"bigip_ltm_profile_sip":                   resourceBigipLtmProfileSip(),
+			"bigip_ltm_profile_radius":                resourceBigipLtmProfileRadius(),
+			"bigip_ltm_virtual_server_asm_policy":     resourceBigipLtmVirtualServerAsmPolicy(),
+			"bigip_ltm_profile_analytics":             resourceBigipLtmProfileAnalytics(),
+			"bigip_sys_crypto_csr":                    resourceBigipSysCryptoCsr(),
+			"bigip_gtm_wideip":                        resourceBigipGtmWideip(),
+			"bigip_ltm_acme_challenge":                resourceBigipLtmAcmeChallenge(),
+			"bigip_gtm_pool":                          resourceBigipGtmPool(),
+			"bigip_gtm_pool_attachment":               resourceBigipGtmPoolAttachment(),
+			"bigip_gtm_datacenter":                    resourceBigipGtmDatacenter(),
+			"bigip_gtm_server":                        resourceBigipGtmServer(),
+			"bigip_gtm_region":                        resourceBigipGtmRegion(),
+			"bigip_gtm_topology":                      resourceBigipGtmTopology(),
+			"bigip_gtm_monitor":                       resourceBigipGtmMonitor(),
+			"bigip_ltm_dns_nameserver":                resourceBigipLtmDnsNameserver(),
+			"bigip_ltm_dns_tsig_key":                  resourceBigipLtmDnsTsigKey(),
+			"bigip_ltm_dns_zone":                      resourceBigipLtmDnsZone(),
+			"bigip_net_routedomain":                   resourceBigipNetRouteDomain(),
+			"bigip_waf_policy":                        resourceBigipWafPolicy(),
+			"bigip_net_tunnel_profile":                resourceBigipNetTunnelProfile(),
+			"bigip_net_tunnel":                        resourceBigipNetTunnel(),
+			"bigip_net_arp":                           resourceBigipNetArp(),
+			"bigip_net_ndp":                           resourceBigipNetNdp(),
+			"bigip_net_packet_filter":                 resourceBigipNetPacketFilter(),
+			"bigip_net_dns_resolver":                  resourceBigipNetDnsResolver(),
+			"bigip_security_profile_http":             resourceBigipSecurityProfileHttp(),
+			"bigip_ltm_virtual_server_fraud_profile":  resourceBigipLtmVirtualServerFraudProfile(),
+			"bigip_sys_snmp_community":                resourceBigipSysSnmpCommunity(),
+			"bigip_sys_snmp_user":                     resourceBigipSysSnmpUser(),
+			"bigip_sys_syslog":                        resourceBigipSysSyslog(),
+			"bigip_sys_log_destination_hsl":           resourceBigipSysLogDestinationHsl(),
+			"bigip_sys_log_destination_syslog":        resourceBigipSysLogDestinationSyslog(),
+			"bigip_sys_log_publisher":                 resourceBigipSysLogPublisher(),
+			"bigip_sys_user":                          resourceBigipSysUser(),
+			"bigip_sys_auth_source":                   resourceBigipSysAuthSource(),
+			"bigip_sys_auth_remote_role_group":        resourceBigipSysAuthRemoteRoleGroup(),
+			"bigip_sys_auth_ldap":                     resourceBigipSysAuthLdap(),
+			"bigip_sys_auth_tacacs":                   resourceBigipSysAuthTacacs(),
+			"bigip_sys_auth_radius_server":            resourceBigipSysAuthRadiusServer(),
+			"bigip_sys_auth_radius":                   resourceBigipSysAuthRadius(),
+			"bigip_sys_license":                       resourceBigipSysLicense(),
+			"bigip_sys_management_ip":                 resourceBigipSysManagementIp(),
+			"bigip_sys_management_route":              resourceBigipSysManagementRoute(),
+			"bigip_net_ipsec_ike_peer":                resourceBigipNetIpsecIkePeer(),
+			"bigip_net_ipsec_policy":                  resourceBigipNetIpsecPolicy(),
+			"bigip_net_ipsec_traffic_selector":        resourceBigipNetIpsecTrafficSelector(),
+			"bigip_ltm_profile_http_proxy_connect":    resourceBigipLtmProfileHttpProxyConnect(),
+			"bigip_sys_global_settings":               resourceBigipSysGlobalSettings(),
+			"bigip_sys_httpd":                         resourceBigipSysHttpd(),
+			"bigip_sys_sshd":                          resourceBigipSysSshd(),
+			"bigip_net_timer_policy":                  resourceBigipNetTimerPolicy(),
+			"bigip_net_service_policy":                resourceBigipNetServicePolicy(),
+			"bigip_sys_ucs":                           resourceBigipSysUcs(),
+			"bigip_sys_ucs_schedule":                  resourceBigipSysUcsSchedule(),
+			"bigip_cm_trust_domain_device":            resourceBigipCmTrustDomainDevice(),
+			"bigip_cm_config_sync":                    resourceBigipCmConfigSync(),
+			"bigip_cm_traffic_group":                  resourceBigipCmTrafficGroup(),
+			"bigip_command":                           resourceBigipCommand(),
+			"bigip_sys_preferences":                   resourceBigipSysPreferences(),
+			"bigip_gtm_zonerunner_view":               resourceBigipGtmZonerunnerView(),
+			"bigip_gtm_zonerunner_zone":               resourceBigipGtmZonerunnerZone(),
+			"bigip_sys_partition":                     resourceBigipSysPartition(),
+			"bigip_sys_log_destination_arcsight":      resourceBigipSysLogDestinationArcsight(),
+			"bigip_security_profile_log":              resourceBigipSecurityProfileLog(),
+			"bigip_ltm_profile_socks":                 resourceBigipLtmProfileSocks(),
+			"bigip_do":                                resourceBigipDo(),
+			"bigip_tracked_objects_purge":             resourceBigipTrackedObjectsPurge(),
+			"bigip_telemetry_streaming":               resourceBigipTelemetryStreaming(),
+			"bigip_fast_template_set":                 resourceBigipFastTemplateSet(),
+			"bigip_fast_application":                  resourceBigipFastApplication(),
+			"bigip_ltm_pool_member_registration":      resourceBigipLtmPoolMemberRegistration(),
+			"bigip_sys_iapp_template":                 resourceBigipSysIappTemplate(),
+			"bigip_ssl_pkcs12":                        resourceBigipSslPkcs12(),
+			"bigip_ssl_crl":                           resourceBigipSslCrl(),
+			"bigip_ssl_ocsp_validator":                resourceBigipSslOcspValidator(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range p.ResourcesMap {
+		autoCreateFolders(r)
+		sharedObjects(r)
+		guardStandbyWrites(r)
+		trackRenames(name, r)
+		extraAttributes(name, r)
+		guardModuleProvisioning(name, r)
+		partitionAttributes(r)
+		inTransactions(r)
+		autoConfigSync(name, r)
+		checkReferencesAtPlan(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		guardModuleProvisioning(name, r)
+	}
+	return p
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		Address:  d.Get("address").(string),
-		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
+		Address:        d.Get("address").(string),
+		Username:       d.Get("username").(string),
+		Password:       d.Get("password").(string),
+		Port:           d.Get("port").(int),
+		RestartTimeout: time.Duration(d.Get("rest_restart_timeout").(int)) * time.Second,
+		Retries:        d.Get("api_retries").(int),
+		RetryMaxDelay:  time.Duration(d.Get("api_retry_max_delay").(int)) * time.Second,
+		DryRun:         d.Get("dry_run").(bool),
+		DryRunFile:     d.Get("dry_run_file").(string),
+		Transactions:   d.Get("transactions").(bool),
+		Insecure:       d.Get("insecure").(bool),
+		CaBundle:       d.Get("ca_bundle").(string),
+		ClientCert:     d.Get("client_cert").(string),
+		ClientKey:      d.Get("client_key").(string),
+		MaxIdleConns:   d.Get("max_idle_connections").(int),
+		KeepAlive:      time.Duration(d.Get("keepalive").(int)) * time.Second,
+		Proxy:          d.Get("proxy").(string),
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: time.Duration(d.Get("api_timeout").(int)) * time.Second,
+		},
 	}
 	if d.Get("token_auth").(bool) {
 		config.LoginReference = d.Get("login_ref").(string)
+		config.AuthTimeout = time.Duration(d.Get("auth_timeout").(int)) * time.Second
+		config.BasicAuthFallback = d.Get("basic_auth_fallback").(bool)
 	}
 
-	return config.Client()
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+	if !d.Get("allow_standby_writes").(bool) {
+		enableStandbyGuard(client)
+	}
+	if d.Get("auto_create_folders").(bool) {
+		enableAutoCreateFolders(client)
+	}
+	if d.Get("track_renames").(bool) {
+		enableRenameTracking(client)
+	}
+	if group := d.Get("config_sync_device_group").(string); group != "" {
+		enableAutoConfigSync(client, group)
+	}
+	if d.Get("check_references").(bool) {
+		enableReferenceChecks(client)
+	}
+	return client, nil
 }
 
 //Convert slice of strings to schema.TypeSet
@@ -149,33 +448,88 @@ func setToStringSlice(s *schema.Set) []string {
 	return list
 }
 
-//Copy map values into an object where map key == object field name (e.g. map[foo] == &{Foo: ...}
+// setToSortedStringSlice returns the strings of a set in alphabetical order
+func setToSortedStringSlice(s *schema.Set) []string {
+	list := setToStringSlice(s)
+	sort.Strings(list)
+	return list
+}
+
+//Suppress the difference between two JSON documents that only differ in formatting or key order
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if json.Unmarshal([]byte(old), &o) != nil || json.Unmarshal([]byte(new), &n) != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}
+
+//Suppress the difference between two MAC addresses that only differ in case or leading zeros, e.g. 0:a:... and 00:0A:...
+func suppressEquivalentMAC(k, old, new string, d *schema.ResourceData) bool {
+	o, n := strings.Split(old, ":"), strings.Split(new, ":")
+	if len(o) != len(n) {
+		return false
+	}
+	for i := range o {
+		if strings.TrimLeft(strings.ToLower(o[i]), "0") != strings.TrimLeft(strings.ToLower(n[i]), "0") {
+			return false
+		}
+	}
+	return true
+}
+
+//Copy map values into an object where map key == snake_case of the object field's json name (e.g. map[http_header] == &{HttpHeader: ...}
 func mapEntity(d map[string]interface{}, obj interface{}) {
 	val := reflect.ValueOf(obj).Elem()
+	fields := schemaFieldIndex(val.Type())
 	for field := range d {
-		f := val.FieldByName(strings.Title(field))
-		if f.IsValid() {
-			if f.Kind() == reflect.Slice {
-				incoming := d[field].([]interface{})
-				s := reflect.MakeSlice(f.Type(), len(incoming), len(incoming))
-				for i := 0; i < len(incoming); i++ {
-					s.Index(i).Set(reflect.ValueOf(incoming[i]))
-				}
-				f.Set(s)
-			} else {
-				f.Set(reflect.ValueOf(d[field]))
+		i, ok := fields[field]
+		if !ok {
+			log.Printf("[WARN] You probably weren't expecting %s to be an invalid field", field)
+			continue
+		}
+		f := val.Field(i)
+		if f.Kind() == reflect.Slice {
+			incoming := d[field].([]interface{})
+			s := reflect.MakeSlice(f.Type(), len(incoming), len(incoming))
+			for i := 0; i < len(incoming); i++ {
+				s.Index(i).Set(reflect.ValueOf(incoming[i]))
 			}
+			f.Set(s)
 		} else {
-			if field == "http_reply" {
-				f := val.FieldByName(strings.Title("httpReply"))
-				f.Set(reflect.ValueOf(d[field]))
+			f.Set(reflect.ValueOf(d[field]))
+		}
+	}
+}
+
+//Map the schema key of each field of a struct to its index, the key is the json name in snake_case (httpHeader -> http_header)
+func schemaFieldIndex(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fields[schemaFieldName(t.Field(i))] = i
+	}
+	return fields
+}
+
+func schemaFieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		name = strings.ToLower(f.Name[0:1]) + f.Name[1:]
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
 			}
-			log.Printf("[WARN] You probably weren't expecting %s to be an invalid field", field)
+			r += 'a' - 'A'
 		}
+		b.WriteRune(r)
 	}
+	return b.String()
 }
 
-//Break a string in the format /Partition/name into a Partition / Name object
+//Break a string in the format /Partition/name into a Partition / Name object, the name keeps any folders (/Partition/folder/name)
 func parseF5Identifier(str string) (partition, name string) {
 	if strings.HasPrefix(str, "/") {
 		ary := strings.SplitN(strings.TrimPrefix(str, "/"), "/", 2)
@@ -183,3 +537,32 @@ func parseF5Identifier(str string) (partition, name string) {
 	}
 	return "", str
 }
+
+//Break a full path /Partition/Folder/name into its partition, folders (empty when none) and name
+func splitF5Path(fullPath string) (partition, subPath, name string) {
+	partition, name = parseF5Identifier(fullPath)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return partition, name[:i], name[i+1:]
+	}
+	return partition, "", name
+}
+
+//Read back a newly created object, retrying while it is not yet visible on the BIG-IP
+func readAfterCreate(d *schema.ResourceData, meta interface{}, read schema.ReadFunc) error {
+	if inTransaction(meta) {
+		// The object is queued in the transaction of the operation, it is read once committed
+		return nil
+	}
+	id := d.Id()
+	return resource.Retry(READ_AFTER_CREATE_TIMEOUT, func() *resource.RetryError {
+		if err := read(d, meta); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if d.Id() == "" {
+			log.Printf("[DEBUG] %s not visible yet after create, retrying read", id)
+			d.SetId(id)
+			return resource.RetryableError(fmt.Errorf("%s not found after create", id))
+		}
+		return nil
+	})
+}
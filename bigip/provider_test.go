@@ -49,3 +49,54 @@ func testAcctPreCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestReadAfterCreateRetriesUntilVisible(t *testing.T) {
+	d := resourceBigipLtmIRule().TestResourceData()
+	d.SetId("/Common/test-rule")
+	calls := 0
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		calls++
+		if calls < 3 {
+			d.SetId("")
+		}
+		return nil
+	}
+	if err := readAfterCreate(d, nil, read); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 reads, got %d", calls)
+	}
+	if d.Id() != "/Common/test-rule" {
+		t.Fatalf("expected id to be kept, got %q", d.Id())
+	}
+}
+
+func TestSuppressEquivalentMAC(t *testing.T) {
+	data := map[[2]string]bool{
+		{"0:50:56:8a:12:34", "00:50:56:8A:12:34"}:  true,
+		{"00:50:56:8a:12:34", "00:50:56:8a:12:34"}: true,
+		{"00:50:56:8a:12:34", "00:50:56:8a:12:35"}: false,
+		{"00:50:56:8a:12:34", "00:50:56:8a:12"}:    false,
+	}
+	for macs, equivalent := range data {
+		if suppressEquivalentMAC("mac_address", macs[0], macs[1], nil) != equivalent {
+			t.Errorf("expected %s and %s to be equivalent: %t", macs[0], macs[1], equivalent)
+		}
+	}
+}
+
+func TestSplitF5Path(t *testing.T) {
+	data := map[string][3]string{
+		"/Common/web":          {"Common", "", "web"},
+		"/Tenant/app1/web":     {"Tenant", "app1", "web"},
+		"/Tenant/app1/api/web": {"Tenant", "app1/api", "web"},
+		"web":                  {"", "", "web"},
+	}
+	for fullPath, expected := range data {
+		partition, subPath, name := splitF5Path(fullPath)
+		if [3]string{partition, subPath, name} != expected {
+			t.Errorf("expected %s to split into %v, got %s, %s and %s", fullPath, expected, partition, subPath, name)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// referenceAttribute is an attribute of a resource naming objects of a kind, in the blocks of block when set
+type referenceAttribute struct {
+	block, attribute, kind string
+}
+
+// The references checked at plan time, by resource
+var referenceAttributes = map[string][]referenceAttribute{
+	"bigip_ltm_virtual_server": {
+		{attribute: "pool", kind: "pool"},
+		{attribute: "irules", kind: "iRule"},
+		{attribute: "policies", kind: "policy"},
+	},
+	"bigip_ltm_pool": {
+		{attribute: "monitors", kind: "monitor"},
+	},
+	"bigip_ltm_profile_client_ssl": {
+		{attribute: "cert", kind: "certificate"},
+		{attribute: "key", kind: "key"},
+		{attribute: "chain", kind: "certificate"},
+		{block: "cert_key_chain", attribute: "cert", kind: "certificate"},
+		{block: "cert_key_chain", attribute: "key", kind: "key"},
+		{block: "cert_key_chain", attribute: "chain", kind: "certificate"},
+	},
+	"bigip_ltm_profile_server_ssl": {
+		{attribute: "cert", kind: "certificate"},
+		{attribute: "key", kind: "key"},
+		{attribute: "chain", kind: "certificate"},
+	},
+}
+
+// referenceExists checks whether an object of a kind exists on the device
+var referenceExists = map[string]func(client *bigip.BigIP, name string) (bool, error){
+	"pool":        objectExists(uriLtm, "pool"),
+	"iRule":       objectExists(uriLtm, "rule"),
+	"policy":      objectExists(uriLtm, "policy"),
+	"certificate": objectExists("sys", "file", "ssl-cert"),
+	"key":         objectExists("sys", "file", "ssl-key"),
+	"monitor": func(client *bigip.BigIP, name string) (bool, error) {
+		t, err := findMonitorType(client, name)
+		return t != "", err
+	},
+}
+
+// referenceChecker holds the objects found on the device of a client, as <kind> <full path>
+type referenceChecker struct {
+	mu    sync.Mutex
+	found map[string]bool
+}
+
+// The reference checkers of clients checking references at plan time, by client
+var referenceCheckClients sync.Map
+
+func enableReferenceChecks(client *bigip.BigIP) {
+	log.Printf("[DEBUG] Checking the references between the resources of %s at plan time", client.Host)
+	referenceCheckClients.Store(client, &referenceChecker{found: map[string]bool{}})
+}
+
+// checkReferencesAtPlan makes the plan of a resource fail with all the references of the resource to objects that
+// do not exist on the device. The values not known yet are skipped, so an object created by the configuration is
+// referenced through the id of its resource, which is only known once it is created. The names planned by the
+// other resources are not used: terraform plans the resources that do not reference each other in any order, and
+// the name of a resource being created is known at plan time, whether it is referenced or written literally.
+func checkReferencesAtPlan(name string, r *schema.Resource) {
+	attributes := referenceAttributes[name]
+	if attributes == nil {
+		return
+	}
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+		c, ok := referenceCheckClients.Load(meta.(*bigip.BigIP))
+		if !ok {
+			return nil
+		}
+		checker := c.(*referenceChecker)
+		var dangling []string
+		for _, a := range attributes {
+			for _, ref := range referencedNames(d, a) {
+				ok, err := checker.exists(meta.(*bigip.BigIP), a.kind, ref)
+				if err != nil {
+					return fmt.Errorf("Error checking %s %s: %s", a.kind, ref, err)
+				}
+				if !ok {
+					dangling = append(dangling, a.kind+" "+ref)
+				}
+			}
+		}
+		if len(dangling) > 0 {
+			sort.Strings(dangling)
+			return fmt.Errorf("%s %s references objects that do not exist: %s, reference the objects created by this configuration through the id of their resource",
+				name, d.Get("name"), strings.Join(dangling, ", "))
+		}
+		return nil
+	}
+}
+
+// exists checks whether an object exists on the device, reading it once
+func (c *referenceChecker) exists(client *bigip.BigIP, kind, name string) (bool, error) {
+	key := kind + " " + name
+	c.mu.Lock()
+	known := c.found[key]
+	c.mu.Unlock()
+	if known {
+		return true, nil
+	}
+	ok, err := referenceExists[kind](client, name)
+	if ok {
+		c.mu.Lock()
+		c.found[key] = true
+		c.mu.Unlock()
+	}
+	return ok, err
+}
+
+// referencedNames returns the full paths of the objects an attribute names, skipping the values not known yet
+func referencedNames(d *schema.ResourceDiff, a referenceAttribute) []string {
+	var values []interface{}
+	if a.block == "" {
+		if !d.NewValueKnown(a.attribute) {
+			return nil
+		}
+		values = []interface{}{d.Get(a.attribute)}
+	} else {
+		if !d.NewValueKnown(a.block) {
+			return nil
+		}
+		blocks, _ := d.Get(a.block).([]interface{})
+		for _, b := range blocks {
+			if block, ok := b.(map[string]interface{}); ok {
+				values = append(values, block[a.attribute])
+			}
+		}
+	}
+	var names []string
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			names = append(names, v)
+		case []interface{}:
+			names = append(names, listToStringSlice(v)...)
+		case *schema.Set:
+			names = append(names, setToStringSlice(v)...)
+		}
+	}
+	var paths []string
+	for _, n := range names {
+		if n == "" || n == "none" {
+			continue
+		}
+		if !strings.HasPrefix(n, "/") {
+			n = "/Common/" + n
+		}
+		paths = append(paths, n)
+	}
+	return paths
+}
+
+// objectExists returns a check of whether an object exists in the collection at path
+func objectExists(path ...string) func(client *bigip.BigIP, name string) (bool, error) {
+	return func(client *bigip.BigIP, name string) (bool, error) {
+		return getForEntity(client, &struct{}{}, append(append([]string{}, path...), name+selectQuery("name"))...)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipReferences(url string, check bool) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_pool" "web" {
+			name = "/Common/web"
+			monitors = ["/Common/web_check"]
+		}
+		resource "bigip_ltm_virtual_server" "web" {
+			name = "/Common/web_vs"
+			destination = "10.1.1.1"
+			port = 80
+			pool = "${bigip_ltm_pool.web.id}"
+			irules = ["/Common/redirect", "/Common/missing_rule"]
+			policies = ["missing_policy"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+			check_references = %t
+		}
+	`, url, check)
+}
+
+func TestAccBigipCheckReferences(t *testing.T) {
+	objects := map[string]map[string]interface{}{
+		"/mgmt/tm/ltm/monitor/http/~Common~web_check": {"name": "web_check"},
+		"/mgmt/tm/ltm/rule/~Common~redirect":          {"name": "redirect"},
+	}
+	var writes []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	handler := objectsHandler(objects)
+	mux.HandleFunc("/mgmt/tm/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+		}
+		handler(w, r)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				// The id of the pool is not known until it is created, the monitor and the first iRule exist
+				Config:      testBigipReferences(server.URL, true),
+				ExpectError: regexp.MustCompile(`bigip_ltm_virtual_server /Common/web_vs references objects that do not exist: iRule /Common/missing_rule, policy /Common/missing_policy, reference`),
+			},
+		},
+	})
+	assert.Empty(t, writes, "nothing is written once a reference is dangling")
+}
+
+// testBigipReferencesByName names the pool of the virtual server, created by the configuration, with its name,
+// literally or through its resource, and plans the pool after the virtual server
+func testBigipReferencesByName(url, pool string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_virtual_server" "web" {
+			name = "/Common/web_vs"
+			destination = "10.1.1.1"
+			port = 80
+			pool = %s
+		}
+		resource "bigip_ltm_pool" "web" {
+			name = "/Common/web"
+			depends_on = ["bigip_ltm_virtual_server.other"]
+		}
+		resource "bigip_ltm_virtual_server" "other" {
+			name = "/Common/other_vs"
+			destination = "10.1.1.2"
+			port = 80
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+			check_references = true
+		}
+	`, pool, url)
+}
+
+func TestAccBigipCheckReferencesPlanOrder(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(map[string]map[string]interface{}{}))
+
+	// The names of the objects created by the configuration are known at plan time, whatever the order of the
+	// plan, the pool is reported until it is referenced by the id of its resource
+	for i := 0; i < 3; i++ {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest: true,
+			Providers:  testProviders,
+			Steps: []resource.TestStep{
+				{
+					Config:      testBigipReferencesByName(server.URL, `"/Common/web"`),
+					ExpectError: regexp.MustCompile(`bigip_ltm_virtual_server /Common/web_vs references objects that do not exist: pool /Common/web,`),
+				},
+				{
+					Config:      testBigipReferencesByName(server.URL, `"${bigip_ltm_pool.web.name}"`),
+					ExpectError: regexp.MustCompile(`bigip_ltm_virtual_server /Common/web_vs references objects that do not exist: pool /Common/web,`),
+				},
+				{
+					Config:             testBigipReferencesByName(server.URL, `"${bigip_ltm_pool.web.id}"`),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	}
+}
+
+func TestAccBigipCheckReferencesDisabled(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(map[string]map[string]interface{}{}))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testBigipReferences(server.URL, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// trackingMetadataName is the name of the metadata entry holding the tracking id of an object
+const trackingMetadataName = "terraform_id"
+
+// Clients that tag the objects they create with a tracking id, so an object renamed outside of
+// terraform is found again under its new name rather than recreated
+var renameTrackingClients sync.Map
+
+// renameTrackedCollections returns the path of the collection holding the object of a resource, by resource type
+var renameTrackedCollections = map[string]func(d *schema.ResourceData) []string{
+	"bigip_ltm_pool": func(d *schema.ResourceData) []string {
+		return []string{uriLtm, "pool"}
+	},
+	"bigip_ltm_virtual_server": func(d *schema.ResourceData) []string {
+		return []string{uriLtm, "virtual"}
+	},
+	"bigip_ltm_node": func(d *schema.ResourceData) []string {
+		return []string{uriLtm, "node"}
+	},
+	"bigip_gtm_pool": func(d *schema.ResourceData) []string {
+		return []string{uriGtm, uriGtmPool, d.Get("type").(string)}
+	},
+	"bigip_gtm_wideip": func(d *schema.ResourceData) []string {
+		return []string{uriGtm, uriWideip, d.Get("type").(string)}
+	},
+}
+
+// trackedCollections are the collections of the objects tagged with a tracking id, in the order their orphans
+// are deleted, the objects referencing others first
+var trackedCollections = [][]string{
+	{uriLtm, "virtual"},
+	{uriGtm, uriWideip, "a"},
+	{uriGtm, uriWideip, "aaaa"},
+	{uriGtm, uriWideip, "cname"},
+	{uriGtm, uriWideip, "mx"},
+	{uriGtm, uriGtmPool, "a"},
+	{uriGtm, uriGtmPool, "aaaa"},
+	{uriGtm, uriGtmPool, "cname"},
+	{uriGtm, uriGtmPool, "mx"},
+	{uriLtm, "pool"},
+	{uriLtm, "node"},
+}
+
+// taggedObject is an object tagged with a tracking id, and the collection it is in
+type taggedObject struct {
+	Collection []string
+	FullPath   string
+	TrackingID string
+}
+
+type metadataEntry struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Persist string `json:"persist,omitempty"`
+}
+
+type trackedObject struct {
+	FullPath string          `json:"fullPath,omitempty"`
+	Metadata []metadataEntry `json:"metadata,omitempty"`
+}
+
+// trackedObjectPath returns the path of the object with the given id in a collection
+func trackedObjectPath(collection []string, id string) []string {
+	if collection[0] == uriGtm && collection[1] == uriWideip {
+		id = gtmWideipPathName(id)
+	}
+	return append(append([]string{}, collection...), id)
+}
+
+// tagTrackedObject stores trackingID in the metadata of an object, keeping its other metadata entries
+func tagTrackedObject(client *bigip.BigIP, collection []string, id, trackingID string) error {
+	path := trackedObjectPath(collection, id)
+	last := len(path) - 1
+
+	var o trackedObject
+	query := append(append([]string{}, path[:last]...), path[last]+selectQuery("metadata"))
+	if _, err := getForEntity(client, &o, query...); err != nil {
+		return fmt.Errorf("Error retrieving metadata of %s: %s", id, err)
+	}
+	metadata := []metadataEntry{{Name: trackingMetadataName, Value: trackingID, Persist: "true"}}
+	for _, m := range o.Metadata {
+		if m.Name != trackingMetadataName {
+			metadata = append(metadata, m)
+		}
+	}
+	if err := patchEntity(client, trackedObject{Metadata: metadata}, path...); err != nil {
+		return fmt.Errorf("Error tagging %s with tracking id %s: %s", id, trackingID, err)
+	}
+	return nil
+}
+
+// findTrackedObject returns the full path of the object of a collection tagged with trackingID, or ""
+func findTrackedObject(client *bigip.BigIP, collection []string, trackingID string) (string, error) {
+	var items []trackedObject
+	if _, err := getCollection(client, &items, selectQuery("fullPath", "metadata"), collection...); err != nil {
+		return "", err
+	}
+	for _, o := range items {
+		for _, m := range o.Metadata {
+			if m.Name == trackingMetadataName && m.Value == trackingID {
+				return o.FullPath, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// listTaggedObjects returns the objects tagged with a tracking id in the given partitions, in the order of
+// trackedCollections. The GTM collections are skipped when the module is not provisioned.
+func listTaggedObjects(client *bigip.BigIP, partitions []string) ([]taggedObject, error) {
+	gtm := checkModuleProvisioned(client, "gtm") == nil
+	var tagged []taggedObject
+	for _, collection := range trackedCollections {
+		if collection[0] == uriGtm && !gtm {
+			continue
+		}
+		var items []trackedObject
+		if _, err := getCollection(client, &items, selectQuery("fullPath", "metadata"), collection...); err != nil {
+			return nil, fmt.Errorf("Error retrieving %s: %s", strings.Join(collection, "/"), err)
+		}
+		for _, o := range items {
+			if !inPartitions(o.FullPath, partitions) {
+				continue
+			}
+			for _, m := range o.Metadata {
+				if m.Name == trackingMetadataName {
+					tagged = append(tagged, taggedObject{Collection: collection, FullPath: o.FullPath, TrackingID: m.Value})
+				}
+			}
+		}
+	}
+	return tagged, nil
+}
+
+// inPartitions returns whether the object of a full path is in one of the partitions, or partitions is empty
+func inPartitions(fullPath string, partitions []string) bool {
+	if len(partitions) == 0 {
+		return true
+	}
+	for _, p := range partitions {
+		if strings.HasPrefix(fullPath, "/"+strings.Trim(p, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// relocateTrackedObject looks for the object of a resource that was not found under its id. When an object
+// tagged with the tracking id of the resource exists under another name, the resource is moved to it.
+func relocateTrackedObject(d *schema.ResourceData, client *bigip.BigIP, collection []string, id string) (bool, error) {
+	trackingID := d.Get("tracking_id").(string)
+	if trackingID == "" {
+		return false, nil
+	}
+	fullPath, err := findTrackedObject(client, collection, trackingID)
+	if err != nil {
+		return false, fmt.Errorf("Error looking for the object tagged with tracking id %s: %s", trackingID, err)
+	}
+	if fullPath == "" || fullPath == id {
+		return false, nil
+	}
+	log.Printf("[WARN] %s was renamed to %s outside of terraform, set its name to %s in the configuration to keep "+
+		"tracking it rather than recreating it", id, fullPath, fullPath)
+	d.SetId(fullPath)
+	d.Set("name", fullPath)
+	return true, nil
+}
+
+// trackRenames wraps the functions of a resource whose objects are tagged with a tracking id, when
+// the provider is configured to. The tracking id is stored in the tracking_id attribute.
+func trackRenames(resourceType string, r *schema.Resource) {
+	collectionPath, ok := renameTrackedCollections[resourceType]
+	if !ok {
+		return
+	}
+	r.Schema["tracking_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Id stored in the metadata of the object to detect renames made outside of terraform",
+	}
+
+	tag := func(d *schema.ResourceData, client *bigip.BigIP) error {
+		trackingID := d.Get("tracking_id").(string)
+		if trackingID == "" {
+			var err error
+			if trackingID, err = uuid.GenerateUUID(); err != nil {
+				return err
+			}
+		}
+		if err := tagTrackedObject(client, collectionPath(d), d.Id(), trackingID); err != nil {
+			return err
+		}
+		d.Set("tracking_id", trackingID)
+		return nil
+	}
+
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*bigip.BigIP)
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		if _, ok := renameTrackingClients.Load(providerClient(client)); !ok || d.Id() == "" {
+			return nil
+		}
+		return tag(d, client)
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			client := meta.(*bigip.BigIP)
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			if _, ok := renameTrackingClients.Load(providerClient(client)); !ok || d.Id() == "" {
+				return nil
+			}
+			// Objects created or imported before tracking was enabled are tagged on their next update
+			return tag(d, client)
+		}
+	}
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client := meta.(*bigip.BigIP)
+			id := d.Id()
+			ok, err := exists(d, meta)
+			if err != nil || ok {
+				return ok, err
+			}
+			if _, enabled := renameTrackingClients.Load(providerClient(client)); !enabled {
+				return false, nil
+			}
+			d.SetId(id)
+			found, err := relocateTrackedObject(d, client, collectionPath(d), id)
+			if !found {
+				d.SetId("")
+			}
+			return found, err
+		}
+	}
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*bigip.BigIP)
+		id := d.Id()
+		if err := read(d, meta); err != nil || d.Id() != "" {
+			return err
+		}
+		if _, ok := renameTrackingClients.Load(providerClient(client)); !ok {
+			return nil
+		}
+		found, err := relocateTrackedObject(d, client, collectionPath(d), id)
+		if err != nil || !found {
+			return err
+		}
+		return read(d, meta)
+	}
+}
+
+func enableRenameTracking(client *bigip.BigIP) {
+	log.Printf("[DEBUG] Tracking renames of the objects created on %s", client.Host)
+	renameTrackingClients.Store(client, true)
+}
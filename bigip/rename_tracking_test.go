@@ -0,0 +1,113 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipTrackedWideip(url, name string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_wideip" "test-tracked" {
+			name = "%s"
+			type = "a"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+			track_renames = true
+		}
+	`, name, url)
+}
+
+func TestAccBigipTrackRenamesWideip(t *testing.T) {
+	fullPath := ""
+	metadata := `[{"name":"owner","value":"dns-team"}]`
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/a", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fullPath = "/Common/app.example.com"
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		assert.Equal(t, "fullPath,metadata", r.URL.Query().Get("$select"))
+		fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/other.example.com"},{"fullPath":"%s","metadata":%s}]}`, fullPath, metadata)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/a/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.Replace(strings.TrimPrefix(r.URL.Path, "/mgmt/tm/gtm/wideip/a/"), "~", "/", -1)
+		if name != fullPath {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		switch r.Method {
+		case "DELETE":
+			fullPath = ""
+			return
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			var body trackedObject
+			json.Unmarshal(b, &body)
+			b, _ = json.Marshal(body.Metadata)
+			metadata = string(b)
+		}
+		if r.URL.Query().Get("$select") == "metadata" {
+			fmt.Fprintf(w, `{"metadata":%s}`, metadata)
+			return
+		}
+		fmt.Fprintf(w, `{"fullPath":"%s","poolLbMode":"round-robin","persistence":"disabled","ttlPersistence":3600,
+			"persistCidrIpv4":32,"persistCidrIpv6":128,"minimalResponse":"enabled","failureRcodeResponse":"disabled",
+			"failureRcode":"noerror","enabled":true}`, name)
+	})
+	trackingID := ""
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipTrackedWideip(server.URL, "/Common/app.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-tracked", "id", "/Common/app.example.com"),
+					func(s *terraform.State) error {
+						trackingID = s.RootModule().Resources["bigip_gtm_wideip.test-tracked"].Primary.Attributes["tracking_id"]
+						assert.JSONEq(t, fmt.Sprintf(`[{"name":"terraform_id","value":"%s","persist":"true"},
+							{"name":"owner","value":"dns-team"}]`, trackingID), metadata, "Other metadata entries are kept")
+						return nil
+					},
+				),
+			},
+			{
+				// The wide IP is renamed on the BIG-IP, the configuration follows the new name
+				PreConfig: func() { fullPath = "/Common/app2.example.com" },
+				Config:    testBigipTrackedWideip(server.URL, "/Common/app2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-tracked", "id", "/Common/app2.example.com"),
+					func(s *terraform.State) error {
+						assert.Equal(t, trackingID, s.RootModule().Resources["bigip_gtm_wideip.test-tracked"].Primary.Attributes["tracking_id"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+	assert.Empty(t, fullPath, "Renamed wide IP was not deleted")
+}
@@ -6,186 +6,320 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
-	"crypto/tls"
+	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// The AS3 endpoints, declarations are deployed asynchronously and followed through their task
+var uriAs3Declare = []string{"mgmt", "shared", "appsvcs", "declare"}
+var uriAs3Task = []string{"mgmt", "shared", "appsvcs", "task"}
+
+// as3Task is the task of a declaration deployed asynchronously, with a result per tenant
+type as3Task struct {
+	ID      string      `json:"id"`
+	Results []as3Result `json:"results"`
+}
+
+type as3Result struct {
+	Code     int      `json:"code"`
+	Message  string   `json:"message"`
+	Tenant   string   `json:"tenant"`
+	Response string   `json:"response"`
+	Errors   []string `json:"errors"`
+}
+
+// bigip_as3 deploys the tenant of an AS3 declaration. Only the tenant of the resource is deployed, and read
+// back to detect drift, other tenants of the declaration are left as they are. The tenant is the one of the
+// declaration when it declares a single one, and the id of the resource is its name.
 func resourceBigipAs3() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipAs3Create,
 		Read:   resourceBigipAs3Read,
 		Update: resourceBigipAs3Update,
 		Delete: resourceBigipAs3Delete,
-		Exists: resourceBigipAs3Exists,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceBigipAs3CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
-
 			"as3_json": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "AS3 json",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "AS3 declaration of the tenant, as JSON",
+				ValidateFunc:     validateAs3JSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
 			},
 			"tenant_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "as3",
-				Description: "unique identifier for resource",
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Tenant of the declaration the resource deploys, the tenant of the declaration when it declares a single one",
 			},
 		},
 	}
 }
 
 func resourceBigipAs3Create(d *schema.ResourceData, meta interface{}) error {
-	client_bigip := meta.(*bigip.BigIP)
-
-	as3_json := d.Get("as3_json").(string)
-	name := d.Get("tenant_name").(string)
-	log.Printf("[INFO] Creating as3 config in bigip:%s", as3_json)
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := client_bigip.Host + "/mgmt/shared/appsvcs/declare"
-	req, err := http.NewRequest("POST", url, strings.NewReader(as3_json))
-	if err != nil {
-		return fmt.Errorf("Error while creating http request with AS3 json:%v", err)
-	}
-	req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	client := meta.(*bigip.BigIP)
 
-	resp, err := client.Do(req)
-	body, err := ioutil.ReadAll(resp.Body)
-	bodyString := string(body)
-	if resp.Status != "200 OK" || err != nil {
-		defer resp.Body.Close()
-		return fmt.Errorf("Error while Sending/Posting http request with AS3 json :%s  %v", bodyString, err)
+	tenant := d.Get("tenant_name").(string)
+	if tenant == "" {
+		// The declaration was not known when planning
+		var err error
+		if tenant, err = as3SingleTenant(d.Get("as3_json").(string)); err != nil {
+			return err
+		}
 	}
+	log.Println("[INFO] Deploying AS3 tenant " + tenant)
 
-	defer resp.Body.Close()
-	d.SetId(name)
-	return resourceBigipAs3Read(d, meta)
+	if err := deployAs3Tenant(client, d, tenant, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(tenant)
+	if _, ok := dryRunClients.Load(client); ok {
+		return nil
+	}
+	return readAfterCreate(d, meta, resourceBigipAs3Read)
 }
+
 func resourceBigipAs3Read(d *schema.ResourceData, meta interface{}) error {
-	client_bigip := meta.(*bigip.BigIP)
-	log.Printf("[INFO] Reading As3 config")
-
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := client_bigip.Host + "/mgmt/shared/appsvcs/declare"
-	req, err := http.NewRequest("GET", url, nil)
+	client := meta.(*bigip.BigIP)
+
+	tenant := d.Id()
+	log.Println("[INFO] Reading AS3 tenant " + tenant)
+
+	deployed, ok, err := getAs3Tenant(client, tenant)
 	if err != nil {
-		return fmt.Errorf("Error while creating http request for reading As3 config:%v", err)
+		log.Printf("[ERROR] Unable to retrieve AS3 tenant (%s) (%v)", tenant, err)
+		return err
 	}
-	req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-	body, err := ioutil.ReadAll(resp.Body)
-	bodyString := string(body)
-	if resp.Status != "200 OK" || err != nil {
-		defer resp.Body.Close()
-		return fmt.Errorf("Error while Sending/fetching http request :%s  %v", bodyString, err)
+	if !ok {
+		log.Printf("[WARN] AS3 tenant (%s) not found, removing from state", tenant)
+		d.SetId("")
+		return nil
 	}
 
-	defer resp.Body.Close()
+	// The tenant deployed replaces the one of the configured declaration, the rest of it, e.g. its id or the
+	// AS3 class wrapping it, is not returned by the device
+	declaration := map[string]interface{}{}
+	json.Unmarshal([]byte(d.Get("as3_json").(string)), &declaration)
+	adc := as3ADC(declaration)
+	if adc == nil {
+		declaration = deployed
+	} else {
+		adc[tenant] = deployed[tenant]
+	}
+	b, err := json.Marshal(declaration)
+	if err != nil {
+		return err
+	}
+	d.Set("tenant_name", tenant)
+	d.Set("as3_json", string(b))
 	return nil
 }
 
-func resourceBigipAs3Exists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client_bigip := meta.(*bigip.BigIP)
-	log.Printf("[INFO] Checking if As3 config exists in bigip ")
+func resourceBigipAs3Update(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
 
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := client_bigip.Host + "/mgmt/shared/appsvcs/declare"
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Printf("[ERROR] Error while creating http request for checking As3 config : %v", err)
-		return false, err
-	}
-	req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-	body, err := ioutil.ReadAll(resp.Body)
-	bodyString := string(body)
-	if resp.Status == "204 No Content" || err != nil {
-		log.Printf("[ERROR] Error while checking as3resource present in bigip :%s  %v", bodyString, err)
-		defer resp.Body.Close()
-		return false, err
-	}
-	defer resp.Body.Close()
-	return true, nil
+	tenant := d.Id()
+	log.Println("[INFO] Updating AS3 tenant " + tenant)
+
+	if err := deployAs3Tenant(client, d, tenant, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	return resourceBigipAs3Read(d, meta)
 }
 
-func resourceBigipAs3Update(d *schema.ResourceData, meta interface{}) error {
-	client_bigip := meta.(*bigip.BigIP)
-	as3_json := d.Get("as3_json").(string)
-	log.Printf("[INFO] Updating As3 Config :%s", as3_json)
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := client_bigip.Host + "/mgmt/shared/appsvcs/declare"
-	req, err := http.NewRequest("PATCH", url, strings.NewReader(as3_json))
+func resourceBigipAs3Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	tenant := d.Id()
+	log.Println("[INFO] Deleting AS3 tenant " + tenant)
+
+	path := append(uriAs3Declare, tenant)
+	if skip, err := reportDryRun(client, "DELETE", "/"+strings.Join(path, "/"), nil); skip || err != nil {
+		d.SetId("")
+		return err
+	}
+	var task as3Task
+	resp, err := client.APICall(&bigip.APIRequest{
+		Method: "delete",
+		URL:    iControlPath(append(uriAs3Declare, tenant+"?async=true")),
+	})
+	if err == nil {
+		if err = json.Unmarshal(resp, &task); err == nil {
+			err = waitForAs3Task(client, task.ID, d.Timeout(schema.TimeoutDelete))
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("Error while creating http request with AS3 json:%v", err)
+		log.Printf("[ERROR] Unable to Delete AS3 tenant (%s) (%v) ", tenant, err)
+		return fmt.Errorf("Error deleting AS3 tenant (%s): %s", tenant, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipAs3CustomizeDiff plans the tenant of the declaration when tenant_name is not set. A declaration
+// changed to a single other tenant replaces the resource.
+func resourceBigipAs3CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("as3_json") || (d.Id() != "" && !d.HasChange("as3_json")) || d.HasChange("tenant_name") {
+		return nil
+	}
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("as3_json").(string)), &declaration); err != nil {
+		return nil
+	}
+	tenants := as3Tenants(as3ADC(declaration))
+	if len(tenants) != 1 || tenants[0] == d.Get("tenant_name").(string) {
+		return nil
+	}
+	if err := d.SetNew("tenant_name", tenants[0]); err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		return d.ForceNew("tenant_name")
 	}
-	req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	return nil
+}
 
-	resp, err := client.Do(req)
-	body, err := ioutil.ReadAll(resp.Body)
-	bodyString := string(body)
-	if resp.Status != "200 OK" || err != nil {
-		return fmt.Errorf("Error while Sending/Posting http request with AS3 json :%s  %v", bodyString, err)
+// as3SingleTenant returns the tenant of a declaration declaring a single one
+func as3SingleTenant(as3JSON string) (string, error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(as3JSON), &declaration); err != nil {
+		return "", fmt.Errorf("as3_json is not valid JSON: %s", err)
 	}
+	tenants := as3Tenants(as3ADC(declaration))
+	if len(tenants) != 1 {
+		return "", fmt.Errorf("as3_json declares %d tenants %v, set tenant_name to the tenant to deploy", len(tenants), tenants)
+	}
+	return tenants[0], nil
+}
 
-	defer resp.Body.Close()
-	return resourceBigipAs3Read(d, meta)
+// as3Tenants returns the tenants of an ADC declaration, sorted
+func as3Tenants(adc map[string]interface{}) []string {
+	tenants := []string{}
+	for name, v := range adc {
+		if o, ok := v.(map[string]interface{}); ok && o["class"] == "Tenant" {
+			tenants = append(tenants, name)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants
 }
 
-func resourceBigipAs3Delete(d *schema.ResourceData, meta interface{}) error {
-	client_bigip := meta.(*bigip.BigIP)
-	log.Printf("[INFO] Deleting As3 config")
+// deployAs3Tenant posts the declaration of the resource for its tenant only, and waits for AS3 to deploy it
+func deployAs3Tenant(client *bigip.BigIP, d *schema.ResourceData, tenant string, timeout time.Duration) error {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("as3_json").(string)), &declaration); err != nil {
+		return fmt.Errorf("as3_json is not valid JSON: %s", err)
+	}
+	if adc := as3ADC(declaration); adc == nil || adc[tenant] == nil {
+		return fmt.Errorf("as3_json does not declare tenant %s, set tenant_name to the tenant of the declaration", tenant)
+	}
 
-	name := d.Get("tenant_name").(string)
+	path := append(uriAs3Declare, tenant)
+	if skip, err := reportDryRun(client, "POST", "/"+strings.Join(path, "/"), declaration); skip || err != nil {
+		return err
+	}
+	var task as3Task
+	err := postForEntity(client, declaration, &task, append(uriAs3Declare, tenant+"?async=true")...)
+	if err == nil {
+		err = waitForAs3Task(client, task.ID, timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("Error deploying AS3 tenant (%s): %s", tenant, err)
+	}
+	return nil
+}
 
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := client_bigip.Host + "/mgmt/shared/appsvcs/declare/" + name
-	req, err := http.NewRequest("DELETE", url, nil)
+// waitForAs3Task waits for the results of an AS3 task, and returns the errors of the tenants it failed for
+func waitForAs3Task(client *bigip.BigIP, id string, timeout time.Duration) error {
+	if id == "" {
+		return fmt.Errorf("AS3 returned no task id, AS3 3.5 or later is required")
+	}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		var task as3Task
+		if _, err := getForEntity(client, &task, append(uriAs3Task, id)...); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(task.Results) == 0 {
+			return resource.RetryableError(fmt.Errorf("task %s is in progress", id))
+		}
+		var failures []string
+		for _, r := range task.Results {
+			switch {
+			case r.Message == "in progress" || r.Code == 0:
+				return resource.RetryableError(fmt.Errorf("task %s is in progress", id))
+			case r.Code >= 300:
+				failure := fmt.Sprintf("%s: %s", r.Tenant, r.Message)
+				if r.Response != "" {
+					failure += ", " + r.Response
+				}
+				for _, e := range r.Errors {
+					failure += ", " + e
+				}
+				failures = append(failures, failure)
+			}
+		}
+		if len(failures) > 0 {
+			return resource.NonRetryableError(fmt.Errorf("task %s failed: %s", id, strings.Join(failures, "; ")))
+		}
+		return nil
+	})
+}
 
+// getAs3Tenant returns the declaration of a tenant deployed by AS3, AS3 answers 204 No Content when nothing is
+// deployed and 404 when the tenant is not
+func getAs3Tenant(client *bigip.BigIP, tenant string) (map[string]interface{}, bool, error) {
+	resp, err := client.APICall(&bigip.APIRequest{
+		Method:      "get",
+		URL:         iControlPath(append(uriAs3Declare, tenant)),
+		ContentType: "application/json",
+	})
 	if err != nil {
-		return fmt.Errorf("Error while creating http request for deleting as3 config:%v", err)
+		var reqError bigip.RequestError
+		json.Unmarshal(resp, &reqError)
+		if reqError.Code == 404 {
+			return nil, false, nil
+		}
+		return nil, false, err
 	}
-	req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-	body, err := ioutil.ReadAll(resp.Body)
-	bodyString := string(body)
-	if resp.Status != "200 OK" || err != nil {
-		return fmt.Errorf("Error while Sending/deleting http request with AS3 json :%s  %v", bodyString, err)
+	if len(strings.TrimSpace(string(resp))) == 0 {
+		return nil, false, nil
+	}
+	declaration := map[string]interface{}{}
+	if err := json.Unmarshal(resp, &declaration); err != nil {
+		return nil, false, err
+	}
+	if declaration[tenant] == nil {
+		return nil, false, nil
 	}
+	return declaration, true, nil
+}
 
-	defer resp.Body.Close()
-	d.SetId("")
+// as3ADC returns the ADC declaration of an AS3 declaration, wrapped or not in an AS3 request
+func as3ADC(declaration map[string]interface{}) map[string]interface{} {
+	if declaration["class"] == "AS3" {
+		adc, _ := declaration["declaration"].(map[string]interface{})
+		return adc
+	}
+	if declaration["class"] == "ADC" {
+		return declaration
+	}
 	return nil
 }
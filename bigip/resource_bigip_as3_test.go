@@ -6,13 +6,10 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
-	"crypto/tls"
 	"fmt"
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"io/ioutil"
-	"net/http"
 	"os"
 	"testing"
 )
@@ -24,7 +21,6 @@ var dir, err = os.Getwd()
 var TEST_AS3_RESOURCE = `
 resource "bigip_as3"  "as3-example" {
      as3_json = "${file("` + dir + `/../examples/as3/example1.json")}"
-     tenant_name = "as3"
 }
 `
 
@@ -34,13 +30,13 @@ func TestAccBigipAs3_create(t *testing.T) {
 			testAcctPreCheck(t)
 		},
 		Providers:    testAccProviders,
-		CheckDestroy: testCheckdevicesDestroyed,
+		CheckDestroy: testCheckAs3Destroyed,
 		Steps: []resource.TestStep{
 			{
 				Config: TEST_AS3_RESOURCE,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckAs3Exists("as3", true),
-					resource.TestCheckResourceAttr("bigip_as3.as3-example", "tenant_name", "as3"),
+					testCheckAs3Exists("Sample_new", true),
+					resource.TestCheckResourceAttr("bigip_as3.as3-example", "tenant_name", "Sample_new"),
 				),
 			},
 		},
@@ -49,27 +45,35 @@ func TestAccBigipAs3_create(t *testing.T) {
 
 func testCheckAs3Exists(name string, exists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client_bigip := testAccProvider.Meta().(*bigip.BigIP)
-		tr := &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-		client := &http.Client{Transport: tr}
-		url := client_bigip.Host + "/mgmt/shared/appsvcs/declare"
-		req, err := http.NewRequest("GET", url, nil)
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		_, ok, err := getAs3Tenant(client, name)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while creating http request with AS3 json: %v", err)
+			return err
 		}
-		req.SetBasicAuth(client_bigip.User, client_bigip.Password)
-		req.Header.Set("Accept", "application/json")
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := client.Do(req)
-		body, err := ioutil.ReadAll(resp.Body)
-		bodyString := string(body)
-		if resp.Status == "204 No Content" || err != nil {
-			return fmt.Errorf("[ERROR] Error while checking as3resource present in bigip :%s  %v", bodyString, err)
-			defer resp.Body.Close()
+		if exists && !ok {
+			return fmt.Errorf("AS3 tenant %s was not deployed", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("AS3 tenant %s still exists", name)
 		}
-		defer resp.Body.Close()
 		return nil
 	}
 }
+
+func testCheckAs3Destroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_as3" {
+			continue
+		}
+		_, ok, err := getAs3Tenant(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("AS3 tenant %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
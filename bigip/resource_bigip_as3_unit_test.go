@@ -0,0 +1,253 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAs3Declaration = `{
+	"class": "AS3",
+	"action": "deploy",
+	"declaration": {
+		"class": "ADC",
+		"schemaVersion": "3.0.0",
+		"Tenant": {
+			"class": "Tenant",
+			"A1": {
+				"class": "Application",
+				"template": "http",
+				"serviceMain": {"class": "Service_HTTP", "virtualAddresses": ["10.0.1.10"]}
+			}
+		},
+		"Other": {"class": "Tenant"}
+	}
+}`
+
+func testBigipAs3(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_as3" "as3-example" {
+			as3_json = <<EOF
+%s
+EOF
+			tenant_name = "Tenant"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, testAs3Declaration, url)
+}
+
+// as3Device answers the AS3 declare and task endpoints, deploying the tenant of a declaration once its task is
+// polled a first time. failure, when set, is the result of the tasks.
+type as3Device struct {
+	tenants  map[string]interface{}
+	failure  string
+	requests []string
+
+	tasks map[string]func() string
+}
+
+func newAs3Device() *as3Device {
+	return &as3Device{tenants: map[string]interface{}{}, tasks: map[string]func() string{}}
+}
+
+func (dev *as3Device) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	dev.requests = append(dev.requests, r.Method+" "+r.URL.RequestURI())
+	if strings.HasPrefix(r.URL.Path, "/mgmt/shared/appsvcs/task/") {
+		result := dev.tasks[strings.TrimPrefix(r.URL.Path, "/mgmt/shared/appsvcs/task/")]
+		fmt.Fprint(w, result())
+		return
+	}
+	tenant := strings.TrimPrefix(r.URL.Path, "/mgmt/shared/appsvcs/declare/")
+	switch r.Method {
+	case "GET":
+		if dev.tenants[tenant] == nil {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"code":404,"message":"specified tenant(s) not found in declaration"}`)
+			return
+		}
+		b, _ := json.Marshal(map[string]interface{}{"class": "ADC", "schemaVersion": "3.0.0", "updateMode": "selective", tenant: dev.tenants[tenant]})
+		w.Write(b)
+		return
+	case "POST", "DELETE":
+		var declaration struct {
+			Declaration map[string]interface{} `json:"declaration"`
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &declaration)
+		id := fmt.Sprintf("task-%d", len(dev.tasks)+1)
+		polled := false
+		dev.tasks[id] = func() string {
+			if !polled {
+				polled = true
+				return fmt.Sprintf(`{"id":"%s","results":[{"message":"in progress"}]}`, id)
+			}
+			if dev.failure != "" {
+				return fmt.Sprintf(`{"id":"%s","results":[{"code":422,"message":"declaration failed","tenant":"%s","response":"%s"}]}`, id, tenant, dev.failure)
+			}
+			if r.Method == "DELETE" {
+				delete(dev.tenants, tenant)
+			} else {
+				dev.tenants[tenant] = declaration.Declaration[tenant]
+			}
+			return fmt.Sprintf(`{"id":"%s","results":[{"code":200,"message":"success","tenant":"%s"}]}`, id, tenant)
+		}
+		w.WriteHeader(202)
+		fmt.Fprintf(w, `{"id":"%s","results":[{"message":"Declaration successfully submitted","code":0}]}`, id)
+	}
+}
+
+func TestAccBigipAs3Deploy(t *testing.T) {
+	setup()
+	defer teardown()
+	dev := newAs3Device()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/shared/appsvcs/", dev)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipAs3(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_as3.as3-example", "id", "Tenant"),
+					func(s *terraform.State) error {
+						if dev.tenants["Tenant"] == nil || dev.tenants["Other"] != nil {
+							return fmt.Errorf("only Tenant is deployed, got %v", dev.tenants)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A change made on the device is detected and undone
+				PreConfig: func() {
+					dev.tenants["Tenant"] = map[string]interface{}{"class": "Tenant"}
+				},
+				Config: testBigipAs3(server.URL),
+				Check: func(s *terraform.State) error {
+					if dev.tenants["Tenant"].(map[string]interface{})["A1"] == nil {
+						return fmt.Errorf("the tenant is not deployed again")
+					}
+					return nil
+				},
+			},
+		},
+	})
+	assert.Contains(t, dev.requests, "POST /mgmt/shared/appsvcs/declare/Tenant?async=true")
+	assert.Contains(t, dev.requests, "GET /mgmt/shared/appsvcs/task/task-2")
+	assert.Contains(t, dev.requests, "DELETE /mgmt/shared/appsvcs/declare/Tenant?async=true")
+	assert.Empty(t, dev.tenants)
+}
+
+func TestAccBigipAs3DeployFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	dev := newAs3Device()
+	dev.failure = "/Tenant/A1/serviceMain: pool web_pool does not exist"
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/shared/appsvcs/", dev)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipAs3(server.URL),
+				ExpectError: regexp.MustCompile("task task-1 failed: Tenant: declaration failed, /Tenant/A1/serviceMain: pool web_pool does not exist"),
+			},
+		},
+	})
+}
+
+func testBigipAs3SingleTenant(url, tenant string) string {
+	return fmt.Sprintf(`
+		resource "bigip_as3" "as3-example" {
+			as3_json = <<EOF
+{"class": "AS3", "declaration": {"class": "ADC", "schemaVersion": "3.0.0", "%s": {"class": "Tenant"}}}
+EOF
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, tenant, url)
+}
+
+func TestAccBigipAs3TenantOfDeclaration(t *testing.T) {
+	setup()
+	defer teardown()
+	dev := newAs3Device()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/shared/appsvcs/", dev)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipAs3SingleTenant(server.URL, "Sample_01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_as3.as3-example", "id", "Sample_01"),
+					resource.TestCheckResourceAttr("bigip_as3.as3-example", "tenant_name", "Sample_01"),
+				),
+			},
+			{
+				// The declaration of another tenant replaces the resource
+				Config: testBigipAs3SingleTenant(server.URL, "Sample_02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_as3.as3-example", "id", "Sample_02"),
+					func(s *terraform.State) error {
+						if dev.tenants["Sample_01"] != nil || dev.tenants["Sample_02"] == nil {
+							return fmt.Errorf("Sample_02 replaces Sample_01, got %v", dev.tenants)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_as3" "as3-example" {
+						as3_json = <<EOF
+%s
+EOF
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, testAs3Declaration, server.URL),
+				ExpectError: regexp.MustCompile(`does not declare tenant Sample_02`),
+			},
+		},
+	})
+}
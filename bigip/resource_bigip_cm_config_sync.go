@@ -0,0 +1,140 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceBigipCmConfigSync is an action resource: creating it synchronizes the configuration of a device
+// group and waits for its members to be in sync, it does not manage any object on the BIG-IP.
+func resourceBigipCmConfigSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmConfigSyncCreate,
+		Read:   resourceBigipCmConfigSyncRead,
+		Delete: resourceBigipCmConfigSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Device group to synchronize",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "to-group",
+				Description:  "to-group pushes the configuration of the BIG-IP to the group, from-group pulls it from the group",
+				ValidateFunc: validateStringValue([]string{"to-group", "from-group"}),
+			},
+			"force_full_load_push": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the full configuration is pushed rather than the changes, to-group only",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that force a new synchronization when changed",
+			},
+			"sync_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sync status of the BIG-IP, e.g. In Sync or Changes Pending",
+			},
+		},
+	}
+}
+
+func resourceBigipCmConfigSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	group := d.Get("device_group").(string)
+	direction := d.Get("direction").(string)
+
+	force := d.Get("force_full_load_push").(bool)
+	if force && direction != "to-group" {
+		return fmt.Errorf("force_full_load_push only applies to direction to-group")
+	}
+	log.Printf("[INFO] Synchronizing device group %s %s", group, direction)
+	if err := runConfigSync(client, group, direction, force); err != nil {
+		return err
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		status, err := getSyncStatus(client)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status != "In Sync" {
+			return resource.RetryableError(fmt.Errorf("device group %s is not in sync, %s", group, status))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", group, time.Now().Unix()))
+	return resourceBigipCmConfigSyncRead(d, meta)
+}
+
+func resourceBigipCmConfigSyncRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	status, err := getSyncStatus(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving sync status: %s", err)
+	}
+	d.Set("sync_status", status)
+	return nil
+}
+
+func resourceBigipCmConfigSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	// Nothing to undo, the synchronized configuration stays on the members
+	d.SetId("")
+	return nil
+}
+
+// runConfigSync synchronizes the configuration of a device group, direction is to-group or from-group
+func runConfigSync(client *bigip.BigIP, group, direction string, force bool) error {
+	args := direction + " " + group
+	if force {
+		args = "force-full-load-push " + args
+	}
+	err := postEntity(client, map[string]string{"command": "run", "utilCmdArgs": args}, "cm", "config-sync")
+	if err != nil {
+		return fmt.Errorf("Error synchronizing device group (%s): %s", group, err)
+	}
+	return nil
+}
+
+// getSyncStatus returns the sync status of the BIG-IP, e.g. In Sync, Changes Pending or Awaiting Initial Sync
+func getSyncStatus(client *bigip.BigIP) (string, error) {
+	var s stats
+	if _, err := getForEntity(client, &s, "cm", "sync-status"); err != nil {
+		return "", err
+	}
+	for _, e := range s.Entries {
+		return e.NestedStats.Entries["status"].Description, nil
+	}
+	return "", nil
+}
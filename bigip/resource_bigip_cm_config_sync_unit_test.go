@@ -0,0 +1,89 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipCmConfigSync(url, direction string) string {
+	return fmt.Sprintf(`
+		resource "bigip_cm_config_sync" "sync" {
+			device_group = "failover-group"
+			direction = "%s"
+			force_full_load_push = true
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, direction, url)
+}
+
+func TestAccBigipCmConfigSyncCreate(t *testing.T) {
+	var sync string
+	polls := 0
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/cm/config-sync", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		sync = string(b)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/sync-status", func(w http.ResponseWriter, r *http.Request) {
+		status := "In Sync"
+		if polls++; polls < 2 {
+			status = "Syncing"
+		}
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/cm/sync-status/0":{"nestedStats":{"entries":{
+			"color":{"description":"green"},"mode":{"description":"high-availability"},"status":{"description":"%s"}}}}}}`, status)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCmConfigSync(server.URL, "to-group"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_config_sync.sync", "sync_status", "In Sync"),
+				),
+			},
+		},
+	})
+	assert.JSONEq(t, `{"command":"run","utilCmdArgs":"force-full-load-push to-group failover-group"}`, sync)
+}
+
+func TestAccBigipCmConfigSyncInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipCmConfigSync(server.URL, "from-group"),
+				ExpectError: regexp.MustCompile("force_full_load_push only applies to direction to-group"),
+			},
+		},
+	})
+}
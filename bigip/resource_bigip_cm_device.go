@@ -74,7 +74,7 @@ func resourceBigipCmDeviceCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipCmDeviceRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipCmDeviceRead)
 
 }
 
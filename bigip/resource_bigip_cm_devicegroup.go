@@ -45,16 +45,19 @@ func resourceBigipCmDevicegroup() *schema.Resource {
 			},
 
 			"auto_sync": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "disabled",
-				Description: "Specifies if the device-group will automatically sync configuration data to its members",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Specifies if the device-group will automatically sync configuration data to its members",
+				ValidateFunc: validateEnabledDisabled,
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "sync-only",
-				Description: "Specifies if the device-group will be used for failover or resource syncing",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sync-only",
+				Description:  "Specifies if the device-group will be used for failover or resource syncing, sync-failover or sync-only",
+				ValidateFunc: validateStringValue([]string{"sync-failover", "sync-only"}),
 			},
 			"full_load_on_sync": {
 				Type:        schema.TypeString,
@@ -70,10 +73,11 @@ func resourceBigipCmDevicegroup() *schema.Resource {
 			},
 
 			"network_failover": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "enabled",
-				Description: "Specifies if the device-group will use a network connection for failover",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Specifies if the device-group will use a network connection for failover",
+				ValidateFunc: validateEnabledDisabled,
 			},
 
 			"incremental_config": {
@@ -120,7 +124,7 @@ func resourceBigipCmDevicegroupCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipCmDevicegroupRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipCmDevicegroupRead)
 }
 
 func resourceBigipCmDevicegroupUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -142,26 +146,41 @@ func resourceBigipCmDevicegroupRead(d *schema.ResourceData, meta interface{}) er
 	name := d.Id()
 
 	log.Println("[INFO] Reading Devicegroup " + name)
-	deviceCount := d.Get("device.#").(int)
-	for i := 0; i < deviceCount; i++ {
-		var r bigip.Devicerecord
-		prefix := fmt.Sprintf("device.%d", i)
-		r.Name = d.Get(prefix + ".name").(string)
-		Rname := r.Name
-		client.DevicegroupsDevices(name, Rname)
-	}
 
-	p, err := client.Devicegroups(name)
+	var p bigip.Devicegroup
+	ok, err := getForEntity(client, &p, "cm", "device-group", name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrive Devicegroup (%s) (%v) ", name, err)
 		return err
 	}
-
-	if p == nil {
+	if !ok {
 		log.Printf("[WARN] Devicegroup (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
+	var members struct {
+		Items []struct {
+			Name     string `json:"name"`
+			FullPath string `json:"fullPath"`
+		} `json:"items"`
+	}
+	if _, err := getForEntity(client, &members, "cm", "device-group", name, "devices"); err != nil {
+		return fmt.Errorf("Error retrieving devices of Devicegroup (%s): %s", name, err)
+	}
+	// The configured spelling of a device, with or without its partition, is kept
+	var devices []map[string]interface{}
+	for _, m := range members.Items {
+		device := map[string]interface{}{"name": m.FullPath, "set_sync_leader": false}
+		for i := 0; i < d.Get("device.#").(int); i++ {
+			prefix := fmt.Sprintf("device.%d", i)
+			if configured := d.Get(prefix + ".name").(string); sameDevice(configured, m.FullPath) {
+				device["name"] = configured
+				device["set_sync_leader"] = d.Get(prefix + ".set_sync_leader").(bool)
+			}
+		}
+		devices = append(devices, device)
+	}
+
 	d.Set("name", p.Name)
 	d.Set("description", p.Description)
 	if err := d.Set("auto_sync", p.AutoSync); err != nil {
@@ -171,10 +190,13 @@ func resourceBigipCmDevicegroupRead(d *schema.ResourceData, meta interface{}) er
 	if err := d.Set("type", p.Type); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Type  to state for Devicegroup (%s): %s", d.Id(), err)
 	}
-	d.Set("fullLoadOnSync", p.FullLoadOnSync)
-	d.Set("saveOnAutoSync", p.SaveOnAutoSync)
-	d.Set("incrementalConfigSyncSizeMax", p.IncrementalConfigSyncSizeMax)
-	d.Set("networkFailover", p.NetworkFailover)
+	d.Set("full_load_on_sync", p.FullLoadOnSync)
+	d.Set("save_on_auto_sync", p.SaveOnAutoSync)
+	d.Set("incremental_config", p.IncrementalConfigSyncSizeMax)
+	d.Set("network_failover", p.NetworkFailover)
+	if err := d.Set("device", devices); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Devices to state for Devicegroup (%s): %s", d.Id(), err)
+	}
 	return nil
 
 }
@@ -0,0 +1,209 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriTrafficGroup = "traffic-group"
+
+type trafficGroup struct {
+	Name                string   `json:"name,omitempty"`
+	Description         string   `json:"description,omitempty"`
+	FailoverMethod      string   `json:"failoverMethod,omitempty"`
+	HaOrder             []string `json:"haOrder"`
+	HaGroup             string   `json:"haGroup,omitempty"`
+	HaLoadFactor        int      `json:"haLoadFactor,omitempty"`
+	AutoFailbackEnabled string   `json:"autoFailbackEnabled,omitempty"`
+	AutoFailbackTime    int      `json:"autoFailbackTime,omitempty"`
+	MacMasquerade       string   `json:"mac,omitempty"`
+}
+
+// bigip_cm_traffic_group manages a traffic group, the floating self IPs, virtual addresses and SNATs failing
+// over together between the devices of a sync-failover device group
+func resourceBigipCmTrafficGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmTrafficGroupCreate,
+		Update: resourceBigipCmTrafficGroupUpdate,
+		Read:   resourceBigipCmTrafficGroupRead,
+		Delete: resourceBigipCmTrafficGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the traffic group",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"failover_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ha-order",
+				Description:  "How the next active device is chosen, ha-order, ha-score or ha-group",
+				ValidateFunc: validateStringValue([]string{"ha-order", "ha-score", "ha-group"}),
+			},
+			"ha_order": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Devices the traffic group fails over to, in order of preference, with failover_method ha-order",
+			},
+			"ha_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "HA group whose score chooses the active device, with failover_method ha-group",
+			},
+			"ha_load_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "Relative load of the traffic group, used by failover_method ha-score",
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"auto_failback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the traffic group fails back to the first device of ha_order once it is available again",
+			},
+			"auto_failback_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  "Seconds to wait before failing back",
+				ValidateFunc: validation.IntBetween(0, 300),
+			},
+			"mac_masquerade": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Shared MAC address of the floating addresses of the traffic group, so peers do not need a gratuitous ARP on failover",
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if v.(string) == "" {
+						return nil, nil
+					}
+					return validateMACAddress(v, k)
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrafficGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating traffic group " + name)
+
+	t := getCmTrafficGroupConfig(d)
+	t.Name = name
+	err := postEntity(client, t, "cm", uriTrafficGroup)
+	if err != nil {
+		return fmt.Errorf("Error creating traffic group (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipCmTrafficGroupRead)
+}
+
+func resourceBigipCmTrafficGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getCmTrafficGroupConfig(d), "cm", uriTrafficGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying traffic group (%s): %s", name, err)
+	}
+	return resourceBigipCmTrafficGroupRead(d, meta)
+}
+
+func resourceBigipCmTrafficGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var t trafficGroup
+	ok, err := getForEntity(client, &t, "cm", uriTrafficGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve traffic group (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Traffic group (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	// The devices of ha_order are returned with their partition, the configured spelling is kept
+	haOrder := t.HaOrder
+	if configured := listToStringSlice(d.Get("ha_order").([]interface{})); len(configured) == len(haOrder) {
+		for i := range haOrder {
+			if sameDevice(configured[i], haOrder[i]) {
+				haOrder[i] = configured[i]
+			}
+		}
+	}
+	d.Set("name", name)
+	d.Set("description", t.Description)
+	d.Set("failover_method", t.FailoverMethod)
+	if err := d.Set("ha_order", haOrder); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving HaOrder to state for traffic group (%s): %s", name, err)
+	}
+	d.Set("ha_group", noneToEmpty(t.HaGroup))
+	d.Set("ha_load_factor", t.HaLoadFactor)
+	d.Set("auto_failback", t.AutoFailbackEnabled == "true")
+	d.Set("auto_failback_time", t.AutoFailbackTime)
+	d.Set("mac_masquerade", noneToEmpty(t.MacMasquerade))
+	return nil
+}
+
+func resourceBigipCmTrafficGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting traffic group " + name)
+
+	err := deleteEntity(client, "cm", uriTrafficGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete traffic group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getCmTrafficGroupConfig(d *schema.ResourceData) *trafficGroup {
+	t := &trafficGroup{
+		Description:         d.Get("description").(string),
+		FailoverMethod:      d.Get("failover_method").(string),
+		HaOrder:             listToStringSlice(d.Get("ha_order").([]interface{})),
+		HaGroup:             emptyToNone(d.Get("ha_group").(string)),
+		HaLoadFactor:        d.Get("ha_load_factor").(int),
+		AutoFailbackEnabled: "false",
+		AutoFailbackTime:    d.Get("auto_failback_time").(int),
+		MacMasquerade:       emptyToNone(d.Get("mac_masquerade").(string)),
+	}
+	if t.HaOrder == nil {
+		t.HaOrder = []string{}
+	}
+	if d.Get("auto_failback").(bool) {
+		t.AutoFailbackEnabled = "true"
+	}
+	return t
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceBigipCmTrafficGroupFailover is an action resource: creating it forces the traffic
+// group to fail over, it does not manage any object on the BIG-IP.
+func resourceBigipCmTrafficGroupFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmTrafficGroupFailoverCreate,
+		Read:   resourceBigipCmTrafficGroupFailoverRead,
+		Delete: resourceBigipCmTrafficGroupFailoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"traffic_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Traffic group to fail over",
+				ValidateFunc: validateF5Name,
+			},
+			"device": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Device the traffic group fails over to, by default the next device in its failover order",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that force a new failover when changed",
+			},
+			"active_device": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Device the traffic group is active on",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrafficGroupFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	trafficGroup := d.Get("traffic_group").(string)
+	device := d.Get("device").(string)
+
+	active, err := getTrafficGroupActiveDevice(client, trafficGroup)
+	if err != nil {
+		return fmt.Errorf("Error retrieving failover state of traffic group (%s): %s", trafficGroup, err)
+	}
+
+	if device != "" && sameDevice(active, device) {
+		log.Printf("[INFO] Traffic group %s is already active on %s, not failing over", trafficGroup, device)
+	} else {
+		args := "standby traffic-group " + trafficGroup
+		if device != "" {
+			args += " device " + device
+		}
+		log.Printf("[INFO] Failing over traffic group %s from %s", trafficGroup, active)
+		err = postEntity(client, map[string]string{"command": "run", "utilCmdArgs": args}, "sys", "failover")
+		if err != nil {
+			return fmt.Errorf("Error failing over traffic group (%s): %s", trafficGroup, err)
+		}
+
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			current, err := getTrafficGroupActiveDevice(client, trafficGroup)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if current == "" || sameDevice(current, active) || (device != "" && !sameDevice(current, device)) {
+				return resource.RetryableError(fmt.Errorf("traffic group %s is still active on %s", trafficGroup, current))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", trafficGroup, time.Now().Unix()))
+	return resourceBigipCmTrafficGroupFailoverRead(d, meta)
+}
+
+func resourceBigipCmTrafficGroupFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	trafficGroup := d.Get("traffic_group").(string)
+	active, err := getTrafficGroupActiveDevice(client, trafficGroup)
+	if err != nil {
+		return fmt.Errorf("Error retrieving failover state of traffic group (%s): %s", trafficGroup, err)
+	}
+	d.Set("active_device", active)
+	return nil
+}
+
+func resourceBigipCmTrafficGroupFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	// Nothing to undo, failing back is another failover
+	d.SetId("")
+	return nil
+}
+
+// getTrafficGroupActiveDevice returns the device a traffic group is active on, or "" while it is failing over
+func getTrafficGroupActiveDevice(client *bigip.BigIP, trafficGroup string) (string, error) {
+	var s stats
+	ok, err := getForEntity(client, &s, "cm", "traffic-group", trafficGroup, "stats")
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("traffic group %s not found", trafficGroup)
+	}
+	for _, e := range s.Entries {
+		if strings.EqualFold(e.NestedStats.Entries["failoverState"].Description, "active") {
+			return e.NestedStats.Entries["deviceName"].Description, nil
+		}
+	}
+	return "", nil
+}
+
+// sameDevice compares device names with or without their partition
+func sameDevice(a, b string) bool {
+	_, a = parseF5Identifier(a)
+	_, b = parseF5Identifier(b)
+	return a == b
+}
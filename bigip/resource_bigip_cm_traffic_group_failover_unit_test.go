@@ -0,0 +1,65 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipCmTrafficGroupFailover(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_cm_traffic_group_failover" "test-failover" {
+			traffic_group = "/Common/traffic-group-1"
+			device = "bigip2.example.com"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipCmTrafficGroupFailoverCreate(t *testing.T) {
+	active := "/Common/bigip1.example.com"
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/cm/traffic-group/~Common~traffic-group-1/stats", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/cm/traffic-group/~Common~traffic-group-1:~Common~bigip1.example.com/stats":{"nestedStats":{"entries":{
+			"deviceName":{"description":"%s"},
+			"failoverState":{"description":"active"},
+			"trafficGroup":{"description":"/Common/traffic-group-1"}}}}}}`, active)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/failover", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"command":"run","utilCmdArgs":"standby traffic-group /Common/traffic-group-1 device bigip2.example.com"}`, string(b))
+		active = "/Common/bigip2.example.com"
+		fmt.Fprintf(w, `{}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCmTrafficGroupFailover(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group_failover.test-failover", "active_device", "/Common/bigip2.example.com"),
+				),
+			},
+		},
+	})
+}
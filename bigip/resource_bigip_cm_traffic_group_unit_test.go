@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipCmTrafficGroup(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_cm_traffic_group" "test-tg" {
+			name = "/Common/test-tg"
+			ha_order = ["bigip2.example.com", "bigip1.example.com"]
+			auto_failback = true
+			auto_failback_time = 30
+			mac_masquerade = "02:01:d7:93:35:08"
+		}
+		resource "bigip_net_selfip" "test-floating" {
+			name = "/Common/test-floating"
+			ip = "11.1.1.3/24"
+			vlan = "/Common/test-vlan"
+			traffic_group = "${bigip_cm_traffic_group.test-tg.name}"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipCmTrafficGroupCreate(t *testing.T) {
+	setup()
+	defer teardown()
+	var created, selfIP map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/cm/traffic-group", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &created)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/traffic-group/~Common~test-tg", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = nil
+			return
+		}
+		if created == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-tg","fullPath":"/Common/test-tg","failoverMethod":"ha-order",
+			"haOrder":["/Common/bigip2.example.com","/Common/bigip1.example.com"],"haGroup":"none","haLoadFactor":1,
+			"autoFailbackEnabled":"true","autoFailbackTime":30,"mac":"02:01:d7:93:35:08"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &selfIP)
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/vlan/~Common~test-vlan", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-vlan"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self/~Common~test-floating", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			selfIP = nil
+			return
+		}
+		if selfIP == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-floating","fullPath":"/Common/test-floating","address":"11.1.1.3/24",
+			"vlan":"/Common/test-vlan","trafficGroup":"/Common/test-tg"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCmTrafficGroup(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "ha_order.0", "bigip2.example.com"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "ha_order.1", "bigip1.example.com"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "auto_failback", "true"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "ha_group", ""),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-floating", "traffic_group", "/Common/test-tg"),
+					func(*terraform.State) error {
+						assert.Equal(t, "true", created["autoFailbackEnabled"])
+						assert.Equal(t, "none", created["haGroup"])
+						assert.Equal(t, "02:01:d7:93:35:08", created["mac"])
+						assert.Equal(t, "/Common/test-tg", selfIP["trafficGroup"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The trust domain every BIG-IP belongs to
+const trustDomainRoot = "Root"
+
+type trustDomainCommand struct {
+	Command    string `json:"command"`
+	Name       string `json:"name"`
+	CaDevice   bool   `json:"caDevice,omitempty"`
+	Device     string `json:"device,omitempty"`
+	DeviceName string `json:"deviceName"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+}
+
+type trustDomain struct {
+	CaDevices    []string `json:"caDevices"`
+	NonCaDevices []string `json:"nonCaDevices"`
+}
+
+// bigip_cm_trust_domain_device adds a peer BIG-IP to the trust domain of the BIG-IP, the devices of a device
+// group must trust each other. The id of the resource is the name of the peer.
+func resourceBigipCmTrustDomainDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmTrustDomainDeviceCreate,
+		Read:   resourceBigipCmTrustDomainDeviceRead,
+		Delete: resourceBigipCmTrustDomainDeviceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the peer device, the hostname it reports, e.g. bigip2.example.com",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Management address the BIG-IP reaches the peer at",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Administrator of the peer",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Password of username",
+			},
+			"ca_device": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the peer is a certificate signing authority of the trust domain",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrustDomainDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("device_name").(string)
+	log.Printf("[INFO] Adding %s to the trust domain of %s", name, client.Host)
+
+	c := &trustDomainCommand{
+		Command:    "run",
+		Name:       trustDomainRoot,
+		CaDevice:   d.Get("ca_device").(bool),
+		Device:     d.Get("address").(string),
+		DeviceName: name,
+		Username:   d.Get("username").(string),
+		Password:   d.Get("password").(string),
+	}
+	if err := postEntity(client, c, "cm", "add-to-trust"); err != nil {
+		return fmt.Errorf("Error adding %s to the trust domain: %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipCmTrustDomainDeviceRead)
+}
+
+func resourceBigipCmTrustDomainDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var t trustDomain
+	if _, err := getForEntity(client, &t, "cm", "trust-domain", trustDomainRoot); err != nil {
+		log.Printf("[ERROR] Unable to retrieve the trust domain (%v)", err)
+		return err
+	}
+	for _, ca := range []bool{true, false} {
+		devices := t.NonCaDevices
+		if ca {
+			devices = t.CaDevices
+		}
+		for _, device := range devices {
+			if sameDevice(device, name) {
+				d.Set("device_name", name)
+				d.Set("ca_device", ca)
+				return nil
+			}
+		}
+	}
+	log.Printf("[WARN] %s is not in the trust domain, removing from state", name)
+	d.SetId("")
+	return nil
+}
+
+func resourceBigipCmTrustDomainDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Removing %s from the trust domain of %s", name, client.Host)
+
+	c := &trustDomainCommand{Command: "run", Name: trustDomainRoot, DeviceName: name}
+	if err := postEntity(client, c, "cm", "remove-from-trust"); err != nil {
+		log.Printf("[ERROR] Unable to remove %s from the trust domain (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipCmTrustDomainDevice(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_cm_trust_domain_device" "bigip2" {
+			device_name = "bigip2.example.com"
+			address = "10.192.74.2"
+			username = "admin"
+			password = "s3cret"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipCmTrustDomainDeviceCreate(t *testing.T) {
+	var added, removed string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/cm/add-to-trust", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		added = string(b)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/remove-from-trust", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		removed = string(b)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/trust-domain/Root", func(w http.ResponseWriter, r *http.Request) {
+		if added == "" || removed != "" {
+			fmt.Fprintf(w, `{"name":"Root","caDevices":["/Common/bigip1.example.com"]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"Root","caDevices":["/Common/bigip1.example.com","/Common/bigip2.example.com"]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCmTrustDomainDevice(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_trust_domain_device.bigip2", "id", "bigip2.example.com"),
+					resource.TestCheckResourceAttr("bigip_cm_trust_domain_device.bigip2", "ca_device", "true"),
+				),
+			},
+		},
+	})
+	assert.JSONEq(t, `{"command":"run","name":"Root","caDevice":true,"device":"10.192.74.2","deviceName":"bigip2.example.com",
+		"username":"admin","password":"s3cret"}`, added)
+	assert.JSONEq(t, `{"command":"run","name":"Root","deviceName":"bigip2.example.com"}`, removed)
+}
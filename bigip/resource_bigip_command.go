@@ -0,0 +1,166 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceBigipCommand is an action resource running tmsh or bash commands through util/bash, for the
+// settings no resource manages yet. Terraform does not know what the commands change: they run again only
+// when the resource is replaced, and are not undone unless destroy_commands are set.
+func resourceBigipCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCommandCreate,
+		Read:   resourceBigipCommandRead,
+		Update: resourceBigipCommandUpdate,
+		Delete: resourceBigipCommandDelete,
+
+		Schema: map[string]*schema.Schema{
+			"commands": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Commands run in order when the resource is created",
+			},
+			"shell": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "tmsh",
+				Description:  "How the commands are run, tmsh or bash",
+				ValidateFunc: validateStringValue([]string{"tmsh", "bash"}),
+			},
+			"expected_output": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateRegexp},
+				Description: "Regular expressions the output of the commands must match, by command, empty to not check a command",
+			},
+			"unless": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Command run first, the commands are skipped when it outputs anything",
+			},
+			"destroy_commands": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Commands run in order when the resource is destroyed",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that force the commands to run again when changed",
+			},
+			"outputs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Outputs of the commands",
+			},
+			"skipped": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the commands were skipped because the unless command output something",
+			},
+		},
+	}
+}
+
+func resourceBigipCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	shell := d.Get("shell").(string)
+	commands := listToStringSlice(d.Get("commands").([]interface{}))
+	// An empty expected output, not checking its command, is nil in the list
+	var expected []string
+	for _, e := range d.Get("expected_output").([]interface{}) {
+		s, _ := e.(string)
+		expected = append(expected, s)
+	}
+	if len(expected) > len(commands) {
+		return fmt.Errorf("expected_output has %d entries for %d commands", len(expected), len(commands))
+	}
+
+	d.SetId(fmt.Sprintf("command-%d", time.Now().UnixNano()))
+	d.Set("skipped", false)
+	d.Set("outputs", nil)
+
+	if unless := d.Get("unless").(string); unless != "" {
+		out, err := runCommand(client, shell, unless)
+		if err != nil {
+			d.SetId("")
+			return fmt.Errorf("Error running unless command (%s): %s", unless, err)
+		}
+		if strings.TrimSpace(out) != "" {
+			log.Printf("[INFO] Skipping the commands on %s, the unless command output %q", client.Host, out)
+			d.Set("skipped", true)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Running %d %s commands on %s, terraform does not track what they change", len(commands), shell, client.Host)
+	var outputs []string
+	for i, command := range commands {
+		out, err := runCommand(client, shell, command)
+		if err != nil {
+			d.SetId("")
+			return fmt.Errorf("Error running command (%s): %s", command, err)
+		}
+		outputs = append(outputs, out)
+		if i < len(expected) && expected[i] != "" && !regexp.MustCompile(expected[i]).MatchString(out) {
+			d.SetId("")
+			return fmt.Errorf("Output of command (%s) does not match %q: %s", command, expected[i], out)
+		}
+	}
+	d.Set("outputs", outputs)
+	return nil
+}
+
+func resourceBigipCommandRead(d *schema.ResourceData, meta interface{}) error {
+	// The commands ran once, there is nothing to read back
+	return nil
+}
+
+func resourceBigipCommandUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only destroy_commands can change without running the commands again, they are kept in the state
+	return resourceBigipCommandRead(d, meta)
+}
+
+func resourceBigipCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	shell := d.Get("shell").(string)
+	for _, command := range listToStringSlice(d.Get("destroy_commands").([]interface{})) {
+		log.Printf("[WARN] Running %s command on %s: %s", shell, client.Host, command)
+		if _, err := runCommand(client, shell, command); err != nil {
+			return fmt.Errorf("Error running destroy command (%s): %s", command, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// runCommand runs a tmsh or bash command and returns its output
+func runCommand(client *bigip.BigIP, shell, command string) (string, error) {
+	if shell == "tmsh" {
+		command = "tmsh " + command
+	}
+	return runBash(client, command)
+}
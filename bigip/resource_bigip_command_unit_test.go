@@ -0,0 +1,143 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipCommand(url, extra string) string {
+	return fmt.Sprintf(`
+		resource "bigip_command" "test-command" {
+			commands = [
+				"modify sys db ui.advisory.enabled value true",
+				"list sys db ui.advisory.enabled value",
+			]
+			expected_output = ["", "value \"?true"]
+			destroy_commands = ["modify sys db ui.advisory.text value 'it''s a lab'"]
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, extra, url)
+}
+
+// bashHandler answers util/bash with the output of each command, recording the commands run
+func bashHandler(t *testing.T, outputs map[string]string, run *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]string
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		*run = append(*run, body["utilCmdArgs"])
+		out, _ := json.Marshal(map[string]string{"command": "run", "commandResult": outputs[body["utilCmdArgs"]]})
+		w.Write(out)
+	}
+}
+
+func TestAccBigipCommandCreate(t *testing.T) {
+	var run []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/util/bash", bashHandler(t, map[string]string{
+		`-c 'tmsh list sys db ui.advisory.enabled value'`: "sys db ui.advisory.enabled {\n    value \"true\"\n}\n",
+	}, &run))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(*terraform.State) error {
+			assert.Equal(t, `-c 'tmsh modify sys db ui.advisory.text value '\''it'\'''\''s a lab'\'''`, run[len(run)-1])
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCommand(server.URL, `unless = "list sys db ui.advisory.enabled value | grep -w false >/dev/null || true"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_command.test-command", "skipped", "false"),
+					resource.TestCheckResourceAttr("bigip_command.test-command", "outputs.#", "2"),
+					resource.TestCheckResourceAttr("bigip_command.test-command", "outputs.1", "sys db ui.advisory.enabled {\n    value \"true\"\n}\n"),
+					func(*terraform.State) error {
+						assert.Equal(t, []string{
+							`-c 'tmsh list sys db ui.advisory.enabled value | grep -w false >/dev/null || true'`,
+							`-c 'tmsh modify sys db ui.advisory.enabled value true'`,
+							`-c 'tmsh list sys db ui.advisory.enabled value'`,
+						}, run)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipCommandUnless(t *testing.T) {
+	var run []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/util/bash", bashHandler(t, map[string]string{
+		`-c 'tmsh list sys db ui.advisory.enabled value | grep -w true'`: "    value \"true\"\n",
+	}, &run))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipCommand(server.URL, `unless = "list sys db ui.advisory.enabled value | grep -w true"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_command.test-command", "skipped", "true"),
+					resource.TestCheckResourceAttr("bigip_command.test-command", "outputs.#", "0"),
+					func(*terraform.State) error {
+						assert.Len(t, run, 1, "the commands are skipped")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipCommandUnexpectedOutput(t *testing.T) {
+	var run []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/util/bash", bashHandler(t, map[string]string{
+		`-c 'tmsh list sys db ui.advisory.enabled value'`: "01020036:3: The requested Database Variable (ui.advisory.enabled) was not found.\n",
+	}, &run))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipCommand(server.URL, ""),
+				ExpectError: regexp.MustCompile(`Output of command \(list sys db ui.advisory.enabled value\) does not match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The Declarative Onboarding endpoint, declarations are applied asynchronously and followed through their task
+var uriDo = []string{"mgmt", "shared", "declarative-onboarding"}
+
+// doTask is the task of a DO declaration
+type doTask struct {
+	ID     string `json:"id"`
+	Result struct {
+		Code    int      `json:"code"`
+		Status  string   `json:"status"`
+		Message string   `json:"message"`
+		Errors  []string `json:"errors"`
+	} `json:"result"`
+}
+
+// bigip_do applies a Declarative Onboarding declaration, onboarding a device, e.g. its license, provisioning,
+// network and clustering, in one step. DO declarations are not objects of the device, destroying the resource
+// leaves the onboarding as it is. The id of the resource is the task of the last declaration applied.
+func resourceBigipDo() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipDoCreate,
+		Read:   resourceBigipDoRead,
+		Update: resourceBigipDoUpdate,
+		Delete: resourceBigipDoDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"do_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "DO declaration, as JSON",
+				ValidateFunc:     validateDoJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+		},
+	}
+}
+
+func resourceBigipDoCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Applying DO declaration")
+	id, err := applyDoDeclaration(client, d.Get("do_json").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	return resourceBigipDoRead(d, meta)
+}
+
+// resourceBigipDoRead keeps the declaration applied, the device does not return it once the tasks of DO are
+// purged, e.g. after a reboot, and it remains onboarded.
+func resourceBigipDoRead(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Reading DO declaration " + d.Id())
+	return nil
+}
+
+func resourceBigipDoUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Applying DO declaration again")
+	id, err := applyDoDeclaration(client, d.Get("do_json").(string), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	return resourceBigipDoRead(d, meta)
+}
+
+func resourceBigipDoDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] DO declarations can't be removed, the device stays onboarded by task %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// applyDoDeclaration posts a DO declaration and waits for DO to apply it, it returns the id of its task
+func applyDoDeclaration(client *bigip.BigIP, doJSON string, timeout time.Duration) (string, error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(doJSON), &declaration); err != nil {
+		return "", fmt.Errorf("do_json is not valid JSON: %s", err)
+	}
+	if skip, err := reportDryRun(client, "POST", "/"+strings.Join(uriDo, "/"), declaration); skip || err != nil {
+		return "dry-run", err
+	}
+	var task doTask
+	err := postForEntity(client, declaration, &task, uriDo...)
+	if err == nil {
+		err = waitForDoTask(client, task.ID, timeout)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error applying DO declaration: %s", err)
+	}
+	return task.ID, nil
+}
+
+// waitForDoTask waits for a DO task to complete, and returns the message and errors DO reported when it failed.
+// The device may restart while it is onboarded, the task is polled again until the timeout when it can't be
+// read.
+func waitForDoTask(client *bigip.BigIP, id string, timeout time.Duration) error {
+	if id == "" {
+		return fmt.Errorf("DO returned no task id")
+	}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		var task doTask
+		ok, err := getForEntity(client, &task, append(uriDo, "task", id)...)
+		if err != nil {
+			log.Printf("[WARN] Unable to read DO task %s, the device may be restarting: %s", id, err)
+			return resource.RetryableError(err)
+		}
+		if !ok {
+			return resource.NonRetryableError(fmt.Errorf("task %s not found", id))
+		}
+		switch {
+		case task.Result.Status == "RUNNING" || task.Result.Status == "ROLLING_BACK" || task.Result.Code == 202:
+			return resource.RetryableError(fmt.Errorf("task %s is %s", id, strings.ToLower(task.Result.Status)))
+		case task.Result.Code >= 300 || task.Result.Status == "ERROR":
+			failure := fmt.Sprintf("task %s failed: %s", id, task.Result.Message)
+			if len(task.Result.Errors) > 0 {
+				failure += ": " + strings.Join(task.Result.Errors, ", ")
+			}
+			return resource.NonRetryableError(errors.New(failure))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipDo(url, hostname string) string {
+	return fmt.Sprintf(`
+		resource "bigip_do" "onboard" {
+			do_json = <<EOF
+{
+	"class": "Device",
+	"schemaVersion": "1.0.0",
+	"Common": {
+		"class": "Tenant",
+		"hostname": "%s",
+		"provisioning": {"class": "Provision", "ltm": "nominal", "asm": "nominal"}
+	}
+}
+EOF
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, hostname, url)
+}
+
+// doHandler answers the DO endpoint, its tasks run for one poll and then end with result
+func doHandler(t *testing.T, declarations *[]string, result string) http.HandlerFunc {
+	polls := map[string]int{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/shared/declarative-onboarding" {
+			assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+			b, _ := ioutil.ReadAll(r.Body)
+			*declarations = append(*declarations, string(b))
+			w.WriteHeader(202)
+			fmt.Fprintf(w, `{"id":"task-%d","result":{"class":"Result","code":202,"status":"RUNNING","message":"processing"}}`, len(*declarations))
+			return
+		}
+		var id string
+		fmt.Sscanf(r.URL.Path, "/mgmt/shared/declarative-onboarding/task/%s", &id)
+		polls[id]++
+		if polls[id] == 1 {
+			fmt.Fprintf(w, `{"id":"%s","result":{"class":"Result","code":202,"status":"RUNNING","message":"processing"}}`, id)
+			return
+		}
+		fmt.Fprintf(w, `{"id":"%s","result":%s}`, id, result)
+	}
+}
+
+func TestAccBigipDoApply(t *testing.T) {
+	var declarations []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	handler := doHandler(t, &declarations, `{"class":"Result","code":200,"status":"OK","message":"success"}`)
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding", handler)
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", handler)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipDo(server.URL, "bigip1.example.com"),
+				Check:  resource.TestCheckResourceAttr("bigip_do.onboard", "id", "task-1"),
+			},
+			{
+				Config: testBigipDo(server.URL, "bigip2.example.com"),
+				Check:  resource.TestCheckResourceAttr("bigip_do.onboard", "id", "task-2"),
+			},
+		},
+	})
+	if assert.Len(t, declarations, 2) {
+		assert.Contains(t, declarations[1], `"hostname":"bigip2.example.com"`)
+	}
+}
+
+func TestAccBigipDoFailure(t *testing.T) {
+	var declarations []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	handler := doHandler(t, &declarations, `{"class":"Result","code":422,"status":"ERROR","message":"invalid config - rolled back","errors":["01071029:5: Master Key not present"]}`)
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding", handler)
+	mux.HandleFunc("/mgmt/shared/declarative-onboarding/", handler)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipDo(server.URL, "bigip1.example.com"),
+				ExpectError: regexp.MustCompile("task task-1 failed: invalid config - rolled back: 01071029:5: Master Key not present"),
+			},
+		},
+	})
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The FAST endpoints, applications are deployed and deleted asynchronously and followed through their task
+var uriFastApplications = []string{"mgmt", "shared", "fast", "applications"}
+var uriFastTasks = []string{"mgmt", "shared", "fast", "tasks"}
+
+// fastTask is the task of an application deployed or deleted
+type fastTask struct {
+	ID          string `json:"id"`
+	Code        int    `json:"code"`
+	Message     string `json:"message"`
+	Tenant      string `json:"tenant"`
+	Application string `json:"application"`
+}
+
+// fastTaskResponse is the response to a deploy or a delete, FAST answers the task id in its message, a list of
+// the tasks of the applications posted, or at the top of the response
+type fastTaskResponse struct {
+	ID      string          `json:"id"`
+	Message json.RawMessage `json:"message"`
+}
+
+// fastApplication is the part of an application FAST deployed it keeps track of, its template and parameters
+type fastApplication struct {
+	Constants struct {
+		Fast struct {
+			Template string                 `json:"template"`
+			View     map[string]interface{} `json:"view"`
+		} `json:"fast"`
+	} `json:"constants"`
+}
+
+// bigip_fast_application deploys a FAST application, rendering a template with parameters. The application is
+// the unit Terraform manages: its id is <tenant>/<application>, as FAST reports them once deployed, and the
+// parameters are read back from the application to detect drift.
+func resourceBigipFastApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipFastApplicationCreate,
+		Read:   resourceBigipFastApplicationRead,
+		Update: resourceBigipFastApplicationUpdate,
+		Delete: resourceBigipFastApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Template the application is rendered with, as <set>/<template>",
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				Description:      "Parameters of the template, as a JSON object",
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"tenant": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tenant of the application",
+			},
+			"application": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the application",
+			},
+		},
+	}
+}
+
+func resourceBigipFastApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	template := d.Get("template").(string)
+	log.Println("[INFO] Deploying FAST application of template " + template)
+	id, err := deployFastApplication(client, d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	if _, ok := dryRunClients.Load(client); ok {
+		return nil
+	}
+	return resourceBigipFastApplicationRead(d, meta)
+}
+
+func resourceBigipFastApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := d.Id()
+	log.Println("[INFO] Reading FAST application " + id)
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("FAST application id %q is not <tenant>/<application>", id)
+	}
+	var app fastApplication
+	ok, err := getForEntity(client, &app, append(uriFastApplications, parts...)...)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve FAST application (%s) (%v)", id, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] FAST application (%s) not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+	d.Set("tenant", parts[0])
+	d.Set("application", parts[1])
+	if app.Constants.Fast.Template != "" {
+		d.Set("template", app.Constants.Fast.Template)
+	}
+
+	// The view holds every parameter of the template, defaults included; only the parameters configured are
+	// compared, all of them once imported
+	view := app.Constants.Fast.View
+	parameters := map[string]interface{}{}
+	json.Unmarshal([]byte(d.Get("parameters").(string)), &parameters)
+	if len(parameters) == 0 {
+		parameters = view
+	}
+	for k := range parameters {
+		if v, ok := view[k]; ok {
+			parameters[k] = v
+		}
+	}
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return err
+	}
+	d.Set("parameters", string(b))
+	return nil
+}
+
+func resourceBigipFastApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	previous := d.Id()
+	log.Println("[INFO] Deploying FAST application again " + previous)
+	id, err := deployFastApplication(client, d, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	if _, ok := dryRunClients.Load(client); ok {
+		return nil
+	}
+	// New parameters may name another tenant or application, FAST then deploys a new application
+	if id != previous {
+		log.Printf("[INFO] FAST application %s is now %s, deleting %s", previous, id, previous)
+		if err := deleteFastApplication(client, previous, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	d.SetId(id)
+	return resourceBigipFastApplicationRead(d, meta)
+}
+
+func resourceBigipFastApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := d.Id()
+	log.Println("[INFO] Deleting FAST application " + id)
+	if err := deleteFastApplication(client, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		log.Printf("[ERROR] Unable to Delete FAST application (%s) (%v) ", id, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// deployFastApplication posts the application of the resource, waits for FAST to deploy it, and returns its id
+func deployFastApplication(client *bigip.BigIP, d *schema.ResourceData, timeout time.Duration) (string, error) {
+	template := d.Get("template").(string)
+	parameters := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(d.Get("parameters").(string)), &parameters); err != nil {
+		return "", fmt.Errorf("parameters is not valid JSON: %s", err)
+	}
+	body := map[string]interface{}{"name": template, "parameters": parameters}
+
+	if skip, err := reportDryRun(client, "POST", "/"+strings.Join(uriFastApplications, "/"), body); skip || err != nil {
+		// FAST names the application once rendered, the dry run keeps the id of the state when there is one
+		id := d.Id()
+		if id == "" {
+			tenant, _ := parameters["tenant_name"].(string)
+			app, _ := parameters["app_name"].(string)
+			id = fmt.Sprintf("%s/%s", tenant, app)
+		}
+		return id, err
+	}
+	var res fastTaskResponse
+	err := postForEntity(client, body, &res, uriFastApplications...)
+	var task *fastTask
+	if err == nil {
+		task, err = waitForFastTask(client, res.taskID(), timeout)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error deploying FAST application of template %s: %s", template, err)
+	}
+	if task.Tenant == "" || task.Application == "" {
+		return "", fmt.Errorf("FAST did not report the tenant and application of template %s", template)
+	}
+	return task.Tenant + "/" + task.Application, nil
+}
+
+// deleteFastApplication deletes an application and waits for FAST to delete it
+func deleteFastApplication(client *bigip.BigIP, id string, timeout time.Duration) error {
+	path := append(uriFastApplications, strings.SplitN(id, "/", 2)...)
+	if skip, err := reportDryRun(client, "DELETE", "/"+strings.Join(path, "/"), nil); skip || err != nil {
+		return err
+	}
+	var res fastTaskResponse
+	resp, err := client.APICall(&bigip.APIRequest{
+		Method: "delete",
+		URL:    iControlPath(path),
+	})
+	if err == nil {
+		if err = json.Unmarshal(resp, &res); err == nil {
+			_, err = waitForFastTask(client, res.taskID(), timeout)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting FAST application (%s): %s", id, err)
+	}
+	return nil
+}
+
+// taskID returns the id of the task FAST answered, from the first task of its message when it is a list
+func (r fastTaskResponse) taskID() string {
+	if r.ID != "" {
+		return r.ID
+	}
+	var tasks []fastTask
+	if json.Unmarshal(r.Message, &tasks) == nil && len(tasks) > 0 {
+		return tasks[0].ID
+	}
+	var task fastTask
+	json.Unmarshal(r.Message, &task)
+	return task.ID
+}
+
+// waitForFastTask waits for a FAST task to complete, and returns it, or the message of its failure
+func waitForFastTask(client *bigip.BigIP, id string, timeout time.Duration) (*fastTask, error) {
+	if id == "" {
+		return nil, fmt.Errorf("FAST returned no task id")
+	}
+	var task fastTask
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		if _, err := getForEntity(client, &task, append(uriFastTasks, id)...); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch {
+		case task.Code == 0 || task.Message == "in progress" || task.Message == "pending":
+			return resource.RetryableError(fmt.Errorf("task %s is in progress", id))
+		case task.Code >= 300:
+			return resource.NonRetryableError(fmt.Errorf("task %s failed: %s", id, task.Message))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipFastApplication(url, template, app, address string) string {
+	return fmt.Sprintf(`
+		resource "bigip_fast_application" "web" {
+			template = "%s"
+			parameters = <<EOF
+{
+	"tenant_name": "Tenant",
+	"app_name": "%s",
+	"virtual_address": "%s"
+}
+EOF
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, template, app, address, url)
+}
+
+// fastDevice is a FAST mock, its tasks are in progress for one poll and then deploy or delete their application.
+// Only the examples/simple_http template is installed, it defaults virtual_port to 80.
+type fastDevice struct {
+	t     *testing.T
+	apps  map[string]map[string]interface{}
+	tasks map[string]func() fastTask
+	polls map[string]int
+	posts int
+}
+
+func newFastDevice(t *testing.T) *fastDevice {
+	return &fastDevice{
+		t:     t,
+		apps:  map[string]map[string]interface{}{},
+		tasks: map[string]func() fastTask{},
+		polls: map[string]int{},
+	}
+}
+
+func (f *fastDevice) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(r.URL.Path, "/mgmt/shared/fast/")
+	switch {
+	case path == "applications" && r.Method == "POST":
+		f.posts++
+		var body struct {
+			Name       string                 `json:"name"`
+			Parameters map[string]interface{} `json:"parameters"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		id := fmt.Sprintf("task-%d", f.posts)
+		f.tasks[id] = func() fastTask {
+			if body.Name != "examples/simple_http" {
+				return fastTask{ID: id, Code: 404, Message: "Error: Could not find template: " + body.Name}
+			}
+			tenant, app := body.Parameters["tenant_name"].(string), body.Parameters["app_name"].(string)
+			view := map[string]interface{}{"virtual_port": 80}
+			for k, v := range body.Parameters {
+				view[k] = v
+			}
+			f.apps[tenant+"/"+app] = map[string]interface{}{"template": body.Name, "view": view}
+			return fastTask{ID: id, Code: 200, Message: "success", Tenant: tenant, Application: app}
+		}
+		w.WriteHeader(202)
+		fmt.Fprintf(w, `{"code":202,"message":[{"id":"%s","name":"%s"}]}`, id, body.Name)
+	case strings.HasPrefix(path, "applications/") && r.Method == "DELETE":
+		app := strings.TrimPrefix(path, "applications/")
+		id := fmt.Sprintf("delete-%d", len(f.tasks))
+		f.tasks[id] = func() fastTask {
+			delete(f.apps, app)
+			return fastTask{ID: id, Code: 200, Message: "success"}
+		}
+		w.WriteHeader(202)
+		fmt.Fprintf(w, `{"id":"%s","code":202,"message":""}`, id)
+	case strings.HasPrefix(path, "applications/"):
+		assert.Equal(f.t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		app, ok := f.apps[strings.TrimPrefix(path, "applications/")]
+		if !ok {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		b, _ := json.Marshal(map[string]interface{}{
+			"class":     "Application",
+			"constants": map[string]interface{}{"class": "Constants", "fast": app},
+		})
+		w.Write(b)
+	case strings.HasPrefix(path, "tasks/"):
+		id := strings.TrimPrefix(path, "tasks/")
+		f.polls[id]++
+		task := fastTask{ID: id, Message: "in progress"}
+		if f.polls[id] > 1 {
+			task = f.tasks[id]()
+		}
+		b, _ := json.Marshal(task)
+		w.Write(b)
+	default:
+		w.WriteHeader(404)
+		fmt.Fprint(w, `{"code":404,"message":"not found"}`)
+	}
+}
+
+func TestAccBigipFastApplication(t *testing.T) {
+	fast := newFastDevice(t)
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/shared/fast/", fast)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(fast.apps) > 0 {
+				return fmt.Errorf("FAST applications %v were not deleted", fast.apps)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipFastApplication(server.URL, "examples/simple_http", "web", "10.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_fast_application.web", "id", "Tenant/web"),
+					resource.TestCheckResourceAttr("bigip_fast_application.web", "tenant", "Tenant"),
+					resource.TestCheckResourceAttr("bigip_fast_application.web", "application", "web"),
+				),
+			},
+			{
+				// The application was changed on the device, it is deployed again
+				PreConfig: func() { fast.apps["Tenant/web"]["view"].(map[string]interface{})["virtual_address"] = "10.9.9.9" },
+				Config:    testBigipFastApplication(server.URL, "examples/simple_http", "web", "10.1.1.1"),
+				Check: func(s *terraform.State) error {
+					if fast.posts != 2 {
+						return fmt.Errorf("FAST application was deployed %d times, not 2", fast.posts)
+					}
+					return nil
+				},
+			},
+			{
+				// Renaming the application deploys the new one and deletes the previous one
+				Config: testBigipFastApplication(server.URL, "examples/simple_http", "web2", "10.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_fast_application.web", "id", "Tenant/web2"),
+					func(s *terraform.State) error {
+						if _, ok := fast.apps["Tenant/web"]; ok {
+							return fmt.Errorf("FAST application Tenant/web was not deleted")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:            testBigipFastApplication(server.URL, "examples/simple_http", "web2", "10.1.1.1"),
+				ResourceName:      "bigip_fast_application.web",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported, the parameters are the whole view, defaults included
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func TestAccBigipFastApplicationFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/shared/fast/", newFastDevice(t))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipFastApplication(server.URL, "examples/missing", "web", "10.1.1.1"),
+				ExpectError: regexp.MustCompile("Error deploying FAST application of template examples/missing: task task-1 failed: Error: Could not find template: examples/missing"),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The FAST template sets, installed from a zip file uploaded to the device
+var uriFastTemplateSets = []string{"mgmt", "shared", "fast", "templatesets"}
+var uriFileTransferUploads = []string{"mgmt", "shared", "file-transfer", "uploads"}
+
+type fastTemplateSet struct {
+	Name      string `json:"name"`
+	Hash      string `json:"hash"`
+	Templates []struct {
+		Name string `json:"name"`
+	} `json:"templates"`
+}
+
+// bigip_fast_template_set uploads a FAST template set, a zip file of templates, and installs it. Installing it
+// again, when its source changes, replaces the templates of the set.
+func resourceBigipFastTemplateSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipFastTemplateSetCreate,
+		Read:   resourceBigipFastTemplateSetRead,
+		Update: resourceBigipFastTemplateSetUpdate,
+		Delete: resourceBigipFastTemplateSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the template set",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the zip file of the template set",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, e.g. the hash of the zip file, that installs the template set again when changed",
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash FAST computed for the templates of the set",
+			},
+			"templates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Templates of the set, as <set>/<template>",
+			},
+		},
+	}
+}
+
+func resourceBigipFastTemplateSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Installing FAST template set " + name)
+	if err := installFastTemplateSet(client, name, d.Get("source").(string)); err != nil {
+		return err
+	}
+	d.SetId(name)
+	return resourceBigipFastTemplateSetRead(d, meta)
+}
+
+func resourceBigipFastTemplateSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading FAST template set " + name)
+	var set fastTemplateSet
+	ok, err := getForEntity(client, &set, append(uriFastTemplateSets, name)...)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve FAST template set (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] FAST template set (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	templates := []string{}
+	for _, t := range set.Templates {
+		templates = append(templates, t.Name)
+	}
+	d.Set("name", name)
+	d.Set("hash", set.Hash)
+	d.Set("templates", templates)
+	return nil
+}
+
+func resourceBigipFastTemplateSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Installing FAST template set again " + name)
+	if err := installFastTemplateSet(client, name, d.Get("source").(string)); err != nil {
+		return err
+	}
+	return resourceBigipFastTemplateSetRead(d, meta)
+}
+
+func resourceBigipFastTemplateSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting FAST template set " + name)
+	if err := deleteEntity(client, append(uriFastTemplateSets, name)...); err != nil {
+		log.Printf("[ERROR] Unable to Delete FAST template set (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// installFastTemplateSet uploads the zip file of a template set, when there is one, and installs the set. A set
+// without a source must already be uploaded, or be one of the sets FAST ships with.
+func installFastTemplateSet(client *bigip.BigIP, name, source string) error {
+	if source != "" {
+		data, err := ioutil.ReadFile(source)
+		if err != nil {
+			return fmt.Errorf("Error reading FAST template set (%s): %s", name, err)
+		}
+		path := append(uriFileTransferUploads, name+".zip")
+		skip, err := reportDryRun(client, "POST", "/"+strings.Join(path, "/"), map[string]string{"source": source})
+		if err != nil {
+			return err
+		}
+		if !skip {
+			if _, err := client.UploadBytes(data, name+".zip"); err != nil {
+				return fmt.Errorf("Error uploading FAST template set (%s): %s", name, err)
+			}
+		}
+	}
+	if err := postEntity(client, map[string]string{"name": name}, uriFastTemplateSets...); err != nil {
+		return fmt.Errorf("Error installing FAST template set (%s): %s", name, err)
+	}
+	return nil
+}
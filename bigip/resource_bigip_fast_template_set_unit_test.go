@@ -0,0 +1,119 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipFastTemplateSet(url, source, hash string) string {
+	return fmt.Sprintf(`
+		resource "bigip_fast_template_set" "web" {
+			name = "web_templates"
+			source = "%s"
+			source_hash = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, source, hash, url)
+}
+
+func TestAccBigipFastTemplateSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "web_templates.zip")
+	if err := ioutil.WriteFile(source, []byte("PK templates"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var uploads, installs int
+	installed := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/web_templates.zip", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "PK templates", string(b))
+		uploads++
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/shared/fast/templatesets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "web_templates", body["name"])
+		installs++
+		installed = true
+		fmt.Fprintf(w, `{"code":200,"message":""}`)
+	})
+	mux.HandleFunc("/mgmt/shared/fast/templatesets/web_templates", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			installed = false
+			return
+		}
+		if !installed {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"web_templates","hash":"h%d","templates":[{"name":"web_templates/http"},{"name":"web_templates/https"}]}`, installs)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if installed {
+				return fmt.Errorf("FAST template set web_templates was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipFastTemplateSet(server.URL, source, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_fast_template_set.web", "id", "web_templates"),
+					resource.TestCheckResourceAttr("bigip_fast_template_set.web", "hash", "h1"),
+					resource.TestCheckResourceAttr("bigip_fast_template_set.web", "templates.#", "2"),
+					resource.TestCheckResourceAttr("bigip_fast_template_set.web", "templates.1", "web_templates/https"),
+				),
+			},
+			{
+				// A new source hash uploads and installs the template set again
+				Config: testBigipFastTemplateSet(server.URL, source, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_fast_template_set.web", "hash", "h2"),
+					func(s *terraform.State) error {
+						if uploads != 2 {
+							return fmt.Errorf("FAST template set was uploaded %d times, not 2", uploads)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriDatacenter = "datacenter"
+
+type gtmDatacenter struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Location    string `json:"location,omitempty"`
+	Contact     string `json:"contact,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+}
+
+func resourceBigipGtmDatacenter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmDatacenterCreate,
+		Update: resourceBigipGtmDatacenterUpdate,
+		Read:   resourceBigipGtmDatacenterRead,
+		Delete: resourceBigipGtmDatacenterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM datacenter",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Where the datacenter is",
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Who to contact about the datacenter",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipGtmDatacenterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating GTM datacenter " + name)
+
+	dc := getGtmDatacenterConfig(d)
+	dc.Name = name
+	err := postEntity(client, dc, uriGtm, uriDatacenter)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM datacenter (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmDatacenterRead)
+}
+
+func resourceBigipGtmDatacenterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getGtmDatacenterConfig(d), uriGtm, uriDatacenter, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM datacenter (%s): %s", name, err)
+	}
+	return resourceBigipGtmDatacenterRead(d, meta)
+}
+
+func resourceBigipGtmDatacenterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var dc gtmDatacenter
+	ok, err := getForEntity(client, &dc, uriGtm, uriDatacenter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM datacenter (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM datacenter (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", dc.Description)
+	d.Set("location", dc.Location)
+	d.Set("contact", dc.Contact)
+	if dc.Disabled {
+		d.Set("state", "disabled")
+	} else {
+		d.Set("state", "enabled")
+	}
+
+	return nil
+}
+
+func resourceBigipGtmDatacenterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM datacenter " + name)
+
+	err := deleteEntity(client, uriGtm, uriDatacenter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM datacenter (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmDatacenterConfig(d *schema.ResourceData) *gtmDatacenter {
+	dc := &gtmDatacenter{
+		Description: d.Get("description").(string),
+		Location:    d.Get("location").(string),
+		Contact:     d.Get("contact").(string),
+	}
+	if d.Get("state").(string) == "disabled" {
+		dc.Disabled = true
+	} else {
+		dc.Enabled = true
+	}
+	return dc
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_DATACENTER_NAME = fmt.Sprintf("/%s/test-datacenter", TEST_PARTITION)
+
+var TEST_GTM_DATACENTER_RESOURCE = `
+resource "bigip_gtm_datacenter" "test-datacenter" {
+	name = "` + TEST_GTM_DATACENTER_NAME + `"
+	description = "test datacenter"
+	location = "Amsterdam"
+	contact = "noc@example.com"
+}
+`
+
+func TestAccBigipGtmDatacenter_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmDatacenterDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_DATACENTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmDatacenterExists(TEST_GTM_DATACENTER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "name", TEST_GTM_DATACENTER_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "description", "test datacenter"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "location", "Amsterdam"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "contact", "noc@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmDatacenter_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmDatacenterDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_DATACENTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmDatacenterExists(TEST_GTM_DATACENTER_NAME, true),
+				),
+				ResourceName:      TEST_GTM_DATACENTER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmDatacenterExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmDatacenter
+		ok, err := getForEntity(client, &p, uriGtm, uriDatacenter, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM datacenter %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM datacenter %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmDatacenterDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_datacenter" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmDatacenter
+		ok, err := getForEntity(client, &p, uriGtm, uriDatacenter, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM datacenter %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriGtmMonitor = "monitor"
+
+type gtmMonitor struct {
+	Name                   string `json:"name,omitempty"`
+	DefaultsFrom           string `json:"defaultsFrom,omitempty"`
+	Description            string `json:"description,omitempty"`
+	Destination            string `json:"destination,omitempty"`
+	Interval               int    `json:"interval,omitempty"`
+	Timeout                int    `json:"timeout,omitempty"`
+	ProbeTimeout           int    `json:"probeTimeout,omitempty"`
+	IgnoreDownResponse     string `json:"ignoreDownResponse,omitempty"`
+	Send                   string `json:"send,omitempty"`
+	Recv                   string `json:"recv,omitempty"`
+	Reverse                string `json:"reverse,omitempty"`
+	Transparent            string `json:"transparent,omitempty"`
+	Cipherlist             string `json:"cipherlist,omitempty"`
+	Cert                   string `json:"cert,omitempty"`
+	Key                    string `json:"key,omitempty"`
+	AggregateDynamicRatios string `json:"aggregateDynamicRatios,omitempty"`
+}
+
+func resourceBigipGtmMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmMonitorCreate,
+		Update: resourceBigipGtmMonitorUpdate,
+		Read:   resourceBigipGtmMonitorRead,
+		Delete: resourceBigipGtmMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipGtmMonitorImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM monitor",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Kind of monitor: http, https, tcp or bigip",
+				ValidateFunc: validateStringValue([]string{"http", "https", "tcp", "bigip"}),
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Existing GTM monitor of the same type to inherit from, defaults to the built-in one, e.g. /Common/http",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address and port checked, *:* checks the address and port of the monitored object",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Check interval in seconds",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds without a successful check before the monitored object is marked down",
+			},
+			"probe_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds to wait for the response to a check",
+			},
+			"ignore_down_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether a down response is ignored until the timeout expires",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"send": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Request string to send, http, https and tcp monitors only",
+				StateFunc: func(s interface{}) string {
+					return strings.Replace(s.(string), "\r\n", "\\r\\n", -1)
+				},
+			},
+			"receive": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Expected response string, http, https and tcp monitors only",
+			},
+			"reverse": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether a matching response marks the monitored object down, http, https and tcp monitors only",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"transparent": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the check is sent through the monitored object to the destination, http, https and tcp monitors only",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"cipherlist": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OpenSSL cipher string, https monitors only",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client certificate presented to the server, https monitors only",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the client certificate, https monitors only",
+			},
+			"aggregate_dynamic_ratios": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How the dynamic ratios of the monitored BIG-IP are aggregated, bigip monitors only: none, average-nodes, sum-nodes, average-members or sum-members",
+				ValidateFunc: validateStringValue([]string{"none", "average-nodes", "sum-nodes", "average-members", "sum-members"}),
+			},
+		},
+	}
+}
+
+func resourceBigipGtmMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	monitorType := d.Get("type").(string)
+	log.Printf("[INFO] Creating GTM monitor %s (%s)", name, monitorType)
+
+	m := getGtmMonitorConfig(d)
+	m.Name = name
+	m.DefaultsFrom = d.Get("defaults_from").(string)
+	err := postEntity(client, m, uriGtm, uriGtmMonitor, monitorType)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM monitor (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmMonitorRead)
+}
+
+func resourceBigipGtmMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getGtmMonitorConfig(d), uriGtm, uriGtmMonitor, d.Get("type").(string), name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM monitor (%s): %s", name, err)
+	}
+	return resourceBigipGtmMonitorRead(d, meta)
+}
+
+func resourceBigipGtmMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var m gtmMonitor
+	ok, err := getForEntity(client, &m, uriGtm, uriGtmMonitor, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM monitor (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM monitor (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", m.DefaultsFrom)
+	d.Set("description", m.Description)
+	d.Set("destination", m.Destination)
+	d.Set("interval", m.Interval)
+	d.Set("timeout", m.Timeout)
+	d.Set("probe_timeout", m.ProbeTimeout)
+	d.Set("ignore_down_response", m.IgnoreDownResponse)
+	d.Set("send", m.Send)
+	d.Set("receive", m.Recv)
+	d.Set("reverse", m.Reverse)
+	d.Set("transparent", m.Transparent)
+	d.Set("cipherlist", m.Cipherlist)
+	d.Set("cert", m.Cert)
+	d.Set("key", m.Key)
+	d.Set("aggregate_dynamic_ratios", m.AggregateDynamicRatios)
+	return nil
+}
+
+func resourceBigipGtmMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM monitor " + name)
+
+	err := deleteEntity(client, uriGtm, uriGtmMonitor, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM monitor (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipGtmMonitorImport takes an id of the form <type>:<full path>, e.g. http:/Common/my-monitor
+func resourceBigipGtmMonitorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected an id of the form <type>:<full path>, got %s", d.Id())
+	}
+	d.Set("type", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func getGtmMonitorConfig(d *schema.ResourceData) *gtmMonitor {
+	return &gtmMonitor{
+		Description:            d.Get("description").(string),
+		Destination:            d.Get("destination").(string),
+		Interval:               d.Get("interval").(int),
+		Timeout:                d.Get("timeout").(int),
+		ProbeTimeout:           d.Get("probe_timeout").(int),
+		IgnoreDownResponse:     d.Get("ignore_down_response").(string),
+		Send:                   d.Get("send").(string),
+		Recv:                   d.Get("receive").(string),
+		Reverse:                d.Get("reverse").(string),
+		Transparent:            d.Get("transparent").(string),
+		Cipherlist:             d.Get("cipherlist").(string),
+		Cert:                   d.Get("cert").(string),
+		Key:                    d.Get("key").(string),
+		AggregateDynamicRatios: d.Get("aggregate_dynamic_ratios").(string),
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_MONITOR_NAME = fmt.Sprintf("/%s/test-gtm-monitor", TEST_PARTITION)
+
+var TEST_GTM_MONITOR_RESOURCE = `
+resource "bigip_gtm_monitor" "test-gtm-monitor" {
+	name = "` + TEST_GTM_MONITOR_NAME + `"
+	type = "http"
+	defaults_from = "/Common/http"
+	interval = 10
+	timeout = 31
+	send = "GET /health\\r\\n"
+	receive = "200 OK"
+}
+`
+
+func TestAccBigipGtmMonitor_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmMonitorDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_MONITOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmMonitorExists(TEST_GTM_MONITOR_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "name", TEST_GTM_MONITOR_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "type", "http"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "defaults_from", "/Common/http"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "interval", "10"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "timeout", "31"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "send", "GET /health\\r\\n"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "receive", "200 OK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmMonitor_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmMonitorDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_MONITOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmMonitorExists(TEST_GTM_MONITOR_NAME, true),
+				),
+				ResourceName:      TEST_GTM_MONITOR_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmMonitorExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmMonitor
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmMonitor, "http", name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM monitor %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM monitor %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmMonitorDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_monitor" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmMonitor
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmMonitor, "http", name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM monitor %s not destroyed.", name)
+		}
+	}
+	return nil
+}
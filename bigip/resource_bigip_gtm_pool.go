@@ -0,0 +1,416 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	uriGtmPool = "pool"
+	uriMembers = "members"
+)
+
+type gtmPool struct {
+	Name                     string `json:"name,omitempty"`
+	Partition                string `json:"partition,omitempty"`
+	SubPath                  string `json:"subPath,omitempty"`
+	Description              string `json:"description,omitempty"`
+	LoadBalancingMode        string `json:"loadBalancingMode,omitempty"`
+	AlternateMode            string `json:"alternateMode,omitempty"`
+	FallbackMode             string `json:"fallbackMode,omitempty"`
+	FallbackIp               string `json:"fallbackIp,omitempty"`
+	MaxAnswersReturned       int    `json:"maxAnswersReturned,omitempty"`
+	Ttl                      int    `json:"ttl"`
+	VerifyMemberAvailability string `json:"verifyMemberAvailability,omitempty"`
+	Monitor                  string `json:"monitor,omitempty"`
+	QosHitRatio              int    `json:"qosHitRatio"`
+	QosHops                  int    `json:"qosHops"`
+	QosKilobytesSecond       int    `json:"qosKilobytesSecond"`
+	QosLcs                   int    `json:"qosLcs"`
+	QosPacketRate            int    `json:"qosPacketRate"`
+	QosRtt                   int    `json:"qosRtt"`
+	QosTopology              int    `json:"qosTopology"`
+	QosVsCapacity            int    `json:"qosVsCapacity"`
+	QosVsScore               int    `json:"qosVsScore"`
+	Enabled                  bool   `json:"enabled,omitempty"`
+	Disabled                 bool   `json:"disabled,omitempty"`
+}
+
+type gtmPoolMember struct {
+	Name         string `json:"name,omitempty"`
+	Partition    string `json:"partition,omitempty"`
+	MemberOrder  int    `json:"memberOrder,omitempty"`
+	Ratio        int    `json:"ratio,omitempty"`
+	StaticTarget string `json:"staticTarget,omitempty"`
+	Enabled      bool   `json:"enabled,omitempty"`
+	Disabled     bool   `json:"disabled,omitempty"`
+}
+
+func resourceBigipGtmPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmPoolCreate,
+		Update: resourceBigipGtmPoolUpdate,
+		Read:   resourceBigipGtmPoolRead,
+		Delete: resourceBigipGtmPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipGtmPoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM pool",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Record type of the pool: a, aaaa, cname or mx",
+				ValidateFunc: validateStringValue([]string{"a", "aaaa", "cname", "mx"}),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"load_balancing_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "round-robin",
+				Description: "Preferred load balancing method, e.g. round-robin, ratio, topology or global-availability",
+			},
+			"alternate_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "round-robin",
+				Description: "Load balancing method used when the preferred method fails",
+			},
+			"fallback_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "return-to-dns",
+				Description: "Load balancing method used when the preferred and alternate methods fail",
+			},
+			"fallback_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address answered with when fallback_mode is fallback-ip",
+			},
+			"max_answers_returned": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Maximum number of members returned in a response",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Time to live in seconds of the answers",
+			},
+			"verify_member_availability": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "To enable _ disable checking the availability of members before answering with them",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Health monitor rule of the pool, e.g. /Common/gateway_icmp",
+			},
+			"qos_hit_ratio": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "Weight of the hit ratio of the virtual server in the QoS load balancing method",
+			},
+			"qos_hops": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Weight of the number of router hops to the client in the QoS load balancing method",
+			},
+			"qos_kilobytes_second": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Weight of the throughput of the virtual server in the QoS load balancing method",
+			},
+			"qos_lcs": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Weight of the link capacity in the QoS load balancing method",
+			},
+			"qos_packet_rate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Weight of the packet rate of the virtual server in the QoS load balancing method",
+			},
+			"qos_rtt": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Weight of the round trip time to the client in the QoS load balancing method",
+			},
+			"qos_topology": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Weight of the topology score in the QoS load balancing method",
+			},
+			"qos_vs_capacity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Weight of the number of available nodes behind the virtual server in the QoS load balancing method",
+			},
+			"qos_vs_score": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Weight of the score of the virtual server in the QoS load balancing method",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateGtmPoolMemberName},
+				Optional:    true,
+				Computed:    true,
+				Description: "Virtual servers of the pool, in the form /Partition/Server_Name:Virtual_Server_Name, or the wide IPs of a CNAME pool",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	poolType := d.Get("type").(string)
+
+	log.Printf("[INFO] Creating GTM pool %s (%s)", name, poolType)
+
+	p := getGtmPoolConfig(d)
+	p.Partition, p.SubPath, p.Name = splitF5Path(name)
+	err := postEntity(client, p, uriGtm, uriGtmPool, poolType)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM pool (%s): %s", name, err)
+	}
+	d.SetId(name)
+
+	if m, ok := d.GetOk("members"); ok {
+		for _, member := range setToStringSlice(m.(*schema.Set)) {
+			err = addGtmPoolMember(client, poolType, name, member, 1, "no")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return readAfterCreate(d, meta, resourceBigipGtmPoolRead)
+}
+
+func resourceBigipGtmPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	poolType := d.Get("type").(string)
+
+	err := putEntity(client, getGtmPoolConfig(d), uriGtm, uriGtmPool, poolType, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM pool (%s): %s", name, err)
+	}
+
+	if d.HasChange("members") {
+		o, n := d.GetChange("members")
+		for _, member := range setToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set))) {
+			err = deleteEntity(client, uriGtm, uriGtmPool, poolType, name, uriMembers, member)
+			if err != nil {
+				return fmt.Errorf("Error removing member %s from GTM pool (%s): %s", member, name, err)
+			}
+		}
+		for _, member := range setToStringSlice(n.(*schema.Set).Difference(o.(*schema.Set))) {
+			err = addGtmPoolMember(client, poolType, name, member, 1, "no")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return resourceBigipGtmPoolRead(d, meta)
+}
+
+func resourceBigipGtmPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	poolType := d.Get("type").(string)
+
+	var p gtmPool
+	ok, err := getForEntity(client, &p, uriGtm, uriGtmPool, poolType, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM pool (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM pool (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("load_balancing_mode", p.LoadBalancingMode)
+	d.Set("alternate_mode", p.AlternateMode)
+	d.Set("fallback_mode", p.FallbackMode)
+	d.Set("fallback_ip", p.FallbackIp)
+	d.Set("max_answers_returned", p.MaxAnswersReturned)
+	d.Set("ttl", p.Ttl)
+	d.Set("verify_member_availability", p.VerifyMemberAvailability)
+	d.Set("monitor", strings.TrimSpace(p.Monitor))
+	d.Set("qos_hit_ratio", p.QosHitRatio)
+	d.Set("qos_hops", p.QosHops)
+	d.Set("qos_kilobytes_second", p.QosKilobytesSecond)
+	d.Set("qos_lcs", p.QosLcs)
+	d.Set("qos_packet_rate", p.QosPacketRate)
+	d.Set("qos_rtt", p.QosRtt)
+	d.Set("qos_topology", p.QosTopology)
+	d.Set("qos_vs_capacity", p.QosVsCapacity)
+	d.Set("qos_vs_score", p.QosVsScore)
+	if p.Disabled {
+		d.Set("state", "disabled")
+	} else {
+		d.Set("state", "enabled")
+	}
+
+	var members []gtmPoolMember
+	_, err = getCollection(client, &members, selectQuery("name", "partition"), uriGtm, uriGtmPool, poolType, name, uriMembers)
+	if err != nil {
+		return fmt.Errorf("Error retrieving GTM pool (%s) members: %s", name, err)
+	}
+	var names []string
+	for _, m := range members {
+		if poolType == "cname" {
+			names = append(names, m.Name)
+		} else {
+			names = append(names, fmt.Sprintf("/%s/%s", m.Partition, m.Name))
+		}
+	}
+	if err := d.Set("members", names); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Members to state for GTM pool (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM pool " + name)
+
+	err := deleteEntity(client, uriGtm, uriGtmPool, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM pool (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipGtmPoolImport takes an id of the form <type>:<full path>, e.g. a:/Common/my-pool
+func resourceBigipGtmPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected an id of the form <type>:<full path>, got %s", d.Id())
+	}
+	d.Set("type", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func getGtmPoolConfig(d *schema.ResourceData) *gtmPool {
+	p := &gtmPool{
+		Description:              d.Get("description").(string),
+		LoadBalancingMode:        d.Get("load_balancing_mode").(string),
+		AlternateMode:            d.Get("alternate_mode").(string),
+		FallbackMode:             d.Get("fallback_mode").(string),
+		FallbackIp:               d.Get("fallback_ip").(string),
+		MaxAnswersReturned:       d.Get("max_answers_returned").(int),
+		Ttl:                      d.Get("ttl").(int),
+		VerifyMemberAvailability: d.Get("verify_member_availability").(string),
+		Monitor:                  d.Get("monitor").(string),
+		QosHitRatio:              d.Get("qos_hit_ratio").(int),
+		QosHops:                  d.Get("qos_hops").(int),
+		QosKilobytesSecond:       d.Get("qos_kilobytes_second").(int),
+		QosLcs:                   d.Get("qos_lcs").(int),
+		QosPacketRate:            d.Get("qos_packet_rate").(int),
+		QosRtt:                   d.Get("qos_rtt").(int),
+		QosTopology:              d.Get("qos_topology").(int),
+		QosVsCapacity:            d.Get("qos_vs_capacity").(int),
+		QosVsScore:               d.Get("qos_vs_score").(int),
+	}
+	if d.Get("state").(string) == "disabled" {
+		p.Disabled = true
+	} else {
+		p.Enabled = true
+	}
+	return p
+}
+
+// addGtmPoolMember adds a virtual server, given as /Partition/Server_Name:Virtual_Server_Name, to a GTM pool. The
+// members of CNAME pools are DNS names instead: wide IPs, or any name when staticTarget is yes.
+func addGtmPoolMember(client *bigip.BigIP, poolType, pool, member string, ratio int, staticTarget string) error {
+	log.Printf("[INFO] Adding member %s to GTM pool: %s", member, pool)
+
+	if err := checkGtmPoolMember(poolType, member, staticTarget); err != nil {
+		return err
+	}
+	m := &gtmPoolMember{Ratio: ratio, Enabled: true}
+	m.Partition, m.Name = parseF5Identifier(member)
+	if poolType == "cname" {
+		m.StaticTarget = staticTarget
+	}
+	err := postEntity(client, m, uriGtm, uriGtmPool, poolType, pool, uriMembers)
+	if err != nil {
+		return fmt.Errorf("Failure adding member %s to GTM pool %s: %s", member, pool, err)
+	}
+	return nil
+}
+
+// checkGtmPoolMember checks that a member matches the type of its GTM pool
+func checkGtmPoolMember(poolType, member, staticTarget string) error {
+	if poolType == "cname" {
+		if !isGtmCnameTarget(member) {
+			return fmt.Errorf("Member %s of a CNAME pool has to be a DNS name, e.g. app.example.com", member)
+		}
+		return nil
+	}
+	if isGtmCnameTarget(member) {
+		return fmt.Errorf("Member %s of an %s pool has to be a virtual server, in the form /Partition/Server_Name:Virtual_Server_Name", member, poolType)
+	}
+	if staticTarget == "yes" {
+		return fmt.Errorf("Member %s can only be a static target in a CNAME pool", member)
+	}
+	return nil
+}
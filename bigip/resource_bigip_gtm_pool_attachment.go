@@ -0,0 +1,175 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipGtmPoolAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmPoolAttachmentCreate,
+		Update: resourceBigipGtmPoolAttachmentUpdate,
+		Read:   resourceBigipGtmPoolAttachmentRead,
+		Delete: resourceBigipGtmPoolAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipGtmPoolAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM pool",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Record type of the pool: a, aaaa, cname or mx",
+				ValidateFunc: validateStringValue([]string{"a", "aaaa", "cname", "mx"}),
+			},
+			"member": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Virtual server to add/remove to/from the pool. Format /partition/server_name:virtual_server_name. e.g. /Common/server1:vs1, or the DNS name of a CNAME pool member",
+				ValidateFunc: validateGtmPoolMemberName,
+			},
+			"ratio": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Weight of the member when the pool load balances by ratio",
+			},
+			"static_target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "no",
+				Description:  "yes when the member of a CNAME pool is an external name rather than a wide IP",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipGtmPoolAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	poolType := d.Get("type").(string)
+	member := d.Get("member").(string)
+
+	err := addGtmPoolMember(client, poolType, pool, member, d.Get("ratio").(int), d.Get("static_target").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s", poolType, pool, member))
+
+	if d.Get("state").(string) == "disabled" {
+		return resourceBigipGtmPoolAttachmentUpdate(d, meta)
+	}
+	return readAfterCreate(d, meta, resourceBigipGtmPoolAttachmentRead)
+}
+
+func resourceBigipGtmPoolAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	poolType := d.Get("type").(string)
+	member := d.Get("member").(string)
+
+	m := &gtmPoolMember{Ratio: d.Get("ratio").(int)}
+	if poolType == "cname" {
+		m.StaticTarget = d.Get("static_target").(string)
+	} else if d.Get("static_target").(string) == "yes" {
+		return fmt.Errorf("Member %s can only be a static target in a CNAME pool", member)
+	}
+	if d.Get("state").(string) == "disabled" {
+		m.Disabled = true
+	} else {
+		m.Enabled = true
+	}
+	err := patchEntity(client, m, uriGtm, uriGtmPool, poolType, pool, uriMembers, member)
+	if err != nil {
+		return fmt.Errorf("Error modifying member %s of GTM pool (%s): %s", member, pool, err)
+	}
+	return resourceBigipGtmPoolAttachmentRead(d, meta)
+}
+
+func resourceBigipGtmPoolAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+
+	var m gtmPoolMember
+	ok, err := getForEntity(client, &m, uriGtm, uriGtmPool, d.Get("type").(string), pool, uriMembers, member)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve member %s of GTM pool (%s) (%v)", member, pool, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] %s is not a member of GTM pool %s, removing from state", member, pool)
+		d.SetId("")
+		return nil
+	}
+	d.Set("ratio", m.Ratio)
+	if m.StaticTarget == "yes" {
+		d.Set("static_target", "yes")
+	} else {
+		d.Set("static_target", "no")
+	}
+	if m.Disabled {
+		d.Set("state", "disabled")
+	} else {
+		d.Set("state", "enabled")
+	}
+
+	return nil
+}
+
+func resourceBigipGtmPoolAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+
+	log.Printf("[INFO] Removing member %s from GTM pool: %s", member, pool)
+
+	err := deleteEntity(client, uriGtm, uriGtmPool, d.Get("type").(string), pool, uriMembers, member)
+	if err != nil {
+		return fmt.Errorf("Failure removing member %s from GTM pool %s: %s", member, pool, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipGtmPoolAttachmentImport takes an id of the form <type>:<pool>:<member>, e.g. a:/Common/my-pool:/Common/server1:vs1
+// or cname:/Common/my-pool:app.example.com
+func resourceBigipGtmPoolAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Expected an id of the form <type>:<pool>:<member>, got %s", d.Id())
+	}
+	d.Set("type", parts[0])
+	d.Set("pool", parts[1])
+	d.Set("member", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipGtmPoolAttachment(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_pool_attachment" "test-member" {
+			pool = "/Common/test-gtm-pool"
+			type = "a"
+			member = "/Common/test-server:test-vs"
+			ratio = 3
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipGtmPoolAttachmentCreate(t *testing.T) {
+	var attached bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/gtm/pool/a/~Common~test-gtm-pool/members", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"test-server:test-vs","partition":"Common","ratio":3,"enabled":true}`, string(b))
+		attached = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/pool/a/~Common~test-gtm-pool/members/~Common~test-server:test-vs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			attached = false
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !attached {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-server:test-vs","partition":"Common","ratio":3,"enabled":true}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipGtmPoolAttachment(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_pool_attachment.test-member", "id", "a:/Common/test-gtm-pool:/Common/test-server:test-vs"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool_attachment.test-member", "ratio", "3"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool_attachment.test-member", "state", "enabled"),
+				),
+			},
+		},
+	})
+	assert.False(t, attached, "member was not removed")
+}
+
+func testBigipGtmPoolAttachmentStaticTarget(url, poolType string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_pool_attachment" "test-member" {
+			pool = "/Common/test-gtm-pool"
+			type = "%s"
+			member = "app.saas.example.net"
+			static_target = "yes"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, poolType, url)
+}
+
+func TestAccBigipGtmPoolAttachmentStaticTarget(t *testing.T) {
+	var attached bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/gtm/pool/cname/~Common~test-gtm-pool/members", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"app.saas.example.net","ratio":1,"staticTarget":"yes","enabled":true}`, string(b))
+		attached = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/pool/cname/~Common~test-gtm-pool/members/app.saas.example.net", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			attached = false
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !attached {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"app.saas.example.net","ratio":1,"staticTarget":"yes","enabled":true}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipGtmPoolAttachmentStaticTarget(server.URL, "a"),
+				ExpectError: regexp.MustCompile("Member app.saas.example.net of an a pool has to be a virtual server"),
+			},
+			{
+				Config: testBigipGtmPoolAttachmentStaticTarget(server.URL, "cname"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_pool_attachment.test-member", "id", "cname:/Common/test-gtm-pool:app.saas.example.net"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool_attachment.test-member", "static_target", "yes"),
+				),
+			},
+		},
+	})
+	assert.False(t, attached, "member was not removed")
+}
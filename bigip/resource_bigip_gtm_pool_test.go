@@ -0,0 +1,122 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_POOL_NAME = fmt.Sprintf("/%s/test-gtm-pool", TEST_PARTITION)
+
+var TEST_GTM_POOL_RESOURCE = `
+resource "bigip_gtm_pool" "test-gtm-pool" {
+	name = "` + TEST_GTM_POOL_NAME + `"
+	type = "a"
+	description = "test GTM pool"
+	load_balancing_mode = "ratio"
+	alternate_mode = "topology"
+	fallback_mode = "return-to-dns"
+	max_answers_returned = 2
+	ttl = 60
+	verify_member_availability = "disabled"
+	qos_rtt = 40
+	qos_hops = 10
+}
+`
+
+func TestAccBigipGtmPool_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_POOL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmPoolExists(TEST_GTM_POOL_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "name", TEST_GTM_POOL_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "type", "a"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "description", "test GTM pool"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "load_balancing_mode", "ratio"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "alternate_mode", "topology"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "fallback_mode", "return-to-dns"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "max_answers_returned", "2"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "ttl", "60"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "verify_member_availability", "disabled"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "qos_rtt", "40"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "qos_hops", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmPool_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_POOL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmPoolExists(TEST_GTM_POOL_NAME, true),
+				),
+				ResourceName:      TEST_GTM_POOL_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmPoolExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmPool
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmPool, "a", name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM pool %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM pool %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmPoolDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_pool" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmPool
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmPool, "a", name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM pool %s not destroyed.", name)
+		}
+	}
+	return nil
+}
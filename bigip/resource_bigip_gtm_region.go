@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriRegion = "region"
+
+type gtmRegion struct {
+	Name          string            `json:"name,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	RegionMembers []gtmRegionMember `json:"regionMembers"`
+}
+
+type gtmRegionMember struct {
+	Name string `json:"name"`
+}
+
+func resourceBigipGtmRegion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmRegionCreate,
+		Update: resourceBigipGtmRegionUpdate,
+		Read:   resourceBigipGtmRegionRead,
+		Delete: resourceBigipGtmRegionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM region",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Members of the region, e.g. continent EU, country NL, subnet 10.0.0.0/8 or not state US/California",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmRegionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating GTM region " + name)
+
+	r := getGtmRegionConfig(d)
+	r.Name = name
+	err := postEntity(client, r, uriGtm, uriRegion)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM region (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmRegionRead)
+}
+
+func resourceBigipGtmRegionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getGtmRegionConfig(d), uriGtm, uriRegion, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM region (%s): %s", name, err)
+	}
+	return resourceBigipGtmRegionRead(d, meta)
+}
+
+func resourceBigipGtmRegionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r gtmRegion
+	ok, err := getForEntity(client, &r, uriGtm, uriRegion, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM region (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM region (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	var members []string
+	for _, m := range r.RegionMembers {
+		members = append(members, m.Name)
+	}
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Members to state for GTM region (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmRegionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM region " + name)
+
+	err := deleteEntity(client, uriGtm, uriRegion, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM region (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmRegionConfig(d *schema.ResourceData) *gtmRegion {
+	r := &gtmRegion{
+		Description:   d.Get("description").(string),
+		RegionMembers: []gtmRegionMember{},
+	}
+	for _, m := range setToStringSlice(d.Get("members").(*schema.Set)) {
+		r.RegionMembers = append(r.RegionMembers, gtmRegionMember{Name: m})
+	}
+	return r
+}
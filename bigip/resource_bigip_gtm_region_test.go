@@ -0,0 +1,112 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_REGION_NAME = fmt.Sprintf("/%s/test-region", TEST_PARTITION)
+
+var TEST_GTM_REGION_RESOURCE = `
+resource "bigip_gtm_region" "test-region" {
+	name = "` + TEST_GTM_REGION_NAME + `"
+	description = "test region"
+	members = ["continent EU", "subnet 10.0.0.0/8"]
+}
+`
+
+func TestAccBigipGtmRegion_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmRegionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_REGION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmRegionExists(TEST_GTM_REGION_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "name", TEST_GTM_REGION_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "description", "test region"),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region",
+						fmt.Sprintf("members.%d", schema.HashString("continent EU")),
+						"continent EU"),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region",
+						fmt.Sprintf("members.%d", schema.HashString("subnet 10.0.0.0/8")),
+						"subnet 10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmRegion_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmRegionDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_REGION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmRegionExists(TEST_GTM_REGION_NAME, true),
+				),
+				ResourceName:      TEST_GTM_REGION_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmRegionExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmRegion
+		ok, err := getForEntity(client, &p, uriGtm, uriRegion, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM region %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM region %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmRegionDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_region" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmRegion
+		ok, err := getForEntity(client, &p, uriGtm, uriRegion, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM region %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriGtmServer = "server"
+
+type gtmServer struct {
+	Name                    string                   `json:"name,omitempty"`
+	Description             string                   `json:"description,omitempty"`
+	Datacenter              string                   `json:"datacenter,omitempty"`
+	Product                 string                   `json:"product,omitempty"`
+	Addresses               []gtmServerAddress       `json:"addresses"`
+	VirtualServerDiscovery  string                   `json:"virtualServerDiscovery,omitempty"`
+	Monitor                 string                   `json:"monitor,omitempty"`
+	Enabled                 bool                     `json:"enabled,omitempty"`
+	Disabled                bool                     `json:"disabled,omitempty"`
+	VirtualServers          []gtmServerVirtualServer `json:"virtualServers,omitempty"`
+	VirtualServersReference *struct {
+		Items []gtmServerVirtualServer `json:"items"`
+	} `json:"virtualServersReference,omitempty"`
+}
+
+type gtmServerAddress struct {
+	Name        string `json:"name"`
+	DeviceName  string `json:"deviceName,omitempty"`
+	Translation string `json:"translation,omitempty"`
+}
+
+type gtmServerVirtualServer struct {
+	Name        string `json:"name"`
+	Destination string `json:"destination"`
+}
+
+func resourceBigipGtmServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmServerCreate,
+		Update: resourceBigipGtmServerUpdate,
+		Read:   resourceBigipGtmServerRead,
+		Delete: resourceBigipGtmServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the GTM server",
+				ValidateFunc: validateF5Name,
+			},
+			"datacenter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the datacenter the server is in",
+				ValidateFunc: validateF5Name,
+			},
+			"product": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "bigip",
+				ForceNew:    true,
+				Description: "Kind of server, e.g. bigip, generic-host or generic-load-balancer",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Addresses of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address",
+						},
+						"device_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of the device the address belongs to, it tells the devices of a BIG-IP HA pair apart",
+						},
+						"translation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "none",
+							Description: "Public address the address is translated to, e.g. behind a NAT",
+						},
+					},
+				},
+			},
+			"virtual_server_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether the virtual servers of the server are discovered: enabled, enabled-no-delete or disabled",
+				ValidateFunc: validateStringValue([]string{"enabled", "enabled-no-delete", "disabled"}),
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Health monitor rule of the server, e.g. /Common/bigip",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"virtual_server": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Virtual servers of the server, discovered ones are listed too",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address and port of the virtual server, e.g. 10.0.0.1:80",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating GTM server " + name)
+
+	s := getGtmServerConfig(d)
+	s.Name = name
+	s.Product = d.Get("product").(string)
+	err := postEntity(client, s, uriGtm, uriGtmServer)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM server (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmServerRead)
+}
+
+func resourceBigipGtmServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getGtmServerConfig(d), uriGtm, uriGtmServer, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM server (%s): %s", name, err)
+	}
+	return resourceBigipGtmServerRead(d, meta)
+}
+
+func resourceBigipGtmServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var s gtmServer
+	ok, err := getForEntity(client, &s, uriGtm, uriGtmServer, name+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM server (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM server (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("datacenter", s.Datacenter)
+	d.Set("product", s.Product)
+	d.Set("description", s.Description)
+	d.Set("virtual_server_discovery", s.VirtualServerDiscovery)
+	d.Set("monitor", strings.TrimSpace(s.Monitor))
+	if s.Disabled {
+		d.Set("state", "disabled")
+	} else {
+		d.Set("state", "enabled")
+	}
+
+	var addresses []interface{}
+	for _, a := range s.Addresses {
+		addresses = append(addresses, map[string]interface{}{
+			"name":        a.Name,
+			"device_name": a.DeviceName,
+			"translation": a.Translation,
+		})
+	}
+	if err := d.Set("address", addresses); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Addresses to state for GTM server (%s): %s", name, err)
+	}
+
+	var virtualServers []interface{}
+	if s.VirtualServersReference != nil {
+		for _, vs := range s.VirtualServersReference.Items {
+			virtualServers = append(virtualServers, map[string]interface{}{
+				"name":        vs.Name,
+				"destination": vs.Destination,
+			})
+		}
+	}
+	if err := d.Set("virtual_server", virtualServers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving VirtualServers to state for GTM server (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM server " + name)
+
+	err := deleteEntity(client, uriGtm, uriGtmServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmServerConfig(d *schema.ResourceData) *gtmServer {
+	s := &gtmServer{
+		Description:            d.Get("description").(string),
+		Datacenter:             d.Get("datacenter").(string),
+		VirtualServerDiscovery: d.Get("virtual_server_discovery").(string),
+		Monitor:                d.Get("monitor").(string),
+		Addresses:              []gtmServerAddress{},
+	}
+	if d.Get("state").(string) == "disabled" {
+		s.Disabled = true
+	} else {
+		s.Enabled = true
+	}
+	for _, a := range d.Get("address").([]interface{}) {
+		address := a.(map[string]interface{})
+		s.Addresses = append(s.Addresses, gtmServerAddress{
+			Name:        address["name"].(string),
+			DeviceName:  address["device_name"].(string),
+			Translation: address["translation"].(string),
+		})
+	}
+	// Discovered virtual servers are left to the discovery
+	if s.VirtualServerDiscovery == "disabled" {
+		for _, v := range d.Get("virtual_server").([]interface{}) {
+			vs := v.(map[string]interface{})
+			s.VirtualServers = append(s.VirtualServers, gtmServerVirtualServer{
+				Name:        vs["name"].(string),
+				Destination: vs["destination"].(string),
+			})
+		}
+	}
+	return s
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_SERVER_NAME = fmt.Sprintf("/%s/test-gtm-server", TEST_PARTITION)
+
+var TEST_GTM_SERVER_RESOURCE = `
+resource "bigip_gtm_datacenter" "test-server-dc" {
+	name = "/Common/test-server-dc"
+}
+
+resource "bigip_gtm_server" "test-gtm-server" {
+	name = "` + TEST_GTM_SERVER_NAME + `"
+	datacenter = "${bigip_gtm_datacenter.test-server-dc.name}"
+	product = "generic-host"
+	description = "test GTM server"
+	monitor = "/Common/gateway_icmp"
+	address {
+		name = "10.10.10.10"
+	}
+	virtual_server {
+		name = "test-vs"
+		destination = "10.10.10.10:80"
+	}
+}
+`
+
+func TestAccBigipGtmServer_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmServerExists(TEST_GTM_SERVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "name", TEST_GTM_SERVER_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "product", "generic-host"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "description", "test GTM server"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "monitor", "/Common/gateway_icmp"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "datacenter", "/Common/test-server-dc"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "address.0.name", "10.10.10.10"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "address.0.translation", "none"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "virtual_server.0.name", "test-vs"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "virtual_server.0.destination", "10.10.10.10:80"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmServer_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmServerExists(TEST_GTM_SERVER_NAME, true),
+				),
+				ResourceName:      TEST_GTM_SERVER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmServerExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmServer
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmServer, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM server %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM server %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmServerDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_server" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmServer
+		ok, err := getForEntity(client, &p, uriGtm, uriGtmServer, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM server %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriTopology = "topology"
+
+// gtmTopology is a topology record, named after what it matches: "ldns: <ldns> server: <server>"
+type gtmTopology struct {
+	Name  string `json:"name,omitempty"`
+	Score int    `json:"score"`
+	Order int    `json:"order,omitempty"`
+}
+
+func resourceBigipGtmTopology() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmTopologyCreate,
+		Update: resourceBigipGtmTopologyUpdate,
+		Read:   resourceBigipGtmTopologyRead,
+		Delete: resourceBigipGtmTopologyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ldns": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Requests the record applies to, by their local DNS server, e.g. continent EU, country NL, subnet 10.0.0.0/8, region /Common/eu or not country US",
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Answers the record prefers, e.g. pool /Common/pool_eu, datacenter /Common/dc1 or region /Common/eu",
+			},
+			"weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Score given to the answers when the record applies",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Position of the record in evaluation order, used when longest match is disabled in the GTM global settings",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmTopologyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := fmt.Sprintf("ldns: %s server: %s", d.Get("ldns").(string), d.Get("server").(string))
+	log.Println("[INFO] Creating GTM topology record " + name)
+
+	t := getGtmTopologyConfig(d)
+	t.Name = name
+	err := postEntity(client, t, uriGtm, uriTopology)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM topology record (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmTopologyRead)
+}
+
+func resourceBigipGtmTopologyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getGtmTopologyConfig(d), uriGtm, uriTopology, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM topology record (%s): %s", name, err)
+	}
+	return resourceBigipGtmTopologyRead(d, meta)
+}
+
+func resourceBigipGtmTopologyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var t gtmTopology
+	ok, err := getForEntity(client, &t, uriGtm, uriTopology, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM topology record (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM topology record (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("weight", t.Score)
+	d.Set("order", t.Order)
+
+	return nil
+}
+
+func resourceBigipGtmTopologyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM topology record " + name)
+
+	err := deleteEntity(client, uriGtm, uriTopology, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM topology record (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmTopologyConfig(d *schema.ResourceData) *gtmTopology {
+	return &gtmTopology{
+		Score: d.Get("weight").(int),
+		Order: d.Get("order").(int),
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_TOPOLOGY_NAME = fmt.Sprintf("ldns: region /%s/test-topology-region server: datacenter /%s/test-topology-dc", TEST_PARTITION, TEST_PARTITION)
+
+var TEST_GTM_TOPOLOGY_RESOURCE = `
+resource "bigip_gtm_region" "test-topology-region" {
+	name = "/` + TEST_PARTITION + `/test-topology-region"
+	members = ["country NL"]
+}
+
+resource "bigip_gtm_datacenter" "test-topology-dc" {
+	name = "/` + TEST_PARTITION + `/test-topology-dc"
+}
+
+resource "bigip_gtm_topology" "test-topology" {
+	ldns = "region ${bigip_gtm_region.test-topology-region.name}"
+	server = "datacenter ${bigip_gtm_datacenter.test-topology-dc.name}"
+	weight = 100
+}
+`
+
+func TestAccBigipGtmTopology_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmTopologyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_TOPOLOGY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmTopologyExists(TEST_GTM_TOPOLOGY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_topology.test-topology", "id", TEST_GTM_TOPOLOGY_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_topology.test-topology", "weight", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmTopologyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmTopology
+		ok, err := getForEntity(client, &p, uriGtm, uriTopology, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM topology record %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM topology record %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmTopologyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_topology" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmTopology
+		ok, err := getForEntity(client, &p, uriGtm, uriTopology, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM topology record %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriWideip = "wideip"
+
+type gtmWideip struct {
+	Name                 string          `json:"name,omitempty"`
+	Description          string          `json:"description,omitempty"`
+	Aliases              []string        `json:"aliases"`
+	PoolLbMode           string          `json:"poolLbMode,omitempty"`
+	Persistence          string          `json:"persistence,omitempty"`
+	TtlPersistence       int             `json:"ttlPersistence,omitempty"`
+	PersistCidrIpv4      int             `json:"persistCidrIpv4"`
+	PersistCidrIpv6      int             `json:"persistCidrIpv6"`
+	LastResortPool       string          `json:"lastResortPool"`
+	MinimalResponse      string          `json:"minimalResponse,omitempty"`
+	FailureRcode         string          `json:"failureRcode,omitempty"`
+	FailureRcodeResponse string          `json:"failureRcodeResponse,omitempty"`
+	Enabled              bool            `json:"enabled,omitempty"`
+	Disabled             bool            `json:"disabled,omitempty"`
+	Pools                []gtmWideipPool `json:"pools"`
+}
+
+type gtmWideipPool struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	Order     int    `json:"order"`
+	Ratio     int    `json:"ratio,omitempty"`
+}
+
+func resourceBigipGtmWideip() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmWideipCreate,
+		Update: resourceBigipGtmWideipUpdate,
+		Read:   resourceBigipGtmWideipRead,
+		Delete: resourceBigipGtmWideipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipGtmWideipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the wide IP, the name is the FQDN it answers for, e.g. /Common/www.example.com or /Common/*.example.com",
+				ValidateFunc: validateGtmWideipName,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Record type of the wide IP: a, aaaa, cname or mx",
+				ValidateFunc: validateStringValue([]string{"a", "aaaa", "cname", "mx"}),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateGtmWideipAlias},
+				Optional:    true,
+				Description: "Other FQDNs the wide IP answers for, wildcards are allowed",
+			},
+			"pool_lb_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				Description:  "How the wide IP picks a pool: global-availability, ratio, round-robin or topology",
+				ValidateFunc: validateStringValue([]string{"global-availability", "ratio", "round-robin", "topology"}),
+			},
+			"persistence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "To enable _ disable answering a client with the same pool member it was last given",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"ttl_persistence": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "Seconds a client is persisted",
+			},
+			"persist_cidr_ipv4": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      32,
+				Description:  "Prefix length of the IPv4 client addresses that share persistence, e.g. 24 persists a whole /24",
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"persist_cidr_ipv6": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      128,
+				Description:  "Prefix length of the IPv6 client addresses that share persistence",
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"last_resort_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the pool used when all other pools are unavailable",
+			},
+			"minimal_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable leaving out the authority and additional sections of responses",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"failure_rcode_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable answering with failure_rcode when no pool member is available",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"failure_rcode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Return code of failure responses: noerror, formerr, servfail, nxdomain, notimpl or refused",
+				ValidateFunc: validateStringValue([]string{"noerror", "formerr", "servfail", "nxdomain", "notimpl", "refused"}),
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"pool": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Pools of the wide IP, in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the GTM pool",
+							ValidateFunc: validateF5Name,
+						},
+						"ratio": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Weight of the pool when pool_lb_mode is ratio",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmWideipCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+
+	log.Printf("[INFO] Creating GTM wide IP %s (%s)", name, recordType)
+
+	w := getGtmWideipConfig(d)
+	w.Name = name
+	err := postEntity(client, w, uriGtm, uriWideip, recordType)
+	if err != nil {
+		return fmt.Errorf("Error creating GTM wide IP (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipGtmWideipRead)
+}
+
+func resourceBigipGtmWideipUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	w := getGtmWideipConfig(d)
+	err := putEntity(client, w, uriGtm, uriWideip, d.Get("type").(string), gtmWideipPathName(name))
+	if err != nil {
+		return fmt.Errorf("Error modifying GTM wide IP (%s): %s", name, err)
+	}
+	return resourceBigipGtmWideipRead(d, meta)
+}
+
+func resourceBigipGtmWideipRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	recordType := d.Get("type").(string)
+
+	var w gtmWideip
+	ok, err := getForEntity(client, &w, uriGtm, uriWideip, recordType, gtmWideipPathName(name))
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve GTM wide IP (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] GTM wide IP (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", w.Description)
+	if err := d.Set("aliases", w.Aliases); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Aliases to state for GTM wide IP (%s): %s", name, err)
+	}
+	d.Set("pool_lb_mode", w.PoolLbMode)
+	d.Set("persistence", w.Persistence)
+	d.Set("ttl_persistence", w.TtlPersistence)
+	d.Set("persist_cidr_ipv4", w.PersistCidrIpv4)
+	d.Set("persist_cidr_ipv6", w.PersistCidrIpv6)
+	d.Set("last_resort_pool", strings.TrimPrefix(w.LastResortPool, recordType+" "))
+	d.Set("minimal_response", w.MinimalResponse)
+	d.Set("failure_rcode_response", w.FailureRcodeResponse)
+	d.Set("failure_rcode", w.FailureRcode)
+	if w.Disabled {
+		d.Set("state", "disabled")
+	} else {
+		d.Set("state", "enabled")
+	}
+
+	pools := make([]interface{}, len(w.Pools))
+	for _, p := range w.Pools {
+		if p.Order < 0 || p.Order >= len(pools) {
+			return fmt.Errorf("[DEBUG] Unexpected order %d of pool %s in GTM wide IP (%s)", p.Order, p.Name, name)
+		}
+		pools[p.Order] = map[string]interface{}{
+			"name":  fmt.Sprintf("/%s/%s", p.Partition, p.Name),
+			"ratio": p.Ratio,
+		}
+	}
+	if err := d.Set("pool", pools); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Pools to state for GTM wide IP (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmWideipDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM wide IP " + name)
+
+	err := deleteEntity(client, uriGtm, uriWideip, d.Get("type").(string), gtmWideipPathName(name))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM wide IP (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipGtmWideipImport takes an id of the form <type>:<full path>, e.g. a:/Common/www.example.com
+func resourceBigipGtmWideipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected an id of the form <type>:<full path>, got %s", d.Id())
+	}
+	d.Set("type", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// gtmWideipPathName escapes the ? wildcard of a wide IP name, which would otherwise start the query of its URL
+func gtmWideipPathName(name string) string {
+	return strings.Replace(name, "?", "%3F", -1)
+}
+
+func getGtmWideipConfig(d *schema.ResourceData) *gtmWideip {
+	w := &gtmWideip{
+		Description:          d.Get("description").(string),
+		Aliases:              setToStringSlice(d.Get("aliases").(*schema.Set)),
+		PoolLbMode:           d.Get("pool_lb_mode").(string),
+		Persistence:          d.Get("persistence").(string),
+		TtlPersistence:       d.Get("ttl_persistence").(int),
+		PersistCidrIpv4:      d.Get("persist_cidr_ipv4").(int),
+		PersistCidrIpv6:      d.Get("persist_cidr_ipv6").(int),
+		MinimalResponse:      d.Get("minimal_response").(string),
+		FailureRcodeResponse: d.Get("failure_rcode_response").(string),
+		FailureRcode:         d.Get("failure_rcode").(string),
+		Pools:                []gtmWideipPool{},
+	}
+	if pool := d.Get("last_resort_pool").(string); pool != "" {
+		w.LastResortPool = d.Get("type").(string) + " " + pool
+	}
+	if d.Get("state").(string) == "disabled" {
+		w.Disabled = true
+	} else {
+		w.Enabled = true
+	}
+	for i, p := range d.Get("pool").([]interface{}) {
+		pool := p.(map[string]interface{})
+		partition, name := parseF5Identifier(pool["name"].(string))
+		w.Pools = append(w.Pools, gtmWideipPool{
+			Name:      name,
+			Partition: partition,
+			Order:     i,
+			Ratio:     pool["ratio"].(int),
+		})
+	}
+	return w
+}
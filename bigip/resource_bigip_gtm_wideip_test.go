@@ -0,0 +1,156 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_WIDEIP_NAME = fmt.Sprintf("/%s/test.example.com", TEST_PARTITION)
+
+var TEST_WIDEIP_RESOURCE = `
+resource "bigip_gtm_wideip" "test-wideip" {
+	name = "` + TEST_WIDEIP_NAME + `"
+	type = "a"
+	description = "test wide IP"
+	pool_lb_mode = "topology"
+	persistence = "enabled"
+	ttl_persistence = 600
+	persist_cidr_ipv4 = 24
+	aliases = ["test-alias.example.com"]
+}
+`
+
+func TestAccBigipGtmWideip_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmWideipDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WIDEIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmWideipExists(TEST_WIDEIP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "name", TEST_WIDEIP_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "type", "a"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "description", "test wide IP"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pool_lb_mode", "topology"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persistence", "enabled"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "ttl_persistence", "600"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persist_cidr_ipv4", "24"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip",
+						fmt.Sprintf("aliases.%d", schema.HashString("test-alias.example.com")),
+						"test-alias.example.com"),
+				),
+			},
+		},
+	})
+}
+
+var TEST_WILDCARD_WIDEIP_NAME = fmt.Sprintf("/%s/*.test.example.com", TEST_PARTITION)
+
+func testWildcardWideipResource(aliases string) string {
+	return `
+resource "bigip_gtm_wideip" "test-wildcard" {
+	name = "` + TEST_WILDCARD_WIDEIP_NAME + `"
+	type = "a"
+	aliases = [` + aliases + `]
+}
+`
+}
+
+func TestAccBigipGtmWideip_wildcard(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmWideipDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testWildcardWideipResource(`"tenant?.test.example.net", "*.test.example.org"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmWideipExists(TEST_WILDCARD_WIDEIP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wildcard", "aliases.#", "2"),
+				),
+			},
+			{
+				Config: testWildcardWideipResource(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wildcard", "aliases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmWideip_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipGtmWideipDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WIDEIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipGtmWideipExists(TEST_WIDEIP_NAME, true),
+				),
+				ResourceName:      TEST_WIDEIP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipGtmWideipExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p gtmWideip
+		ok, err := getForEntity(client, &p, uriGtm, uriWideip, "a", gtmWideipPathName(name))
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("GTM wide IP %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("GTM wide IP %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipGtmWideipDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_wideip" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p gtmWideip
+		ok, err := getForEntity(client, &p, uriGtm, uriWideip, "a", gtmWideipPathName(name))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("GTM wide IP %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipGtmWideipWildcard(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_wideip" "test-wildcard" {
+			name = "/Common/tenant?.example.com"
+			type = "a"
+			aliases = ["*.tenants.example.com"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipGtmWideipWildcardCreate(t *testing.T) {
+	created := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/a", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/wideip/a/~Common~tenant?.example.com", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mgmt/tm/gtm/wideip/a/~Common~tenant%3F.example.com", r.URL.EscapedPath())
+		if r.Method == "DELETE" {
+			created = false
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"tenant?.example.com","partition":"Common","aliases":["*.tenants.example.com"],
+			"poolLbMode":"round-robin","persistence":"disabled","ttlPersistence":3600,"persistCidrIpv4":32,"persistCidrIpv6":128,
+			"minimalResponse":"enabled","failureRcodeResponse":"disabled","failureRcode":"noerror","enabled":true}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipGtmWideipWildcard(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wildcard", "id", "/Common/tenant?.example.com"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wildcard", "aliases.#", "1"),
+				),
+			},
+		},
+	})
+	assert.False(t, created, "Wide IP was not deleted")
+}
+
+func TestAccBigipGtmWideipInvalidAlias(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bigip_gtm_wideip" "test-wildcard" {
+						name = "/Common/*.example.com"
+						type = "a"
+						aliases = ["*.example com"]
+					}
+					provider "bigip" {
+						address = "xxx.xxx.xxx.xxx"
+						username = "xxxxx"
+						password = "xxxxx"
+					}
+				`,
+				ExpectError: regexp.MustCompile("must be a FQDN"),
+			},
+		},
+	})
+}
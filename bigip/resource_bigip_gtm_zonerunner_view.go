@@ -0,0 +1,217 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_gtm_zonerunner_view manages a view of the local BIND, the way ZoneRunner does. named answers a client
+// from the first view whose match-clients it matches, so the view is placed before another one with before,
+// e.g. an internal view before the external view of ZoneRunner matching any client.
+func resourceBigipGtmZonerunnerView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmZonerunnerViewCreate,
+		Read:   resourceBigipGtmZonerunnerViewRead,
+		Update: resourceBigipGtmZonerunnerViewUpdate,
+		Delete: resourceBigipGtmZonerunnerViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the view",
+				ValidateFunc: validateNamedName,
+			},
+			"match_clients": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateNamedElement},
+				Description: "Address match list of the clients the view answers, e.g. 10.0.0.0/8, key tsig-key or any",
+			},
+			"before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "View this view is placed before, the view is placed after the others when empty",
+				ValidateFunc: validateNamedName,
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Zones of the view",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmZonerunnerViewCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating ZoneRunner view %s", name)
+
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		statements, err := parseNamedStatements(conf, 0, len(conf))
+		if err != nil {
+			return "", err
+		}
+		if findNamedStatement(statements, "view", name) != nil {
+			return "", fmt.Errorf("view %s already exists, import it", name)
+		}
+		view := fmt.Sprintf("view \"%s\" {\n\t%s\n};\n", name, namedListStatement("match-clients", listToStringSlice(d.Get("match_clients").([]interface{}))))
+		return placeNamedView(conf, view, d.Get("before").(string))
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating ZoneRunner view (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmZonerunnerViewRead(d, meta)
+}
+
+func resourceBigipGtmZonerunnerViewRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	conf, err := readNamedConf(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve ZoneRunner view (%s) (%v)", name, err)
+		return err
+	}
+	statements, err := parseNamedStatements(conf, 0, len(conf))
+	if err != nil {
+		return err
+	}
+	view := findNamedStatement(statements, "view", name)
+	if view == nil {
+		log.Printf("[WARN] ZoneRunner view (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	body, err := parseNamedStatements(conf, view.bodyStart, view.bodyEnd)
+	if err != nil {
+		return err
+	}
+	var matchClients, zones []string
+	for i := range body {
+		switch body[i].word(0) {
+		case "match-clients":
+			if matchClients, err = namedList(conf, &body[i]); err != nil {
+				return err
+			}
+		case "zone":
+			zones = append(zones, body[i].word(1))
+		}
+	}
+	d.Set("name", name)
+	d.Set("match_clients", matchClients)
+	d.Set("zones", zones)
+	return nil
+}
+
+func resourceBigipGtmZonerunnerViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Updating ZoneRunner view %s", name)
+
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		statements, err := parseNamedStatements(conf, 0, len(conf))
+		if err != nil {
+			return "", err
+		}
+		view := findNamedStatement(statements, "view", name)
+		if view == nil {
+			return "", fmt.Errorf("view %s not found", name)
+		}
+		body, err := parseNamedStatements(conf, view.bodyStart, view.bodyEnd)
+		if err != nil {
+			return "", err
+		}
+		matchClients := namedListStatement("match-clients", listToStringSlice(d.Get("match_clients").([]interface{})))
+		if s := findNamedStatement(body, "match-clients", ""); s != nil {
+			conf = conf[:s.start] + matchClients + conf[s.end:]
+		} else {
+			conf = conf[:view.bodyStart] + "\n\t" + matchClients + conf[view.bodyStart:]
+		}
+		if !d.HasChange("before") {
+			return conf, nil
+		}
+		// The view is moved with its zones
+		statements, err = parseNamedStatements(conf, 0, len(conf))
+		if err != nil {
+			return "", err
+		}
+		view = findNamedStatement(statements, "view", name)
+		text := conf[view.start:view.end] + "\n"
+		return placeNamedView(removeNamedStatement(conf, view), text, d.Get("before").(string))
+	})
+	if err != nil {
+		return fmt.Errorf("Error modifying ZoneRunner view (%s): %s", name, err)
+	}
+	return resourceBigipGtmZonerunnerViewRead(d, meta)
+}
+
+func resourceBigipGtmZonerunnerViewDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting ZoneRunner view %s", name)
+
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		statements, err := parseNamedStatements(conf, 0, len(conf))
+		if err != nil {
+			return "", err
+		}
+		view := findNamedStatement(statements, "view", name)
+		if view == nil {
+			return conf, nil
+		}
+		body, err := parseNamedStatements(conf, view.bodyStart, view.bodyEnd)
+		if err != nil {
+			return "", err
+		}
+		for i := range body {
+			if body[i].word(0) == "zone" {
+				return "", fmt.Errorf("view %s still has zone %s", name, body[i].word(1))
+			}
+		}
+		return removeNamedStatement(conf, view), nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting ZoneRunner view (%s): %s", name, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// placeNamedView inserts the text of a view before the view named before, or after the last statement
+func placeNamedView(conf, view, before string) (string, error) {
+	if before == "" {
+		if conf != "" && conf[len(conf)-1] != '\n' {
+			conf += "\n"
+		}
+		return conf + view, nil
+	}
+	statements, err := parseNamedStatements(conf, 0, len(conf))
+	if err != nil {
+		return "", err
+	}
+	next := findNamedStatement(statements, "view", before)
+	if next == nil {
+		return "", fmt.Errorf("view %s to place the view before not found", before)
+	}
+	return conf[:next.start] + view + conf[next.start:], nil
+}
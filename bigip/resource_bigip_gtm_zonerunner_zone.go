@@ -0,0 +1,293 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_gtm_zonerunner_zone manages a zone of a view of the local BIND, the way ZoneRunner does. The zone file
+// of a master zone is written from zone_file but not read back, named rewrites it with the dynamic updates
+// and the serials ZoneRunner makes.
+func resourceBigipGtmZonerunnerZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmZonerunnerZoneCreate,
+		Read:   resourceBigipGtmZonerunnerZoneRead,
+		Update: resourceBigipGtmZonerunnerZoneUpdate,
+		Delete: resourceBigipGtmZonerunnerZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipGtmZonerunnerZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"view": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "View of the zone",
+				ValidateFunc: validateNamedName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the zone, e.g. example.com",
+				ValidateFunc: validateNamedName,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "master",
+				Description:  "Type of the zone, master, slave or forward",
+				ValidateFunc: validateStringValue([]string{"master", "slave", "forward"}),
+			},
+			"zone_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Content of the zone file of a master zone, in the format of BIND",
+			},
+			"allow_transfer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateNamedElement},
+				Description: "Address match list of the clients allowed to transfer the zone, e.g. 10.1.1.1 or key tsig-key",
+			},
+			"allow_update": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateNamedElement},
+				Description: "Address match list of the clients allowed to update a master zone, ZoneRunner updates it from localhost",
+			},
+			"masters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateNamedElement},
+				Description: "Addresses of the masters a slave zone is transferred from",
+			},
+			"forwarders": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateNamedElement},
+				Description: "Addresses of the servers the queries of a forward zone are forwarded to",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmZonerunnerZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	view := d.Get("view").(string)
+	name := d.Get("name").(string)
+	if err := checkZonerunnerZoneConfig(d); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Creating ZoneRunner zone %s in view %s", name, view)
+
+	if d.Get("type").(string) == "master" {
+		if err := writeNamedZoneFile(client, name, namedZoneFile(view, name), d.Get("zone_file").(string)); err != nil {
+			return err
+		}
+	}
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		v, zone, err := findNamedZone(conf, view, name)
+		if err != nil {
+			return "", err
+		}
+		if v == nil {
+			return "", fmt.Errorf("view %s not found", view)
+		}
+		if zone != nil {
+			return "", fmt.Errorf("zone %s already exists in view %s, import it", name, view)
+		}
+		return conf[:v.bodyEnd] + "\t" + zonerunnerZoneStatement(d) + "\n" + conf[v.bodyEnd:], nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating ZoneRunner zone (%s): %s", name, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", view, name))
+	return resourceBigipGtmZonerunnerZoneRead(d, meta)
+}
+
+func resourceBigipGtmZonerunnerZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	view := d.Get("view").(string)
+	name := d.Get("name").(string)
+	conf, err := readNamedConf(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve ZoneRunner zone (%s) (%v)", name, err)
+		return err
+	}
+	_, zone, err := findNamedZone(conf, view, name)
+	if err != nil {
+		return err
+	}
+	if zone == nil {
+		log.Printf("[WARN] ZoneRunner zone (%s) not found in view %s, removing from state", name, view)
+		d.SetId("")
+		return nil
+	}
+	options, err := parseNamedStatements(conf, zone.bodyStart, zone.bodyEnd)
+	if err != nil {
+		return err
+	}
+	lists := map[string][]string{}
+	for i := range options {
+		switch keyword := options[i].word(0); keyword {
+		case "type":
+			d.Set("type", options[i].word(1))
+		case "allow-transfer", "allow-update", "masters", "forwarders":
+			if lists[keyword], err = namedList(conf, &options[i]); err != nil {
+				return err
+			}
+		}
+	}
+	d.Set("allow_transfer", lists["allow-transfer"])
+	d.Set("allow_update", lists["allow-update"])
+	d.Set("masters", lists["masters"])
+	d.Set("forwarders", lists["forwarders"])
+	return nil
+}
+
+func resourceBigipGtmZonerunnerZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	view := d.Get("view").(string)
+	name := d.Get("name").(string)
+	if err := checkZonerunnerZoneConfig(d); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updating ZoneRunner zone %s in view %s", name, view)
+
+	if d.Get("type").(string) == "master" && d.HasChange("zone_file") {
+		if err := writeNamedZoneFile(client, name, namedZoneFile(view, name), d.Get("zone_file").(string)); err != nil {
+			return err
+		}
+	}
+	// named.conf is written even when only the zone file changed, writing it reloads named
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		_, zone, err := findNamedZone(conf, view, name)
+		if err != nil {
+			return "", err
+		}
+		if zone == nil {
+			return "", fmt.Errorf("zone %s not found in view %s", name, view)
+		}
+		return conf[:zone.start] + zonerunnerZoneStatement(d) + conf[zone.end:], nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error modifying ZoneRunner zone (%s): %s", name, err)
+	}
+	return resourceBigipGtmZonerunnerZoneRead(d, meta)
+}
+
+func resourceBigipGtmZonerunnerZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	view := d.Get("view").(string)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Deleting ZoneRunner zone %s in view %s", name, view)
+
+	err := updateNamedConf(client, func(conf string) (string, error) {
+		_, zone, err := findNamedZone(conf, view, name)
+		if err != nil {
+			return "", err
+		}
+		if zone == nil {
+			return conf, nil
+		}
+		return removeNamedStatement(conf, zone), nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting ZoneRunner zone (%s): %s", name, err)
+	}
+	if d.Get("type").(string) == "master" {
+		path := namedChroot + namedZoneDir + "/" + namedZoneFile(view, name)
+		if err := runNamedScript(client, fmt.Sprintf("rm -f %s %s.jnl", path, path)); err != nil {
+			log.Printf("[WARN] Unable to remove zone file %s: %v", path, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipGtmZonerunnerZoneImport takes an id of the form <view>:<zone>, e.g. external:example.com
+func resourceBigipGtmZonerunnerZoneImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected an id of the form <view>:<zone>, got %s", d.Id())
+	}
+	d.Set("view", parts[0])
+	d.Set("name", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkZonerunnerZoneConfig checks the attributes a zone of its type needs
+func checkZonerunnerZoneConfig(d *schema.ResourceData) error {
+	switch d.Get("type").(string) {
+	case "master":
+		if d.Get("zone_file").(string) == "" {
+			return fmt.Errorf("A master zone requires zone_file")
+		}
+	case "slave":
+		if len(d.Get("masters").([]interface{})) == 0 {
+			return fmt.Errorf("A slave zone requires masters")
+		}
+	case "forward":
+		if len(d.Get("forwarders").([]interface{})) == 0 {
+			return fmt.Errorf("A forward zone requires forwarders")
+		}
+	}
+	return nil
+}
+
+// zonerunnerZoneStatement returns the zone statement of named.conf, the name with a trailing dot the way
+// ZoneRunner writes it
+func zonerunnerZoneStatement(d *schema.ResourceData) string {
+	view := d.Get("view").(string)
+	name := d.Get("name").(string)
+	zoneType := d.Get("type").(string)
+
+	options := []string{"type " + zoneType + ";"}
+	if zoneType != "forward" {
+		options = append(options, fmt.Sprintf("file \"%s\";", namedZoneFile(view, name)))
+	}
+	for _, list := range []struct{ keyword, attribute string }{
+		{"allow-transfer", "allow_transfer"},
+		{"allow-update", "allow_update"},
+		{"masters", "masters"},
+		{"forwarders", "forwarders"},
+	} {
+		if values := listToStringSlice(d.Get(list.attribute).([]interface{})); len(values) > 0 {
+			options = append(options, namedListStatement(list.keyword, values))
+		}
+	}
+	return fmt.Sprintf("zone \"%s.\" {\n\t\t%s\n\t};", strings.TrimSuffix(name, "."), strings.Join(options, "\n\t\t"))
+}
+
+// findNamedZone returns the statements of a view and of one of its zones, nil when there is no such view or zone
+func findNamedZone(conf, view, name string) (*namedStatement, *namedStatement, error) {
+	statements, err := parseNamedStatements(conf, 0, len(conf))
+	if err != nil {
+		return nil, nil, err
+	}
+	v := findNamedStatement(statements, "view", view)
+	if v == nil {
+		return nil, nil, nil
+	}
+	zones, err := parseNamedStatements(conf, v.bodyStart, v.bodyEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, findNamedStatement(zones, "zone", name), nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testNamedConfPath = "/var/named/config/named.conf"
+const testZoneFilePath = "/var/named/config/namedb/db.internal.example.com."
+
+func testBigipGtmZonerunnerSplitHorizon(url, allowTransfer string) string {
+	return fmt.Sprintf(`
+		resource "bigip_gtm_zonerunner_view" "internal" {
+			name = "internal"
+			match_clients = ["10.0.0.0/8", "key internal-key"]
+			before = "external"
+		}
+		resource "bigip_gtm_zonerunner_zone" "internal" {
+			view = "${bigip_gtm_zonerunner_view.internal.name}"
+			name = "example.com"
+			zone_file = "$TTL 300\n@ IN SOA ns1.example.com. hostmaster.example.com. 1 3600 600 86400 300\n@ IN NS ns1.example.com.\nns1 IN A 10.1.1.53\n"
+			allow_update = ["localhost"]
+			allow_transfer = [%s]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, allowTransfer, url)
+}
+
+func TestAccBigipGtmZonerunnerSplitHorizon(t *testing.T) {
+	files := map[string]string{testNamedConfPath: testNamedConf}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/util/bash", namedHandler(t, files))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Equal(t, testNamedConf, files[testNamedConfPath], "named.conf is back as it was")
+			assert.NotContains(t, files, testZoneFilePath)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipGtmZonerunnerSplitHorizon(server.URL, `"10.1.1.54"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_view.internal", "match_clients.1", "key internal-key"),
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_zone.internal", "id", "internal:example.com"),
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_zone.internal", "type", "master"),
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_zone.internal", "allow_transfer.0", "10.1.1.54"),
+					func(s *terraform.State) error {
+						conf := files[testNamedConfPath]
+						assert.True(t, strings.Index(conf, `view "internal"`) < strings.Index(conf, `view "external"`), "the internal view is matched first")
+						assert.Contains(t, conf, "match-clients { 10.0.0.0/8; key internal-key; };")
+						assert.Contains(t, conf, "zone \"example.com.\" {\n\t\ttype master;\n\t\tfile \"db.internal.example.com.\";\n\t\tallow-transfer { 10.1.1.54; };")
+						assert.Contains(t, files[testZoneFilePath], "ns1 IN A 10.1.1.53")
+						return nil
+					},
+				),
+			},
+			{
+				Config: testBigipGtmZonerunnerSplitHorizon(server.URL, `"10.1.1.54", "key transfer-key"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_zone.internal", "allow_transfer.1", "key transfer-key"),
+					resource.TestCheckResourceAttr("bigip_gtm_zonerunner_view.internal", "zones.0", "example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmZonerunnerZoneRequiresMasters(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", provisionHandler("nominal"))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_gtm_zonerunner_zone" "slave" {
+						view = "external"
+						name = "example.org"
+						type = "slave"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				ExpectError: regexp.MustCompile("A slave zone requires masters"),
+			},
+		},
+	})
+}
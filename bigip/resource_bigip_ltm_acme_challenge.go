@@ -0,0 +1,234 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The iRule and data group answering HTTP-01 challenges are named with this prefix, followed by
+// the name of the virtual server.
+const acmeChallengePrefix = "acme_challenge__"
+
+// acmeChallengeRule answers requests for /.well-known/acme-challenge/<token> with the key
+// authorization stored for the token in the data group, and lets all other requests through.
+const acmeChallengeRule = `when HTTP_REQUEST priority 100 {
+    if { [HTTP::path] starts_with "/.well-known/acme-challenge/" } {
+        set token [lindex [split [HTTP::path] "/"] end]
+        set response [class match -value -- $token equals %s]
+        if { $response ne "" } {
+            HTTP::respond 200 content $response "Content-Type" "text/plain"
+        } else {
+            HTTP::respond 404
+        }
+    }
+}`
+
+type virtualServerRules struct {
+	Rules []string `json:"rules"`
+}
+
+func resourceBigipLtmAcmeChallenge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmAcmeChallengeCreate,
+		Update: resourceBigipLtmAcmeChallengeUpdate,
+		Read:   resourceBigipLtmAcmeChallengeRead,
+		Delete: resourceBigipLtmAcmeChallengeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_server": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the virtual server answering the challenges, it needs an HTTP profile",
+				ValidateFunc: validateF5Name,
+			},
+			"challenges": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Key authorizations to answer with, by challenge token",
+			},
+			"irule": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the iRule answering the challenges",
+			},
+			"datagroup": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the data group holding the key authorizations",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmAcmeChallengeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Get("virtual_server").(string)
+	partition, name := acmeChallengeName(vs)
+	fullPath := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Setting up ACME challenge responder on virtual server %s", vs)
+
+	err := client.AddInternalDataGroup(&bigip.DataGroup{
+		Name:    fullPath,
+		Type:    "string",
+		Records: getAcmeChallengeRecords(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Data Group List (%s): %s", fullPath, err)
+	}
+	err = client.CreateIRule(fullPath, fmt.Sprintf(acmeChallengeRule, fullPath))
+	if err != nil {
+		client.DeleteInternalDataGroup(fullPath)
+		return fmt.Errorf("Error creating iRule (%s): %s", fullPath, err)
+	}
+
+	current, err := client.GetVirtualServer(vs)
+	if err == nil && current == nil {
+		err = fmt.Errorf("not found")
+	}
+	if err == nil {
+		err = patchEntity(client, &virtualServerRules{Rules: append(current.Rules, fullPath)}, uriLtm, "virtual", vs)
+	}
+	if err != nil {
+		client.DeleteIRule(fullPath)
+		client.DeleteInternalDataGroup(fullPath)
+		return fmt.Errorf("Error attaching iRule (%s) to virtual server (%s): %s", fullPath, vs, err)
+	}
+
+	d.SetId(vs)
+	return readAfterCreate(d, meta, resourceBigipLtmAcmeChallengeRead)
+}
+
+func resourceBigipLtmAcmeChallengeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	partition, name := acmeChallengeName(d.Id())
+	fullPath := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Modifying ACME challenges of virtual server %s", d.Id())
+
+	err := client.ModifyInternalDataGroupRecords(fullPath, "string", getAcmeChallengeRecords(d))
+	if err != nil {
+		return fmt.Errorf("Error modifying Data Group List (%s): %s", fullPath, err)
+	}
+	return resourceBigipLtmAcmeChallengeRead(d, meta)
+}
+
+func resourceBigipLtmAcmeChallengeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	partition, name := acmeChallengeName(vs)
+	fullPath := fmt.Sprintf("/%s/%s", partition, name)
+
+	rule, err := client.IRule(fullPath)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve iRule (%s) (%v)", fullPath, err)
+		return err
+	}
+	if rule == nil {
+		log.Printf("[WARN] iRule (%s) not found, removing from state", fullPath)
+		d.SetId("")
+		return nil
+	}
+	dg, err := client.GetInternalDataGroup(fullPath)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Data Group List (%s) (%v)", fullPath, err)
+		return err
+	}
+	if dg == nil {
+		log.Printf("[WARN] Data Group List (%s) not found, removing from state", fullPath)
+		d.SetId("")
+		return nil
+	}
+
+	challenges := make(map[string]interface{}, len(dg.Records))
+	for _, r := range dg.Records {
+		challenges[r.Name] = r.Data
+	}
+	if err := d.Set("challenges", challenges); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Challenges to state for ACME challenge responder (%s): %s", vs, err)
+	}
+	d.Set("virtual_server", vs)
+	d.Set("irule", fullPath)
+	d.Set("datagroup", fullPath)
+
+	return nil
+}
+
+func resourceBigipLtmAcmeChallengeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	partition, name := acmeChallengeName(vs)
+	fullPath := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Removing ACME challenge responder from virtual server %s", vs)
+
+	current, err := client.GetVirtualServer(vs)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Virtual Server (%s) (%v)", vs, err)
+		return err
+	}
+	if current != nil {
+		rules := []string{}
+		for _, r := range current.Rules {
+			if r != fullPath {
+				rules = append(rules, r)
+			}
+		}
+		err = patchEntity(client, &virtualServerRules{Rules: rules}, uriLtm, "virtual", vs)
+		if err != nil {
+			log.Printf("[ERROR] Unable to detach iRule (%s) from Virtual Server (%s) (%v)", fullPath, vs, err)
+			return err
+		}
+	}
+	err = client.DeleteIRule(fullPath)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete iRule (%s) (%v)", fullPath, err)
+		return err
+	}
+	err = client.DeleteInternalDataGroup(fullPath)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Data Group List (%s) (%v)", fullPath, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// acmeChallengeName returns the partition and name of the iRule and data group answering challenges for a virtual server,
+// they are kept in the folder of the virtual server
+func acmeChallengeName(vs string) (string, string) {
+	partition, name := parseF5Identifier(vs)
+	i := strings.LastIndex(name, "/") + 1
+	return partition, name[:i] + acmeChallengePrefix + name[i:]
+}
+
+func getAcmeChallengeRecords(d *schema.ResourceData) []bigip.DataGroupRecord {
+	challenges := d.Get("challenges").(map[string]interface{})
+	tokens := make([]string, 0, len(challenges))
+	for token := range challenges {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	records := []bigip.DataGroupRecord{}
+	for _, token := range tokens {
+		records = append(records, bigip.DataGroupRecord{Name: token, Data: challenges[token].(string)})
+	}
+	return records
+}
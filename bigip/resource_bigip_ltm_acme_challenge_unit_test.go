@@ -0,0 +1,116 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmAcmeChallenge(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_acme_challenge" "test-acme" {
+			virtual_server = "/Common/test-vs"
+			challenges = {
+				"test-token" = "test-token.test-thumbprint"
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipLtmAcmeChallengeCreate(t *testing.T) {
+	rules := []string{"/Common/test-rule"}
+	var ruleDeleted, dgDeleted bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/internal", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"/Common/acme_challenge__test-vs","type":"string",
+			"records":[{"name":"test-token","data":"test-token.test-thumbprint"}]}`, string(b))
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/internal/~Common~acme_challenge__test-vs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			dgDeleted = true
+		}
+		fmt.Fprintf(w, `{"name":"acme_challenge__test-vs","type":"string","records":[{"name":"test-token","data":"test-token.test-thumbprint"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/rule", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]string
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		assert.Equal(t, "/Common/acme_challenge__test-vs", body["name"])
+		assert.Contains(t, body["apiAnonymous"], "class match -value -- $token equals /Common/acme_challenge__test-vs")
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/rule/~Common~acme_challenge__test-vs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			ruleDeleted = true
+		}
+		fmt.Fprintf(w, `{"name":"acme_challenge__test-vs","fullPath":"/Common/acme_challenge__test-vs"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			var body virtualServerRules
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &body)
+			rules = body.Rules
+		}
+		b, _ := json.Marshal(map[string]interface{}{"name": "test-vs", "fullPath": "/Common/test-vs", "rules": rules})
+		w.Write(b)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/profiles", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmAcmeChallenge(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_acme_challenge.test-acme", "irule", "/Common/acme_challenge__test-vs"),
+					resource.TestCheckResourceAttr("bigip_ltm_acme_challenge.test-acme", "datagroup", "/Common/acme_challenge__test-vs"),
+					resource.TestCheckResourceAttr("bigip_ltm_acme_challenge.test-acme", "challenges.test-token", "test-token.test-thumbprint"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{"/Common/test-rule", "/Common/acme_challenge__test-vs"}, rules)
+						return nil
+					},
+				),
+			},
+		},
+	})
+	assert.Equal(t, []string{"/Common/test-rule"}, rules, "iRule was not detached")
+	assert.True(t, ruleDeleted, "iRule was not deleted")
+	assert.True(t, dgDeleted, "Data Group List was not deleted")
+}
+
+func TestAttachedByOtherResource(t *testing.T) {
+	assert.True(t, attachedByOtherResource("/Common/acme_challenge__test-vs"))
+	assert.True(t, attachedByOtherResource("asm_auto_l7_policy__test-vs"))
+	assert.False(t, attachedByOtherResource("/Common/test-rule"))
+}
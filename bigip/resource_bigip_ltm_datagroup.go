@@ -95,7 +95,7 @@ func resourceBigipLtmDataGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(name)
 
-	return resourceBigipLtmDataGroupRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmDataGroupRead)
 }
 
 func resourceBigipLtmDataGroupRead(d *schema.ResourceData, meta interface{}) error {
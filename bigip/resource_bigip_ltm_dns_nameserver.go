@@ -0,0 +1,153 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriNameserver = "nameserver"
+
+type dnsNameserver struct {
+	Name        string `json:"name,omitempty"`
+	Address     string `json:"address,omitempty"`
+	Port        int    `json:"port,omitempty"`
+	RouteDomain string `json:"routeDomain,omitempty"`
+	TsigKey     string `json:"tsigKey,omitempty"`
+}
+
+func resourceBigipLtmDnsNameserver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmDnsNameserverCreate,
+		Update: resourceBigipLtmDnsNameserverUpdate,
+		Read:   resourceBigipLtmDnsNameserverRead,
+		Delete: resourceBigipLtmDnsNameserverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the name server",
+				ValidateFunc: validateF5Name,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP address of the name server",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      53,
+				Description:  "Port of the name server",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"route_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/0",
+				Description: "Route domain the address is in",
+			},
+			"tsig_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the TSIG key that signs the messages exchanged with the name server",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDnsNameserverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating DNS name server " + name)
+
+	n := getDnsNameserverConfig(d)
+	n.Name = name
+	err := postEntity(client, n, uriLtm, uriDns, uriNameserver)
+	if err != nil {
+		return fmt.Errorf("Error creating DNS name server (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmDnsNameserverRead)
+}
+
+func resourceBigipLtmDnsNameserverUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getDnsNameserverConfig(d), uriLtm, uriDns, uriNameserver, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying DNS name server (%s): %s", name, err)
+	}
+	return resourceBigipLtmDnsNameserverRead(d, meta)
+}
+
+func resourceBigipLtmDnsNameserverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var n dnsNameserver
+	ok, err := getForEntity(client, &n, uriLtm, uriDns, uriNameserver, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve DNS name server (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] DNS name server (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("address", n.Address)
+	d.Set("port", n.Port)
+	d.Set("route_domain", n.RouteDomain)
+	if n.TsigKey == "none" {
+		n.TsigKey = ""
+	}
+	d.Set("tsig_key", n.TsigKey)
+	return nil
+}
+
+func resourceBigipLtmDnsNameserverDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting DNS name server " + name)
+
+	err := deleteEntity(client, uriLtm, uriDns, uriNameserver, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DNS name server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getDnsNameserverConfig(d *schema.ResourceData) *dnsNameserver {
+	n := &dnsNameserver{
+		Address:     d.Get("address").(string),
+		Port:        d.Get("port").(int),
+		RouteDomain: d.Get("route_domain").(string),
+		TsigKey:     d.Get("tsig_key").(string),
+	}
+	if n.TsigKey == "" {
+		n.TsigKey = "none"
+	}
+	return n
+}
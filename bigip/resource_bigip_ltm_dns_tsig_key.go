@@ -0,0 +1,129 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriTsigKey = "tsig-key"
+
+type dnsTsigKey struct {
+	Name      string `json:"name,omitempty"`
+	Algorithm string `json:"algorithm,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+}
+
+func resourceBigipLtmDnsTsigKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmDnsTsigKeyCreate,
+		Update: resourceBigipLtmDnsTsigKeyUpdate,
+		Read:   resourceBigipLtmDnsTsigKeyRead,
+		Delete: resourceBigipLtmDnsTsigKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the TSIG key, the name has to match the key name configured on the peer name server",
+				ValidateFunc: validateF5Name,
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hmac-md5",
+				Description:  "Algorithm of the key: hmac-md5, hmac-sha1 or hmac-sha256",
+				ValidateFunc: validateStringValue([]string{"hmac-md5", "hmac-sha1", "hmac-sha256"}),
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded secret of the key",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDnsTsigKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating TSIG key " + name)
+
+	k := getDnsTsigKeyConfig(d)
+	k.Name = name
+	err := postEntity(client, k, uriLtm, uriDns, uriTsigKey)
+	if err != nil {
+		return fmt.Errorf("Error creating TSIG key (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmDnsTsigKeyRead)
+}
+
+func resourceBigipLtmDnsTsigKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getDnsTsigKeyConfig(d), uriLtm, uriDns, uriTsigKey, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying TSIG key (%s): %s", name, err)
+	}
+	return resourceBigipLtmDnsTsigKeyRead(d, meta)
+}
+
+func resourceBigipLtmDnsTsigKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var k dnsTsigKey
+	ok, err := getForEntity(client, &k, uriLtm, uriDns, uriTsigKey, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve TSIG key (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] TSIG key (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	// The secret is read back encrypted, it is left as configured
+	d.Set("name", name)
+	d.Set("algorithm", k.Algorithm)
+	return nil
+}
+
+func resourceBigipLtmDnsTsigKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting TSIG key " + name)
+
+	err := deleteEntity(client, uriLtm, uriDns, uriTsigKey, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete TSIG key (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getDnsTsigKeyConfig(d *schema.ResourceData) *dnsTsigKey {
+	return &dnsTsigKey{
+		Algorithm: d.Get("algorithm").(string),
+		Secret:    d.Get("secret").(string),
+	}
+}
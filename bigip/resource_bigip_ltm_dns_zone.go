@@ -0,0 +1,190 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriZone = "zone"
+
+type dnsZone struct {
+	Name                       string   `json:"name,omitempty"`
+	DnsExpressEnabled          string   `json:"dnsExpressEnabled,omitempty"`
+	DnsExpressServer           string   `json:"dnsExpressServer,omitempty"`
+	DnsExpressNotifyAction     string   `json:"dnsExpressNotifyAction,omitempty"`
+	DnsExpressAllowNotify      []string `json:"dnsExpressAllowNotify"`
+	DnsExpressNotifyTsigVerify string   `json:"dnsExpressNotifyTsigVerify,omitempty"`
+	ServerTsigKey              string   `json:"serverTsigKey,omitempty"`
+	TransferClients            []string `json:"transferClients"`
+	ResponsePolicy             string   `json:"responsePolicy,omitempty"`
+}
+
+func resourceBigipLtmDnsZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmDnsZoneCreate,
+		Update: resourceBigipLtmDnsZoneUpdate,
+		Read:   resourceBigipLtmDnsZoneRead,
+		Delete: resourceBigipLtmDnsZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the zone, the name is the domain of the zone, e.g. /Common/example.com",
+				ValidateFunc: validateF5Name,
+			},
+			"dns_express_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "yes",
+				Description:  "Whether DNS Express answers queries for the zone",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"dns_express_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the name server the zone is transferred from",
+			},
+			"notify_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "consume",
+				Description:  "What is done with NOTIFY messages for the zone: consume, bypass or repeat",
+				ValidateFunc: validateStringValue([]string{"consume", "bypass", "repeat"}),
+			},
+			"allow_notify_from": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Addresses NOTIFY messages are accepted from, besides the DNS Express server",
+			},
+			"verify_notify_tsig": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "yes",
+				Description:  "Whether NOTIFY messages have to be signed with a TSIG key",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"server_tsig_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the TSIG key the zone is transferred with",
+			},
+			"transfer_clients": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Full paths of the name servers allowed to transfer the zone from the BIG-IP",
+			},
+			"response_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "no",
+				Description:  "Whether the zone is a response policy zone",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating DNS zone " + name)
+
+	z := getDnsZoneConfig(d)
+	z.Name = name
+	err := postEntity(client, z, uriLtm, uriDns, uriZone)
+	if err != nil {
+		return fmt.Errorf("Error creating DNS zone (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmDnsZoneRead)
+}
+
+func resourceBigipLtmDnsZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getDnsZoneConfig(d), uriLtm, uriDns, uriZone, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying DNS zone (%s): %s", name, err)
+	}
+	return resourceBigipLtmDnsZoneRead(d, meta)
+}
+
+func resourceBigipLtmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var z dnsZone
+	ok, err := getForEntity(client, &z, uriLtm, uriDns, uriZone, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve DNS zone (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] DNS zone (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("dns_express_enabled", z.DnsExpressEnabled)
+	d.Set("dns_express_server", z.DnsExpressServer)
+	d.Set("notify_action", z.DnsExpressNotifyAction)
+	if err := d.Set("allow_notify_from", z.DnsExpressAllowNotify); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving AllowNotify to state for DNS zone (%s): %s", name, err)
+	}
+	d.Set("verify_notify_tsig", z.DnsExpressNotifyTsigVerify)
+	d.Set("server_tsig_key", z.ServerTsigKey)
+	if err := d.Set("transfer_clients", z.TransferClients); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving TransferClients to state for DNS zone (%s): %s", name, err)
+	}
+	d.Set("response_policy", z.ResponsePolicy)
+	return nil
+}
+
+func resourceBigipLtmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting DNS zone " + name)
+
+	err := deleteEntity(client, uriLtm, uriDns, uriZone, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DNS zone (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getDnsZoneConfig(d *schema.ResourceData) *dnsZone {
+	return &dnsZone{
+		DnsExpressEnabled:          d.Get("dns_express_enabled").(string),
+		DnsExpressServer:           d.Get("dns_express_server").(string),
+		DnsExpressNotifyAction:     d.Get("notify_action").(string),
+		DnsExpressAllowNotify:      setToStringSlice(d.Get("allow_notify_from").(*schema.Set)),
+		DnsExpressNotifyTsigVerify: d.Get("verify_notify_tsig").(string),
+		ServerTsigKey:              d.Get("server_tsig_key").(string),
+		TransferClients:            setToStringSlice(d.Get("transfer_clients").(*schema.Set)),
+		ResponsePolicy:             d.Get("response_policy").(string),
+	}
+}
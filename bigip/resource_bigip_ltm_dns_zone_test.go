@@ -0,0 +1,125 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DNS_ZONE_NAME = fmt.Sprintf("/%s/test.example.com", TEST_PARTITION)
+
+var TEST_DNS_ZONE_RESOURCE = `
+resource "bigip_ltm_dns_tsig_key" "test-key" {
+	name = "/Common/test-key"
+	algorithm = "hmac-sha256"
+	secret = "c2VjcmV0LXNlY3JldC1zZWNyZXQ="
+}
+
+resource "bigip_ltm_dns_nameserver" "test-ns" {
+	name = "/Common/test-ns"
+	address = "10.10.10.53"
+	tsig_key = "${bigip_ltm_dns_tsig_key.test-key.name}"
+}
+
+resource "bigip_ltm_dns_zone" "test-zone" {
+	name = "` + TEST_DNS_ZONE_NAME + `"
+	dns_express_server = "${bigip_ltm_dns_nameserver.test-ns.name}"
+	server_tsig_key = "${bigip_ltm_dns_tsig_key.test-key.name}"
+	notify_action = "repeat"
+	allow_notify_from = ["10.10.10.54"]
+}
+`
+
+func TestAccBigipLtmDnsZone_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmDnsZoneDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_ZONE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmDnsZoneExists(TEST_DNS_ZONE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_dns_zone.test-zone", "name", TEST_DNS_ZONE_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_dns_zone.test-zone", "notify_action", "repeat"),
+					resource.TestCheckResourceAttr("bigip_ltm_dns_zone.test-zone",
+						fmt.Sprintf("allow_notify_from.%d", schema.HashString("10.10.10.54")),
+						"10.10.10.54"),
+					resource.TestCheckResourceAttr("bigip_ltm_dns_zone.test-zone", "dns_express_server", "/Common/test-ns"),
+					resource.TestCheckResourceAttr("bigip_ltm_dns_zone.test-zone", "server_tsig_key", "/Common/test-key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmDnsZone_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmDnsZoneDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_ZONE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmDnsZoneExists(TEST_DNS_ZONE_NAME, true),
+				),
+				ResourceName:      TEST_DNS_ZONE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmDnsZoneExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p dnsZone
+		ok, err := getForEntity(client, &p, uriLtm, uriDns, uriZone, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("DNS zone %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("DNS zone %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmDnsZoneDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_dns_zone" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p dnsZone
+		ok, err := getForEntity(client, &p, uriLtm, uriDns, uriZone, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("DNS zone %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -60,7 +60,7 @@ func resourceBigipLtmIRuleCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(name)
 
-	return resourceBigipLtmIRuleRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmIRuleRead)
 }
 
 func resourceBigipLtmIRuleRead(d *schema.ResourceData, meta interface{}) error {
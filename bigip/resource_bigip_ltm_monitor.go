@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// monitorTypes are the monitor types a parent monitor is looked up in
+var monitorTypes = []string{"http", "https", "icmp", "gateway-icmp", "tcp", "tcp-half-open", "ftp", "udp", "postgresql"}
+
 var parentMonitors = map[string]bool{
 	"/Common/udp":           true,
 	"/Common/postgresql":    true,
@@ -50,14 +53,14 @@ func resourceBigipLtmMonitor() *schema.Resource {
 			"parent": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateParent,
+				ValidateFunc: validateF5Name,
 				ForceNew:     true,
-				Description:  "Existing monitor to inherit from. Must be one of /Common/http, /Common/https, /Common/icmp or /Common/gateway-icmp.",
+				Description:  "Full path of an existing monitor to inherit from, a built-in one like /Common/http or a user-defined one in any partition.",
 			},
 			"defaults_from": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Existing monitor to inherit from. Must be one of /Common/http, /Common/https, /Common/icmp or /Common/gateway-icmp.",
+				Description: "Existing monitor to inherit from, defaults to parent when it is a user-defined monitor.",
 			},
 
 			"interval": {
@@ -179,20 +182,38 @@ func resourceBigipLtmMonitor() *schema.Resource {
 func resourceBigipLtmMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Get("name").(string)
+	parent := d.Get("parent").(string)
+
+	monitorType, err := getMonitorType(client, parent)
+	if err != nil {
+		return err
+	}
+	defaultsFrom := d.Get("defaults_from").(string)
+	if defaultsFrom == "" && !parentMonitors[parent] {
+		defaultsFrom = parent
+	}
 
-	log.Println("[INFO] Creating monitor " + name + " :: " + monitorParent(d.Get("parent").(string)))
-
-	err := client.CreateMonitor(
-		name,
-		monitorParent(d.Get("parent").(string)),
-		d.Get("defaults_from").(string),
-		d.Get("interval").(int),
-		d.Get("timeout").(int),
-		d.Get("send").(string),
-		d.Get("receive").(string),
-		d.Get("receive_disable").(string),
-		d.Get("compatibility").(string),
-	)
+	log.Println("[INFO] Creating monitor " + name + " :: " + monitorType)
+
+	if defaultsFrom != "" {
+		// go-bigip drops defaultsFrom when sending a monitor, the remaining settings are applied by the update below
+		err = postEntity(client, map[string]string{
+			"name":         name,
+			"defaultsFrom": defaultsFrom,
+		}, uriLtm, "monitor", monitorType)
+	} else {
+		err = client.CreateMonitor(
+			name,
+			monitorType,
+			"",
+			d.Get("interval").(int),
+			d.Get("timeout").(int),
+			d.Get("send").(string),
+			d.Get("receive").(string),
+			d.Get("receive_disable").(string),
+			d.Get("compatibility").(string),
+		)
+	}
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create Monitor (%s) (%v) ", name, err)
 		return err
@@ -201,7 +222,7 @@ func resourceBigipLtmMonitorCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(name)
 
 	resourceBigipLtmMonitorUpdate(d, meta)
-	return resourceBigipLtmMonitorRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmMonitorRead)
 }
 
 func resourceBigipLtmMonitorRead(d *schema.ResourceData, meta interface{}) error {
@@ -209,48 +230,50 @@ func resourceBigipLtmMonitorRead(d *schema.ResourceData, meta interface{}) error
 
 	name := d.Id()
 
-	monitors, err := client.Monitors()
+	monitorType, err := findMonitorType(client, name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrieve Monitor (%s) (%v) ", name, err)
 		return err
 	}
-	if monitors == nil {
+	var m bigip.Monitor
+	ok := false
+	if monitorType != "" {
+		ok, err = getForEntity(client, &m, uriLtm, "monitor", monitorType, name)
+		if err != nil {
+			log.Printf("[ERROR] Unable to retrieve Monitor (%s) (%v) ", name, err)
+			return err
+		}
+	}
+	if !ok {
 		log.Printf("[WARN] Monitor (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
-	for _, m := range monitors {
-		if m.FullPath == name {
-			d.Set("defaults_from", m.DefaultsFrom)
-			d.Set("interval", m.Interval)
-			d.Set("timeout", m.Timeout)
-			if err := d.Set("send", m.SendString); err != nil {
-				return fmt.Errorf("[DEBUG] Error saving SendString to state for Monitor (%s): %s", d.Id(), err)
-			}
-			if err := d.Set("receive", m.ReceiveString); err != nil {
-				return fmt.Errorf("[DEBUG] Error saving ReceiveString to state for Monitor (%s): %s", d.Id(), err)
-			}
-			d.Set("receive_disable", m.ReceiveDisable)
-			d.Set("reverse", m.Reverse)
-			d.Set("transparent", m.Transparent)
-			d.Set("ip_dscp", m.IPDSCP)
-			d.Set("time_until_up", m.TimeUntilUp)
-			d.Set("manual_resume", m.ManualResume)
-			d.Set("destination", m.Destination)
-			d.Set("compatibility", m.Compatibility)
-			d.Set("filename", m.Filename)
-			d.Set("mode", m.Mode)
-			d.Set("adaptive", m.Adaptive)
-			d.Set("adaptive_limit", m.AdaptiveLimit)
-			d.Set("username", m.Username)
-			d.Set("password", m.Password)
-			d.Set("name", name)
-
-			return nil
-		}
+	d.Set("defaults_from", m.DefaultsFrom)
+	d.Set("interval", m.Interval)
+	d.Set("timeout", m.Timeout)
+	if err := d.Set("send", m.SendString); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving SendString to state for Monitor (%s): %s", d.Id(), err)
 	}
-	return fmt.Errorf("Couldn't find monitor %s", name)
-
+	if err := d.Set("receive", m.ReceiveString); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ReceiveString to state for Monitor (%s): %s", d.Id(), err)
+	}
+	d.Set("receive_disable", m.ReceiveDisable)
+	d.Set("reverse", m.Reverse)
+	d.Set("transparent", m.Transparent)
+	d.Set("ip_dscp", m.IPDSCP)
+	d.Set("time_until_up", m.TimeUntilUp)
+	d.Set("manual_resume", m.ManualResume)
+	d.Set("destination", m.Destination)
+	d.Set("compatibility", m.Compatibility)
+	d.Set("filename", m.Filename)
+	d.Set("mode", m.Mode)
+	d.Set("adaptive", m.Adaptive)
+	d.Set("adaptive_limit", m.AdaptiveLimit)
+	d.Set("username", m.Username)
+	d.Set("password", m.Password)
+	d.Set("name", name)
+	return nil
 }
 
 func resourceBigipLtmMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -259,23 +282,17 @@ func resourceBigipLtmMonitorExists(d *schema.ResourceData, meta interface{}) (bo
 	name := d.Id()
 	log.Println("[INFO] Fetching monitor " + name)
 
-	monitors, err := client.Monitors()
+	monitorType, err := findMonitorType(client, name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrieve Monitor (%s) (%v) ", name, err)
 		return false, err
 	}
-	if monitors == nil {
+	if monitorType == "" {
 		log.Printf("[WARN] Monitor (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return false, nil
 	}
-	for _, m := range monitors {
-		if m.FullPath == name {
-			return true, nil
-		}
-	}
-
-	return false, nil
+	return true, nil
 }
 
 func resourceBigipLtmMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -303,7 +320,11 @@ func resourceBigipLtmMonitorUpdate(d *schema.ResourceData, meta interface{}) err
 		Password:       d.Get("password").(string),
 	}
 
-	err := client.ModifyMonitor(name, monitorParent(d.Get("parent").(string)), m)
+	monitorType, err := getMonitorType(client, d.Get("parent").(string))
+	if err != nil {
+		return err
+	}
+	err = client.ModifyMonitor(name, monitorType, m)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Update Monitor (%s) (%v) ", name, err)
 		return err
@@ -315,9 +336,12 @@ func resourceBigipLtmMonitorUpdate(d *schema.ResourceData, meta interface{}) err
 func resourceBigipLtmMonitorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	parent := monitorParent(d.Get("parent").(string))
-	log.Println("[Info] Deleting monitor " + name + "::" + parent)
-	err := client.DeleteMonitor(name, parent)
+	monitorType, err := getMonitorType(client, d.Get("parent").(string))
+	if err != nil {
+		return err
+	}
+	log.Println("[Info] Deleting monitor " + name + "::" + monitorType)
+	err = client.DeleteMonitor(name, monitorType)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Monitor (%s) (%v) ", name, err)
 		return err
@@ -326,15 +350,38 @@ func resourceBigipLtmMonitorDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func validateParent(v interface{}, k string) ([]string, []error) {
-	p := v.(string)
-	if parentMonitors[p] {
-		return nil, nil
-	}
+func monitorParent(s string) string {
+	return strings.TrimPrefix(s, "/Common/")
+}
 
-	return nil, []error{fmt.Errorf("parent must be one of /Common/udp, /Common/postgresql, /Common/http, /Common/https, /Common/icmp, /Common/gateway-icmp, /Common/tcp-half-open, /Common/tcp, /Common/ftp")}
+// getMonitorType returns the type of monitor inheriting from parent. A parent other than the
+// built-in ones is looked up in every monitor type, which also makes sure it exists.
+func getMonitorType(client *bigip.BigIP, parent string) (string, error) {
+	if parentMonitors[parent] {
+		return monitorParent(parent), nil
+	}
+	t, err := findMonitorType(client, parent)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving parent monitor (%s): %s", parent, err)
+	}
+	if t == "" {
+		return "", fmt.Errorf("Parent monitor %s not found, it must be an existing http, https, icmp, gateway-icmp, tcp, tcp-half-open, ftp, udp or postgresql monitor", parent)
+	}
+	return t, nil
 }
 
-func monitorParent(s string) string {
-	return strings.TrimPrefix(s, "/Common/")
+// findMonitorType returns the type of an existing monitor, or "" when there is no monitor of that name. Only the
+// name of the monitor is fetched from each type, rather than listing every monitor of every type.
+func findMonitorType(client *bigip.BigIP, name string) (string, error) {
+	for _, t := range monitorTypes {
+		var m struct{}
+		ok, err := getForEntity(client, &m, uriLtm, "monitor", t, name+selectQuery("name"))
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return t, nil
+		}
+	}
+	return "", nil
 }
@@ -0,0 +1,115 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmMonitorCustomParent(url, parent string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_monitor" "test-monitor" {
+			name = "/Common/test-monitor"
+			parent = "%s"
+			interval = 10
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, parent, url)
+}
+
+func TestAccBigipLtmMonitorCustomParent(t *testing.T) {
+	var created, deleted bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mgmt/tm/ltm/monitor/https/~Tenant~base-https":
+			fmt.Fprintf(w, `{"name":"base-https","partition":"Tenant","fullPath":"/Tenant/base-https"}`)
+		case "/mgmt/tm/ltm/monitor/https":
+			if r.Method == "POST" {
+				b, _ := ioutil.ReadAll(r.Body)
+				assert.JSONEq(t, `{"name":"/Common/test-monitor","defaultsFrom":"/Tenant/base-https"}`, string(b))
+				created = true
+				fmt.Fprintf(w, `{}`)
+				return
+			}
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		case "/mgmt/tm/ltm/monitor/https/~Common~test-monitor":
+			switch {
+			case r.Method == "DELETE":
+				deleted = true
+			case r.Method == "GET" && created && !deleted:
+				fmt.Fprintf(w, `{"name":"test-monitor","fullPath":"/Common/test-monitor","interval":10}`)
+				return
+			case r.Method == "GET":
+				w.WriteHeader(404)
+				fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+				return
+			}
+			fmt.Fprintf(w, `{}`)
+		default:
+			if r.Method != "GET" {
+				t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+		}
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmMonitorCustomParent(server.URL, "/Tenant/base-https"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "parent", "/Tenant/base-https"),
+					resource.TestCheckResourceAttr("bigip_ltm_monitor.test-monitor", "interval", "10"),
+				),
+			},
+		},
+	})
+	assert.True(t, created, "monitor was not created")
+	assert.True(t, deleted, "monitor was not deleted")
+}
+
+func TestAccBigipLtmMonitorMissingParent(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/monitor/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipLtmMonitorCustomParent(server.URL, "/Tenant/missing"),
+				ExpectError: regexp.MustCompile("Parent monitor /Tenant/missing not found"),
+			},
+		},
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceBigipLtmNode() *schema.Resource {
@@ -38,9 +39,17 @@ func resourceBigipLtmNode() *schema.Resource {
 			"address": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Address of the node",
+				Description: "Address of the node, e.g. 10.1.1.1, or 10.1.1.1%2 in route domain 2",
 				ForceNew:    true,
 			},
+			"route_domain": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Route domain of the address, an alternative to its %<id> suffix",
+				ValidateFunc: validation.IntBetween(0, 65534),
+			},
 			"rate_limit": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -137,9 +146,14 @@ func resourceBigipLtmNodeCreate(d *schema.ResourceData, meta interface{}) error
 
 	r, _ := regexp.Compile("^((?:[0-9]{1,3}.){3}[0-9]{1,3})|(.*:.*)$")
 
-	log.Println("[INFO] Creating node " + name + "::" + address)
 	var err error
 	if r.MatchString(address) {
+		routeDomain := d.Get("route_domain").(int)
+		if err := checkRouteDomain(address, routeDomain); err != nil {
+			return err
+		}
+		address = withRouteDomain(address, routeDomain)
+		log.Println("[INFO] Creating node " + name + "::" + address)
 		err = client.CreateNode(
 			name,
 			address,
@@ -152,6 +166,7 @@ func resourceBigipLtmNodeCreate(d *schema.ResourceData, meta interface{}) error
 			ratio,
 		)
 	} else {
+		log.Println("[INFO] Creating node " + name + "::" + address)
 		interval := d.Get("fqdn.0.interval").(string)
 		address_family := d.Get("fqdn.0.address_family").(string)
 		autopopulate := d.Get("fqdn.0.autopopulate").(string)
@@ -180,7 +195,7 @@ func resourceBigipLtmNodeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(name)
 
-	return resourceBigipLtmNodeRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmNodeRead)
 }
 
 func resourceBigipLtmNodeRead(d *schema.ResourceData, meta interface{}) error {
@@ -205,13 +220,12 @@ func resourceBigipLtmNodeRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[DEBUG] Error saving address to state for Node (%s): %s", d.Id(), err)
 		}
 	} else {
-		// xxx.xxx.xxx.xxx(%x)
-		regex := regexp.MustCompile(`((?:[0-9]{1,3}\.){3}[0-9]{1,3})(?:\%\d+)?`)
-		address := regex.FindStringSubmatch(node.Address)
-		log.Println("[INFO] Address: " + address[1])
-		if err := d.Set("address", node.Address); err != nil {
+		// xxx.xxx.xxx.xxx(%x), the route domain id is kept when it is configured
+		if err := d.Set("address", configuredAddress(d.Get("address").(string), node.Address)); err != nil {
 			return fmt.Errorf("[DEBUG] Error saving address to state for Node (%s): %s", d.Id(), err)
 		}
+		_, routeDomain, _ := splitRouteDomain(node.Address)
+		d.Set("route_domain", routeDomain)
 	}
 	d.Set("name", name)
 	//if err := d.Set("monitor", node.Monitor); err != nil {
@@ -263,7 +277,7 @@ func resourceBigipLtmNodeUpdate(d *schema.ResourceData, meta interface{}) error
 	var node *bigip.Node
 	if r.MatchString(address) {
 		node = &bigip.Node{
-			Address:         address,
+			Address:         withRouteDomain(address, d.Get("route_domain").(int)),
 			ConnectionLimit: d.Get("connection_limit").(int),
 			DynamicRatio:    d.Get("dynamic_ratio").(int),
 			Monitor:         d.Get("monitor").(string),
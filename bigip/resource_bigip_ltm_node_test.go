@@ -137,6 +137,47 @@ func TestAccBigipLtmNode_import(t *testing.T) {
 	})
 }
 
+var TEST_RD_NODE_RESOURCE = TEST_ROUTEDOMAIN_RESOURCE + `
+resource "bigip_ltm_node" "test-rd-node" {
+	name = "/` + TEST_PARTITION + `/test-rd-node"
+	address = "192.168.31.1%12"
+	depends_on = ["bigip_net_routedomain.test-routedomain"]
+}
+resource "bigip_ltm_node" "test-rd-attribute-node" {
+	name = "/` + TEST_PARTITION + `/test-rd-attribute-node"
+	address = "192.168.31.2"
+	route_domain = 12
+	depends_on = ["bigip_net_routedomain.test-routedomain"]
+}
+`
+
+// Nodes in a route domain keep their address as configured, the plan is empty once they are applied
+func TestAccBigipLtmNode_routeDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckNodesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RD_NODE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNodeExists("/"+TEST_PARTITION+"/test-rd-node", true),
+					resource.TestCheckResourceAttr("bigip_ltm_node.test-rd-node", "address", "192.168.31.1%12"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.test-rd-node", "route_domain", "12"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.test-rd-attribute-node", "address", "192.168.31.2"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.test-rd-attribute-node", "route_domain", "12"),
+				),
+			},
+			{
+				Config:   TEST_RD_NODE_RESOURCE,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testCheckNodeExists(name string, exists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
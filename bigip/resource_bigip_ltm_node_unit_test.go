@@ -73,6 +73,7 @@ func TestAccBigipLtmNodeCreate(t *testing.T) {
 		fmt.Fprintf(w, `{
 }`)
 	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
 	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
 		//fmt.Println(r)
 		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
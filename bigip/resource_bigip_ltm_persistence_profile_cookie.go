@@ -91,6 +91,14 @@ func resourceBigipLtmPersistenceProfileCookie() *schema.Resource {
 			},
 
 			// Specific to CookiePersistenceProfile
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Cookie persistence method: insert, rewrite, passive or hash",
+				ValidateFunc: validateStringValue([]string{"insert", "rewrite", "passive", "hash"}),
+			},
+
 			"always_send": {
 				Type:         schema.TypeString,
 				Default:      "default",
@@ -175,7 +183,7 @@ func resourceBigipLtmPersistenceProfileCookieCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	return resourceBigipLtmPersistenceProfileCookieRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPersistenceProfileCookieRead)
 
 }
 
@@ -214,6 +222,7 @@ func resourceBigipLtmPersistenceProfileCookieRead(d *schema.ResourceData, meta i
 	d.Set("override_conn_limit", pp.OverrideConnectionLimit)
 
 	// Specific to CookiePersistenceProfile
+	d.Set("method", pp.Method)
 	d.Set("always_send", pp.AlwaysSend)
 	if err := d.Set("cookie_encryption", pp.CookieEncryption); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving CookieEncryption to state for PersistenceProfileCookie (%s): %s", d.Id(), err)
@@ -242,6 +251,7 @@ func resourceBigipLtmPersistenceProfileCookieUpdate(d *schema.ResourceData, meta
 			MatchAcrossPools:        d.Get("match_across_pools").(string),
 			MatchAcrossServices:     d.Get("match_across_services").(string),
 			MatchAcrossVirtuals:     d.Get("match_across_virtuals").(string),
+			Method:                  d.Get("method").(string),
 			Mirror:                  d.Get("mirror").(string),
 			OverrideConnectionLimit: d.Get("override_conn_limit").(string),
 			Timeout:                 strconv.Itoa(d.Get("timeout").(int)),
@@ -26,6 +26,7 @@ resource "bigip_ltm_persistence_profile_cookie" "test_ppcookie" {
 	match_across_virtuals = "enabled"
 	timeout = 3600
 	override_conn_limit = "enabled"
+	method = "insert"
 	always_send = "enabled"
 	cookie_encryption = "disabled"
 	cookie_name = "ham"
@@ -55,6 +56,7 @@ func TestAccBigipLtmPersistenceProfileCookieCreate(t *testing.T) {
 					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "match_across_virtuals", "enabled"),
 					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "timeout", "3600"),
 					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "override_conn_limit", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "method", "insert"),
 					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "always_send", "enabled"),
 					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_cookie.test_ppcookie", "cookie_encryption", "disabled"),
 					// unable to validate since value is encrypted
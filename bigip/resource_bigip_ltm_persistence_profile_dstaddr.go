@@ -129,7 +129,7 @@ func resourceBigipLtmPersistenceProfileDstAddrCreate(d *schema.ResourceData, met
 		return err
 	}
 
-	return resourceBigipLtmPersistenceProfileDstAddrRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPersistenceProfileDstAddrRead)
 
 }
 
@@ -137,7 +137,7 @@ func resourceBigipLtmPersistenceProfileSrcAddrCreate(d *schema.ResourceData, met
 		return err
 	}
 
-	return resourceBigipLtmPersistenceProfileSrcAddrRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPersistenceProfileSrcAddrRead)
 
 }
 
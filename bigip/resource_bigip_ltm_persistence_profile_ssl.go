@@ -113,7 +113,7 @@ func resourceBigipLtmPersistenceProfileSSLCreate(d *schema.ResourceData, meta in
 		return err
 	}
 
-	return resourceBigipLtmPersistenceProfileSSLRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPersistenceProfileSSLRead)
 
 }
 
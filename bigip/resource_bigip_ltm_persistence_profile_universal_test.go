@@ -0,0 +1,121 @@
+/*
+Original work from https://github.com/DealerDotCom/terraform-provider-bigip
+Modifications Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_PPUNIVERSAL_NAME = fmt.Sprintf("/%s/test-ppuniversal", TEST_PARTITION)
+
+var TEST_PPUNIVERSAL_RESOURCE = `
+resource "bigip_ltm_persistence_profile_universal" "test_ppuniversal" {
+	name = "` + TEST_PPUNIVERSAL_NAME + `"
+	defaults_from = "/Common/universal"
+	match_across_pools = "enabled"
+	match_across_services = "enabled"
+	match_across_virtuals = "enabled"
+	mirror = "enabled"
+	timeout = 3600
+	override_conn_limit = "enabled"
+	rule = "/Common/_sys_https_redirect"
+}
+
+`
+
+func TestAccBigipLtmPersistenceProfileUniversalCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(testCheckBigipLtmPersistenceProfileUniversalDestroyed),
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PPUNIVERSAL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileUniversalExists(TEST_PPUNIVERSAL_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "name", TEST_PPUNIVERSAL_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "defaults_from", "/Common/universal"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "match_across_pools", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "match_across_services", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "match_across_virtuals", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "mirror", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "timeout", "3600"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "override_conn_limit", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "rule", "/Common/_sys_https_redirect"),
+				),
+			},
+		},
+	})
+
+}
+
+func TestAccBigipLtmPersistenceProfileUniversalImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileUniversalDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PPUNIVERSAL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileUniversalExists(TEST_PPUNIVERSAL_NAME, true),
+				),
+				ResourceName:      TEST_PPUNIVERSAL_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileUniversalExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		pp, err := client.GetUniversalPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+		if exists && pp == nil {
+			return fmt.Errorf("Universal Persistence Profile %s does not exist.", name)
+		}
+		if !exists && pp != nil {
+			return fmt.Errorf("Universal Persistence Profile %s exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileUniversalDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_universal" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		pp, err := client.GetUniversalPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+
+		if pp != nil {
+			return fmt.Errorf("Universal Persistence Profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -35,7 +34,7 @@ func resourceBigipLtmPolicy() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Name of the Policy",
+				Description: "Name of the Policy, /Partition/Name for a partition other than Common",
 				ForceNew:    true,
 			},
 			"published_copy": {
@@ -1075,13 +1074,13 @@ func resourceBigipLtmPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	published_copy := d.Get("published_copy").(string)
 	if published_copy == "" {
-		published_copy = "Drafts/" + name
+		published_copy = policyDraftName(name)
 	}
 	t := client.PublishPolicy(name, published_copy)
 	if t != nil {
 		return t
 	}
-	return resourceBigipLtmPolicyRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPolicyRead)
 }
 
 func resourceBigipLtmPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -1128,7 +1127,7 @@ func resourceBigipLtmPolicyUpdate(d *schema.ResourceData, meta interface{}) erro
 	name := d.Id()
 	log.Println("[INFO] Updating  Policy " + name)
 	p := dataToPolicy(name, d)
-	err := client.UpdatePolicy(name, &p)
+	err := updatePolicyDraft(client, name, &p)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Policy   (%s) (%v) ", name, err)
 		return err
@@ -1148,14 +1147,33 @@ func resourceBigipLtmPolicyDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// policyDraftName returns the name of the draft of a policy, Drafts/<name> in Common or the partition of the
+// user, /<partition>/Drafts/<name> in another partition
+func policyDraftName(name string) string {
+	partition, policy := parseF5Identifier(name)
+	if partition == "" {
+		return "Drafts/" + name
+	}
+	return fmt.Sprintf("/%s/Drafts/%s", partition, policy)
+}
+
+// updatePolicyDraft replaces the draft of a policy. client.UpdatePolicy only knows the drafts of Common.
+func updatePolicyDraft(client *bigip.BigIP, name string, p *bigip.Policy) error {
+	for ri := range p.Rules {
+		p.Rules[ri].Ordinal = ri
+		for ai := range p.Rules[ri].Actions {
+			p.Rules[ri].Actions[ai].Name = fmt.Sprintf("%d", ai)
+		}
+		for ci := range p.Rules[ri].Conditions {
+			p.Rules[ri].Conditions[ci].Name = fmt.Sprintf("%d", ci)
+		}
+	}
+	return putEntity(client, p, uriLtm, "policy", policyDraftName(name))
+}
+
 func dataToPolicy(name string, d *schema.ResourceData) bigip.Policy {
 	var p bigip.Policy
-	values := []string{}
-	values = append(values, "Drafts/")
-	values = append(values, name)
-	// Join three strings into one.
-	result := strings.Join(values, "")
-	p.Name = result
+	p.Name = policyDraftName(name)
 	p.Strategy = d.Get("strategy").(string)
 	p.Controls = setToStringSlice(d.Get("controls").(*schema.Set))
 	p.Requires = setToStringSlice(d.Get("requires").(*schema.Set))
@@ -1221,7 +1239,7 @@ func interfaceToResourceData(obj interface{}, d *schema.ResourceData, prefix str
 		if fn != "Name" && fn != "Generation" {
 			f := v.Field(fi)
 			if (f.Kind() == reflect.Slice && f.Interface() != nil) || f.Interface() != reflect.Zero(f.Type()).Interface() {
-				d.Set(fmt.Sprintf("%s.%s%s", prefix, strings.ToLower(fn[0:1]), fn[1:]), f.Interface())
+				d.Set(fmt.Sprintf("%s.%s", prefix, schemaFieldName(v.Type().Field(fi))), f.Interface())
 			}
 		}
 	}
@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"reflect"
 	"testing"
 )
 
@@ -71,6 +73,46 @@ resource "bigip_ltm_policy" "http_to_https_redirect" {
     }
   }
 }
+resource "bigip_ltm_policy" "test-policy-conditions" {
+  depends_on = ["bigip_ltm_pool.test-pool"]
+  name = "test-policy-conditions"
+  strategy = "/Common/first-match"
+  requires = ["http", "client-ssl", "tcp"]
+  published_copy = "Drafts/test-policy-conditions"
+  controls = ["forwarding"]
+  rule  {
+    name = "api_by_sni"
+    condition {
+      ssl_extension = true
+      server_name = true
+      ssl_client_hello = true
+      equals = true
+      values = ["api.example.com"]
+    }
+    condition {
+      http_uri = true
+      path = true
+      starts_with = true
+      values = ["/v1/"]
+    }
+    condition {
+      http_header = true
+      tm_name = "X-Tenant"
+      equals = true
+      values = ["internal"]
+    }
+    action {
+      http_header = true
+      replace = true
+      tm_name = "X-Forwarded-Proto"
+      value = "https"
+    }
+    action {
+      forward = true
+      pool = "/Common/test-pool"
+    }
+  }
+}
 `
 
 func TestAccBigipLtmPolicy_create(t *testing.T) {
@@ -86,6 +128,13 @@ func TestAccBigipLtmPolicy_create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckPolicyExists(TEST_POLICY_NAME, true),
 					testCheckPolicyExists("http_to_https_redirect", true),
+					testCheckPolicyExists("test-policy-conditions", true),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.condition.#", "3"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.condition.0.server_name", "true"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.condition.1.starts_with", "true"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.condition.2.tm_name", "X-Tenant"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.action.0.http_header", "true"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.test-policy-conditions", "rule.0.action.0.tm_name", "X-Forwarded-Proto"),
 				),
 			},
 		},
@@ -153,3 +202,49 @@ func testCheckPolicysDestroyed(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestPolicyRuleSchemaCoversAllFields(t *testing.T) {
+	rule := resourceBigipLtmPolicy().Schema["rule"].Elem.(*schema.Resource)
+	for block, obj := range map[string]interface{}{
+		"action":    bigip.PolicyRuleAction{},
+		"condition": bigip.PolicyRuleCondition{},
+	} {
+		keys := rule.Schema[block].Elem.(*schema.Resource).Schema
+		fields := schemaFieldIndex(reflect.TypeOf(obj))
+		for k := range keys {
+			if _, ok := fields[k]; !ok {
+				t.Errorf("%s.%s does not map to a field of %T", block, k, obj)
+			}
+		}
+		for k := range fields {
+			if _, ok := keys[k]; !ok && k != "name" && k != "generation" {
+				t.Errorf("field %s of %T is missing from the %s schema", k, obj, block)
+			}
+		}
+	}
+}
+
+func TestMapEntityPolicyRule(t *testing.T) {
+	var a bigip.PolicyRuleAction
+	mapEntity(map[string]interface{}{
+		"http_header": true,
+		"replace":     true,
+		"tm_name":     "X-Forwarded-Proto",
+		"value":       "https",
+		"vlan_id":     10,
+	}, &a)
+	if !a.HttpHeader || !a.Replace || a.TmName != "X-Forwarded-Proto" || a.Value != "https" || a.VlanId != 10 {
+		t.Errorf("unexpected action %+v", a)
+	}
+
+	var c bigip.PolicyRuleCondition
+	mapEntity(map[string]interface{}{
+		"ssl_extension": true,
+		"server_name":   true,
+		"last_15secs":   true,
+		"values":        []interface{}{"api.example.com"},
+	}, &c)
+	if !c.SslExtension || !c.ServerName || !c.Last_15secs || len(c.Values) != 1 || c.Values[0] != "api.example.com" {
+		t.Errorf("unexpected condition %+v", c)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmPolicyPartition(url, requires string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_policy" "tenant" {
+			name = "/Tenant/web-policy"
+			strategy = "/Common/first-match"
+			requires = [%s]
+			controls = ["forwarding"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, requires, url)
+}
+
+func TestAccBigipLtmPolicyPartition(t *testing.T) {
+	var policy map[string]interface{}
+	var requests []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/policy", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		requests = append(requests, fmt.Sprintf("%s %s %v %v", r.Method, r.URL.Path, body["command"], body["name"]))
+		if body["command"] == nil {
+			policy = body
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Tenant~Drafts~web-policy", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &policy)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Tenant~web-policy", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			policy = nil
+			return
+		}
+		if policy == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": "web-policy", "partition": "Tenant", "fullPath": "/Tenant/web-policy",
+			"strategy": policy["strategy"], "controls": policy["controls"], "requires": policy["requires"],
+		})
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Tenant~web-policy/rules", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmPolicyPartition(server.URL, `"http"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_policy.tenant", "id", "/Tenant/web-policy"),
+					resource.TestCheckResourceAttr("bigip_ltm_policy.tenant", "requires.#", "1"),
+				),
+			},
+			{
+				Config: testBigipLtmPolicyPartition(server.URL, `"http", "tcp"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_policy.tenant", "requires.#", "2"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, []string{
+		"POST /mgmt/tm/ltm/policy <nil> /Tenant/Drafts/web-policy",
+		"POST /mgmt/tm/ltm/policy publish /Tenant/Drafts/web-policy",
+		"PUT /mgmt/tm/ltm/policy/~Tenant~Drafts~web-policy",
+		"DELETE /mgmt/tm/ltm/policy/~Tenant~web-policy",
+	}, requests, "The drafts of the policy are in its partition")
+}
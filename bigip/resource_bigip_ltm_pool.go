@@ -88,6 +88,34 @@ func resourceBigipLtmPool() *schema.Resource {
 				Computed:    true,
 				Description: "Number of times the system tries to select a new pool member after a failure.",
 			},
+
+			"ip_tos_to_client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ToS (DSCP) value set in packets to the client: pass-through, mimic or 0-255",
+			},
+
+			"ip_tos_to_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ToS (DSCP) value set in packets to the server: pass-through, mimic or 0-255",
+			},
+
+			"link_qos_to_client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Link QoS value set in packets to the client: pass-through or 0-7",
+			},
+
+			"link_qos_to_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Link QoS value set in packets to the server: pass-through or 0-7",
+			},
 		},
 	}
 }
@@ -109,7 +137,7 @@ func resourceBigipLtmPoolCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	return resourceBigipLtmPoolRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmPoolRead)
 }
 
 func resourceBigipLtmPoolRead(d *schema.ResourceData, meta interface{}) error {
@@ -148,6 +176,10 @@ func resourceBigipLtmPoolRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] ERror saving ReselectTries to state for Pool  (%s): %s", d.Id(), err)
 	}
 	d.Set("description", pool.Description)
+	d.Set("ip_tos_to_client", pool.IPTOSToClient)
+	d.Set("ip_tos_to_server", pool.IPTOSToServer)
+	d.Set("link_qos_to_client", pool.LinkQoSToClient)
+	d.Set("link_qos_to_server", pool.LinkQoSToServer)
 	monitors := strings.Split(strings.TrimSpace(pool.Monitor), " and ")
 	if err := d.Set("monitors", makeStringSet(&monitors)); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Monitors to state for Pool  (%s): %s", d.Id(), err)
@@ -197,6 +229,10 @@ func resourceBigipLtmPoolUpdate(d *schema.ResourceData, meta interface{}) error
 		ServiceDownAction: d.Get("service_down_action").(string),
 		ReselectTries:     d.Get("reselect_tries").(int),
 		Monitor:           strings.Join(monitors, " and "),
+		IPTOSToClient:     d.Get("ip_tos_to_client").(string),
+		IPTOSToServer:     d.Get("ip_tos_to_server").(string),
+		LinkQoSToClient:   d.Get("link_qos_to_client").(string),
+		LinkQoSToServer:   d.Get("link_qos_to_server").(string),
 	}
 	err := client.ModifyPool(name, pool)
 	if err != nil {
@@ -81,30 +81,16 @@ func resourceBigipLtmPoolAttachmentRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	nodes, err := client.PoolMembers(poolName)
+	found, err := poolHasMember(client, poolName, expected)
 	if err != nil {
 		return fmt.Errorf("Error retrieving pool (%s) members: %s", poolName, err)
 	}
-	if nodes == nil {
-		log.Printf("[WARN] Pool Members (%s) not found, removing from state", poolName)
-		d.SetId("")
-		return nil
-	}
-
-	// only set the instance Id that this resource manages
-	found := false
-	for _, node := range nodes.PoolMembers {
-		if expected == node.FullPath {
-			d.Set("node", expected)
-			found = true
-			break
-		}
-	}
-
 	if !found {
 		log.Printf("[WARN] Node %s is not a member of pool %s", expected, poolName)
 		d.SetId("")
+		return nil
 	}
+	d.Set("node", expected)
 
 	return nil
 }
@@ -152,20 +138,11 @@ func resourceBigipLtmPoolAttachmentImport(d *schema.ResourceData, meta interface
 		return nil, fmt.Errorf("unable to find the pool %s in bigip", poolName)
 	}
 
-	nodes, err := client.PoolMembers(poolName)
+	found, err := poolHasMember(client, poolName, expectedNode)
 	if err != nil {
 		return nil, errors.New("error retrieving pool members")
 	}
 
-	// only set the instance Id that this resource manages
-	found := false
-	for _, node := range nodes.PoolMembers {
-		if expectedNode == node.FullPath {
-			found = true
-			break
-		}
-	}
-
 	if !found {
 		return nil, fmt.Errorf("cannot locate node %s in pool %s", expectedNode, poolName)
 	}
@@ -176,3 +153,21 @@ func resourceBigipLtmPoolAttachmentImport(d *schema.ResourceData, meta interface
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// poolHasMember reports whether member, e.g. /Common/node1:80, is a member of pool. Members are read page by
+// page, so pools with more members than fit a response are handled.
+func poolHasMember(client *bigip.BigIP, pool, member string) (bool, error) {
+	var members []struct {
+		FullPath string `json:"fullPath"`
+	}
+	_, err := getCollection(client, &members, selectQuery("fullPath"), uriLtm, "pool", pool, "members")
+	if err != nil {
+		return false, err
+	}
+	for _, m := range members {
+		if m.FullPath == member {
+			return true, nil
+		}
+	}
+	return false, nil
+}
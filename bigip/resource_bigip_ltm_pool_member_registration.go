@@ -0,0 +1,245 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// bigip_ltm_pool_member_registration registers an instance as the member of a pool, e.g. with for_each over the
+// instances of an auto scaling group. Deregistering it drains the member before removing it: the member is
+// disabled, or forced offline, and removed once its connections are at most the drain threshold, or once the drain
+// timeout has elapsed. A member found disabled, e.g. by a drain that timed out, is enabled again.
+func resourceBigipLtmPoolMemberRegistration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmPoolMemberRegistrationCreate,
+		Read:   resourceBigipLtmPoolMemberRegistrationRead,
+		Update: resourceBigipLtmPoolMemberRegistrationUpdate,
+		Delete: resourceBigipLtmPoolMemberRegistrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"pool": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the pool",
+				ValidateFunc: validateF5Name,
+			},
+			"member": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePoolMemberName,
+				Description:  "Member to register in the pool. Format /partition/node_name:port. e.g. /Common/10.1.1.1:443",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Address of the node of the member, the node is created with the member when it does not exist",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the member is enabled, set to false to drain the member before it is deregistered",
+			},
+			"drain_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disable",
+				Description:  "How the member is drained: disable lets persistent connections in, force_offline only lets active connections complete",
+				ValidateFunc: validateStringValue([]string{"disable", "force_offline"}),
+			},
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "Seconds to wait for the member to drain before it is removed",
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"drain_connection_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Current connections at or below which the member is drained",
+				ValidateFunc: validation.IntBetween(0, 1000000),
+			},
+			"remove_on_drain_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Remove the member when it has not drained in drain_timeout, rather than fail and leave it disabled",
+			},
+			"delete_node": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the node of the member once it is removed, unless another pool uses it",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmPoolMemberRegistrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+	path := []string{uriLtm, "pool", pool, "members", member}
+
+	found, err := getForEntity(client, &struct{}{}, path...)
+	if err != nil {
+		return fmt.Errorf("Error retrieving member %s of pool %s: %s", member, pool, err)
+	}
+	if found {
+		// e.g. a member left by a deregistration that failed
+		log.Printf("[INFO] Member %s of pool %s is already registered", member, pool)
+		err = patchEntity(client, poolMemberSession(d), path...)
+	} else {
+		log.Printf("[INFO] Registering member %s in pool %s", member, pool)
+		body := poolMemberSession(d)
+		body["name"] = member
+		if address := d.Get("address").(string); address != "" {
+			body["address"] = address
+		}
+		err = postEntity(client, body, uriLtm, "pool", pool, "members")
+	}
+	if err != nil {
+		return fmt.Errorf("Failure registering member %s in pool %s: %s", member, pool, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", pool, member))
+	return resourceBigipLtmPoolMemberRegistrationRead(d, meta)
+}
+
+func resourceBigipLtmPoolMemberRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+
+	var m struct {
+		Address string `json:"address"`
+		Session string `json:"session"`
+		State   string `json:"state"`
+	}
+	found, err := getForEntity(client, &m, uriLtm, "pool", pool, "members", member)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve member (%s) of pool (%s) (%v) ", member, pool, err)
+		return err
+	}
+	if !found {
+		log.Printf("[WARN] Member %s of pool %s not found, removing from state", member, pool)
+		d.SetId("")
+		return nil
+	}
+	d.Set("address", m.Address)
+	d.Set("enabled", m.Session != "user-disabled" && m.State != "user-down")
+	return nil
+}
+
+// resourceBigipLtmPoolMemberRegistrationUpdate enables or disables the member, the other arguments only change how
+// it is deregistered
+func resourceBigipLtmPoolMemberRegistrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+	if d.HasChange("enabled") || d.HasChange("drain_mode") {
+		log.Printf("[INFO] Updating member %s of pool %s", member, pool)
+		if err := patchEntity(client, poolMemberSession(d), uriLtm, "pool", pool, "members", member); err != nil {
+			return fmt.Errorf("Failure updating member %s of pool %s: %s", member, pool, err)
+		}
+	}
+	return resourceBigipLtmPoolMemberRegistrationRead(d, meta)
+}
+
+func resourceBigipLtmPoolMemberRegistrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	member := d.Get("member").(string)
+	path := []string{uriLtm, "pool", pool, "members", member}
+
+	log.Printf("[INFO] Draining member %s of pool %s", member, pool)
+	d.Set("enabled", false)
+	if err := patchEntity(client, poolMemberSession(d), path...); err != nil {
+		return fmt.Errorf("Failure disabling member %s of pool %s: %s", member, pool, err)
+	}
+	timeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+	if _, ok := dryRunClients.Load(client); !ok && timeout > 0 {
+		conns, err := waitForPoolMemberDrain(client, pool, member, d.Get("drain_connection_threshold").(int), timeout)
+		if err != nil {
+			return err
+		}
+		if conns > d.Get("drain_connection_threshold").(int) {
+			if !d.Get("remove_on_drain_timeout").(bool) {
+				return fmt.Errorf("Member %s of pool %s still has %d connections after %s, it is left disabled", member, pool, conns, timeout)
+			}
+			log.Printf("[WARN] Member %s of pool %s still has %d connections after %s, removing it", member, pool, conns, timeout)
+		}
+	}
+
+	log.Printf("[INFO] Removing member %s from pool %s", member, pool)
+	if err := deleteEntity(client, path...); err != nil {
+		log.Printf("[ERROR] Unable to Delete member (%s) of pool (%s) (%s) ", member, pool, err)
+		return fmt.Errorf("Failure removing member %s from pool %s: %s", member, pool, err)
+	}
+	if d.Get("delete_node").(bool) {
+		node := member[:strings.LastIndex(member, ":")]
+		if err := deleteEntity(client, uriLtm, "node", node); err != nil {
+			// The node is still used by the members of other pools
+			log.Printf("[WARN] Node %s of member %s not deleted: %s", node, member, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// poolMemberSession returns the session and state of the member, disabled according to drain_mode when it is not
+// enabled
+func poolMemberSession(d *schema.ResourceData) map[string]string {
+	if d.Get("enabled").(bool) {
+		return map[string]string{"session": "user-enabled", "state": "user-up"}
+	}
+	if d.Get("drain_mode").(string) == "force_offline" {
+		return map[string]string{"session": "user-disabled", "state": "user-down"}
+	}
+	return map[string]string{"session": "user-disabled", "state": "user-up"}
+}
+
+// waitForPoolMemberDrain waits for the current connections of a pool member to be at most threshold, and returns
+// them, above threshold when the timeout elapsed
+func waitForPoolMemberDrain(client *bigip.BigIP, pool, member string, threshold int, timeout time.Duration) (int, error) {
+	conns := 0
+	var readErr error
+	resource.Retry(timeout, func() *resource.RetryError {
+		var s stats
+		if _, err := getForEntity(client, &s, uriLtm, "pool", pool, "members", member, "stats"); err != nil {
+			readErr = fmt.Errorf("Error retrieving the connections of member %s of pool %s: %s", member, pool, err)
+			return resource.NonRetryableError(readErr)
+		}
+		conns = 0
+		for _, e := range s.Entries {
+			conns += e.NestedStats.Entries["serverside.curConns"].Value
+		}
+		if conns > threshold {
+			return resource.RetryableError(fmt.Errorf("member %s of pool %s has %d connections", member, pool, conns))
+		}
+		return nil
+	})
+	return conns, readErr
+}
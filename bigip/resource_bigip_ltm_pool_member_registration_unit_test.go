@@ -0,0 +1,144 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMemberPath = "/mgmt/tm/ltm/pool/~Common~web/members/~Common~10.1.1.1:80"
+
+func testBigipPoolMemberRegistration(url string, removeOnTimeout bool) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_pool_member_registration" "web" {
+			pool = "/Common/web"
+			member = "/Common/10.1.1.1:80"
+			address = "10.1.1.1"
+			drain_mode = "force_offline"
+			drain_timeout = 2
+			drain_connection_threshold = 1
+			remove_on_drain_timeout = %t
+			delete_node = true
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, removeOnTimeout, url)
+}
+
+// poolMemberHandler answers the pool members and nodes of objects, and the stats of the member with conns, the
+// current connections of the member, which are closed one by one when closing is set
+func poolMemberHandler(objects map[string]map[string]interface{}, calls *[]string, conns *int, closing *bool) http.HandlerFunc {
+	handler := objectsHandler(objects)
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/stats") {
+			if *closing && *conns > 0 {
+				*conns--
+			}
+			fmt.Fprintf(w, `{"entries":{"https://localhost%s":{"nestedStats":{"entries":{"serverside.curConns":{"value":%d}}}}}}`,
+				strings.TrimSuffix(r.URL.Path, "/stats"), *conns)
+			return
+		}
+		if r.Method != "GET" {
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+		}
+		handler(w, r)
+	}
+}
+
+func TestAccBigipLtmPoolMemberRegistration(t *testing.T) {
+	objects := map[string]map[string]interface{}{
+		"/mgmt/tm/ltm/node/~Common~10.1.1.1": {"name": "10.1.1.1"},
+	}
+	var calls []string
+	conns, closing := 3, true
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", poolMemberHandler(objects, &calls, &conns, &closing))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(objects) > 0 {
+				return fmt.Errorf("objects %v were not deleted", objects)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipPoolMemberRegistration(server.URL, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_pool_member_registration.web", "id", "/Common/web-/Common/10.1.1.1:80"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool_member_registration.web", "address", "10.1.1.1"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, []string{
+		"POST /mgmt/tm/ltm/pool/~Common~web/members",
+		"PATCH " + testMemberPath,
+		"DELETE " + testMemberPath,
+		"DELETE /mgmt/tm/ltm/node/~Common~10.1.1.1",
+	}, calls, "the member is disabled, and removed with its node once drained")
+	assert.Equal(t, 1, conns, "the member is removed once at the threshold")
+}
+
+func TestAccBigipLtmPoolMemberRegistrationDrainTimeout(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	var calls []string
+	conns, closing := 5, false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", poolMemberHandler(objects, &calls, &conns, &closing))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipPoolMemberRegistration(server.URL, false),
+			},
+			{
+				Config:      testBigipPoolMemberRegistration(server.URL, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Member /Common/10.1.1.1:80 of pool /Common/web still has 5 connections after 2s, it is left disabled"),
+			},
+			{
+				// The member left disabled is enabled again, and drains once its connections are closed
+				PreConfig: func() {
+					assert.Equal(t, "user-down", objects[testMemberPath]["state"])
+					conns, closing = 2, true
+				},
+				Config: testBigipPoolMemberRegistration(server.URL, false),
+				Check: func(s *terraform.State) error {
+					if objects[testMemberPath]["state"] != "user-up" {
+						return fmt.Errorf("member %v was not enabled again", objects[testMemberPath])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -39,6 +39,9 @@ resource "bigip_ltm_pool" "test-pool" {
 	slow_ramp_time = "5"
 	service_down_action = "reset"
 	reselect_tries = "2"
+	ip_tos_to_client = "pass-through"
+	ip_tos_to_server = "184"
+	link_qos_to_server = "3"
 }
 
 resource "bigip_ltm_pool_attachment" "test-pool_test-node" {
@@ -68,6 +71,9 @@ func TestAccBigipLtmPool_create(t *testing.T) {
 					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "slow_ramp_time", "5"),
 					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "service_down_action", "reset"),
 					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "reselect_tries", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ip_tos_to_client", "pass-through"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "ip_tos_to_server", "184"),
+					resource.TestCheckResourceAttr("bigip_ltm_pool.test-pool", "link_qos_to_server", "3"),
 					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.test-pool_test-node", "pool", TEST_POOL_NAME),
 					resource.TestCheckResourceAttr("bigip_ltm_pool_attachment.test-pool_test-node", "node", TEST_POOLNODE_NAMEPORT),
 				),
@@ -0,0 +1,298 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriAnalytics = "analytics"
+
+type analyticsProfile struct {
+	Name                          string           `json:"name,omitempty"`
+	DefaultsFrom                  string           `json:"defaultsFrom,omitempty"`
+	CollectGeo                    string           `json:"collectGeo,omitempty"`
+	CollectIp                     string           `json:"collectIp,omitempty"`
+	CollectMaxTpsAndThroughput    string           `json:"collectMaxTpsAndThroughput,omitempty"`
+	CollectMethods                string           `json:"collectMethods,omitempty"`
+	CollectPageLoadTime           string           `json:"collectPageLoadTime,omitempty"`
+	CollectResponseCodes          string           `json:"collectResponseCodes,omitempty"`
+	CollectSubnets                string           `json:"collectSubnets,omitempty"`
+	CollectUrl                    string           `json:"collectUrl,omitempty"`
+	CollectUserAgent              string           `json:"collectUserAgent,omitempty"`
+	CollectUserSessions           string           `json:"collectUserSessions,omitempty"`
+	CollectedStatsInternalLogging string           `json:"collectedStatsInternalLogging,omitempty"`
+	CollectedStatsExternalLogging string           `json:"collectedStatsExternalLogging,omitempty"`
+	ExternalLoggingPublisher      string           `json:"externalLoggingPublisher,omitempty"`
+	NotificationByEmail           string           `json:"notificationByEmail,omitempty"`
+	NotificationBySnmp            string           `json:"notificationBySnmp,omitempty"`
+	NotificationBySyslog          string           `json:"notificationBySyslog,omitempty"`
+	NotificationEmailAddresses    []string         `json:"notificationEmailAddresses,omitempty"`
+	Alerts                        []analyticsAlert `json:"alerts"`
+	AlertsReference               *analyticsAlerts `json:"alertsReference,omitempty"`
+}
+
+// analyticsAlerts is how alerts are read back with expandSubcollections=true
+type analyticsAlerts struct {
+	Items []analyticsAlert `json:"items"`
+}
+
+type analyticsAlert struct {
+	Name              string `json:"name"`
+	Metric            string `json:"metric"`
+	Threshold         int    `json:"threshold"`
+	ThresholdRelation string `json:"thresholdRelation,omitempty"`
+	ThresholdDuration int    `json:"thresholdDuration,omitempty"`
+	Granularity       string `json:"granularity,omitempty"`
+}
+
+func resourceBigipLtmProfileAnalytics() *schema.Resource {
+	collect := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  description,
+			ValidateFunc: validateEnabledDisabled,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileAnalyticsCreate,
+		Update: resourceBigipLtmProfileAnalyticsUpdate,
+		Read:   resourceBigipLtmProfileAnalyticsRead,
+		Delete: resourceBigipLtmProfileAnalyticsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Analytics Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/analytics",
+				Description: "Use the parent Analytics profile",
+			},
+			"collect_geo":                      collect("To enable _ disable collecting statistics per client country"),
+			"collect_ip":                       collect("To enable _ disable collecting statistics per client IP address"),
+			"collect_max_tps_and_throughput":   collect("To enable _ disable collecting the maximum TPS and throughput"),
+			"collect_methods":                  collect("To enable _ disable collecting statistics per HTTP method"),
+			"collect_page_load_time":           collect("To enable _ disable collecting the page load time measured by the client"),
+			"collect_response_codes":           collect("To enable _ disable collecting statistics per HTTP response code"),
+			"collect_subnets":                  collect("To enable _ disable collecting statistics per client subnet"),
+			"collect_url":                      collect("To enable _ disable collecting statistics per URL"),
+			"collect_user_agent":               collect("To enable _ disable collecting statistics per user agent"),
+			"collect_user_sessions":            collect("To enable _ disable collecting statistics per user session"),
+			"collected_stats_internal_logging": collect("To enable _ disable storing the statistics on the BIG-IP"),
+			"collected_stats_external_logging": collect("To enable _ disable sending the statistics to external_logging_publisher"),
+			"external_logging_publisher": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Log publisher the statistics are sent to",
+			},
+			"notification_by_email":  collect("To enable _ disable sending alerts to notification_email_addresses"),
+			"notification_by_snmp":   collect("To enable _ disable sending alerts as SNMP traps"),
+			"notification_by_syslog": collect("To enable _ disable sending alerts to syslog"),
+			"notification_email_addresses": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Email addresses alerts are sent to",
+			},
+			"alert": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Thresholds raising an alert when a metric crosses them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Metric the alert watches, e.g. average-tps or average-server-latency",
+						},
+						"threshold": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Value of the metric raising the alert",
+						},
+						"threshold_relation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "above",
+							Description:  "Whether the alert is raised above or below the threshold",
+							ValidateFunc: validateStringValue([]string{"above", "below"}),
+						},
+						"threshold_duration": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     10,
+							Description: "Seconds the metric has to cross the threshold before the alert is raised",
+						},
+						"granularity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "virtual-server",
+							Description:  "Whether the metric is measured per application, virtual-server or pool-member",
+							ValidateFunc: validateStringValue([]string{"application", "virtual-server", "pool-member"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileAnalyticsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating Analytics profile " + name)
+
+	p := getAnalyticsProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriAnalytics)
+	if err != nil {
+		return fmt.Errorf("Error creating profile Analytics (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileAnalyticsRead)
+}
+
+func resourceBigipLtmProfileAnalyticsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getAnalyticsProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriAnalytics, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile Analytics (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileAnalyticsRead(d, meta)
+}
+
+func resourceBigipLtmProfileAnalyticsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p analyticsProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriAnalytics, name+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Analytics Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Analytics Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("collect_geo", p.CollectGeo)
+	d.Set("collect_ip", p.CollectIp)
+	d.Set("collect_max_tps_and_throughput", p.CollectMaxTpsAndThroughput)
+	d.Set("collect_methods", p.CollectMethods)
+	d.Set("collect_page_load_time", p.CollectPageLoadTime)
+	d.Set("collect_response_codes", p.CollectResponseCodes)
+	d.Set("collect_subnets", p.CollectSubnets)
+	d.Set("collect_url", p.CollectUrl)
+	d.Set("collect_user_agent", p.CollectUserAgent)
+	d.Set("collect_user_sessions", p.CollectUserSessions)
+	d.Set("collected_stats_internal_logging", p.CollectedStatsInternalLogging)
+	d.Set("collected_stats_external_logging", p.CollectedStatsExternalLogging)
+	d.Set("external_logging_publisher", p.ExternalLoggingPublisher)
+	d.Set("notification_by_email", p.NotificationByEmail)
+	d.Set("notification_by_snmp", p.NotificationBySnmp)
+	d.Set("notification_by_syslog", p.NotificationBySyslog)
+	if err := d.Set("notification_email_addresses", p.NotificationEmailAddresses); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving NotificationEmailAddresses to state for Analytics profile (%s): %s", name, err)
+	}
+
+	alerts := []interface{}{}
+	if p.AlertsReference != nil {
+		for _, a := range p.AlertsReference.Items {
+			alerts = append(alerts, map[string]interface{}{
+				"metric":             a.Metric,
+				"threshold":          a.Threshold,
+				"threshold_relation": a.ThresholdRelation,
+				"threshold_duration": a.ThresholdDuration,
+				"granularity":        a.Granularity,
+			})
+		}
+	}
+	if err := d.Set("alert", alerts); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Alerts to state for Analytics profile (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipLtmProfileAnalyticsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Analytics Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriAnalytics, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Analytics Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getAnalyticsProfileConfig(d *schema.ResourceData) *analyticsProfile {
+	p := &analyticsProfile{
+		DefaultsFrom:                  d.Get("defaults_from").(string),
+		CollectGeo:                    d.Get("collect_geo").(string),
+		CollectIp:                     d.Get("collect_ip").(string),
+		CollectMaxTpsAndThroughput:    d.Get("collect_max_tps_and_throughput").(string),
+		CollectMethods:                d.Get("collect_methods").(string),
+		CollectPageLoadTime:           d.Get("collect_page_load_time").(string),
+		CollectResponseCodes:          d.Get("collect_response_codes").(string),
+		CollectSubnets:                d.Get("collect_subnets").(string),
+		CollectUrl:                    d.Get("collect_url").(string),
+		CollectUserAgent:              d.Get("collect_user_agent").(string),
+		CollectUserSessions:           d.Get("collect_user_sessions").(string),
+		CollectedStatsInternalLogging: d.Get("collected_stats_internal_logging").(string),
+		CollectedStatsExternalLogging: d.Get("collected_stats_external_logging").(string),
+		ExternalLoggingPublisher:      d.Get("external_logging_publisher").(string),
+		NotificationByEmail:           d.Get("notification_by_email").(string),
+		NotificationBySnmp:            d.Get("notification_by_snmp").(string),
+		NotificationBySyslog:          d.Get("notification_by_syslog").(string),
+		NotificationEmailAddresses:    setToStringSlice(d.Get("notification_email_addresses").(*schema.Set)),
+		Alerts:                        []analyticsAlert{},
+	}
+
+	// Alerts are subcollection items and need a name, generate one from their position
+	for i, a := range d.Get("alert").([]interface{}) {
+		alert := a.(map[string]interface{})
+		p.Alerts = append(p.Alerts, analyticsAlert{
+			Name:              fmt.Sprintf("alert-%d", i),
+			Metric:            alert["metric"].(string),
+			Threshold:         alert["threshold"].(int),
+			ThresholdRelation: alert["threshold_relation"].(string),
+			ThresholdDuration: alert["threshold_duration"].(int),
+			Granularity:       alert["granularity"].(string),
+		})
+	}
+	return p
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_ANALYTICS_NAME = fmt.Sprintf("/%s/test-analytics", TEST_PARTITION)
+
+var TEST_ANALYTICS_RESOURCE = `
+resource "bigip_ltm_profile_analytics" "test-analytics" {
+	name = "` + TEST_ANALYTICS_NAME + `"
+	defaults_from = "/Common/analytics"
+	collect_geo = "enabled"
+	collect_url = "enabled"
+	collect_response_codes = "enabled"
+	notification_by_syslog = "enabled"
+	alert {
+		metric = "average-server-latency"
+		threshold = 500
+		threshold_relation = "above"
+	}
+}
+`
+
+func TestAccBigipLtmProfileAnalytics_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileAnalyticsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ANALYTICS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileAnalyticsExists(TEST_ANALYTICS_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "name", TEST_ANALYTICS_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "defaults_from", "/Common/analytics"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_geo", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_url", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_response_codes", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "notification_by_syslog", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.#", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.metric", "average-server-latency"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.threshold", "500"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.granularity", "virtual-server"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileAnalytics_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileAnalyticsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ANALYTICS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileAnalyticsExists(TEST_ANALYTICS_NAME, true),
+				),
+				ResourceName:      TEST_ANALYTICS_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileAnalyticsExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p analyticsProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriAnalytics, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("analytics profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("analytics profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileAnalyticsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_analytics" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p analyticsProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriAnalytics, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("analytics profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
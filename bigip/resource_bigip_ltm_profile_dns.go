@@ -0,0 +1,200 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriDns = "dns"
+
+type dnsProfile struct {
+	Name                 string `json:"name,omitempty"`
+	DefaultsFrom         string `json:"defaultsFrom,omitempty"`
+	EnableDnsExpress     string `json:"enableDnsExpress,omitempty"`
+	EnableCache          string `json:"enableCache,omitempty"`
+	Cache                string `json:"cache,omitempty"`
+	EnableDnssec         string `json:"enableDnssec,omitempty"`
+	EnableGtm            string `json:"enableGtm,omitempty"`
+	ProcessRd            string `json:"processRd,omitempty"`
+	UseLocalBind         string `json:"useLocalBind,omitempty"`
+	UnhandledQueryAction string `json:"unhandledQueryAction,omitempty"`
+}
+
+func resourceBigipLtmProfileDns() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileDnsCreate,
+		Update: resourceBigipLtmProfileDnsUpdate,
+		Read:   resourceBigipLtmProfileDnsRead,
+		Delete: resourceBigipLtmProfileDnsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the DNS Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/dns",
+				Description: "Use the parent DNS profile",
+			},
+			"enable_dns_express": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable answering queries from DNS Express zones",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"enable_cache": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable answering queries from the DNS cache",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"cache": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS cache used when enable_cache is yes",
+			},
+			"enable_dnssec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable signing responses with DNSSEC keys",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"enable_gtm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable answering queries for wide IPs",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"process_rd": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable processing the recursion desired flag of queries",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"use_local_bind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable forwarding unanswered queries to the local BIND server",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+			"unhandled_query_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "What to do with queries that are not answered: allow, drop, hint, no-error or reject",
+				ValidateFunc: validateStringValue([]string{"allow", "drop", "hint", "no-error", "reject"}),
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileDnsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating DNS profile " + name)
+
+	p := getDnsProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriDns)
+	if err != nil {
+		return fmt.Errorf("Error creating profile DNS (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileDnsRead)
+}
+
+func resourceBigipLtmProfileDnsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getDnsProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriDns, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile DNS (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileDnsRead(d, meta)
+}
+
+func resourceBigipLtmProfileDnsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p dnsProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriDns, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve DNS Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] DNS Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("enable_dns_express", p.EnableDnsExpress)
+	d.Set("enable_cache", p.EnableCache)
+	d.Set("cache", p.Cache)
+	d.Set("enable_dnssec", p.EnableDnssec)
+	d.Set("enable_gtm", p.EnableGtm)
+	d.Set("process_rd", p.ProcessRd)
+	d.Set("use_local_bind", p.UseLocalBind)
+	d.Set("unhandled_query_action", p.UnhandledQueryAction)
+
+	return nil
+}
+
+func resourceBigipLtmProfileDnsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting DNS Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriDns, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DNS Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getDnsProfileConfig(d *schema.ResourceData) *dnsProfile {
+	return &dnsProfile{
+		DefaultsFrom:         d.Get("defaults_from").(string),
+		EnableDnsExpress:     d.Get("enable_dns_express").(string),
+		EnableCache:          d.Get("enable_cache").(string),
+		Cache:                d.Get("cache").(string),
+		EnableDnssec:         d.Get("enable_dnssec").(string),
+		EnableGtm:            d.Get("enable_gtm").(string),
+		ProcessRd:            d.Get("process_rd").(string),
+		UseLocalBind:         d.Get("use_local_bind").(string),
+		UnhandledQueryAction: d.Get("unhandled_query_action").(string),
+	}
+}
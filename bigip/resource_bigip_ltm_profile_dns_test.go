@@ -0,0 +1,147 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DNS_PROFILE_NAME = fmt.Sprintf("/%s/test-dns", TEST_PARTITION)
+
+var TEST_DNS_PROFILE_RESOURCE = `
+resource "bigip_ltm_profile_dns" "test-dns" {
+	name = "` + TEST_DNS_PROFILE_NAME + `"
+	defaults_from = "/Common/dns"
+	enable_dns_express = "no"
+	enable_dnssec = "yes"
+	enable_gtm = "no"
+	use_local_bind = "no"
+	unhandled_query_action = "reject"
+}
+`
+
+var TEST_DNS_LISTENER_NAME = fmt.Sprintf("/%s/test-dns-listener", TEST_PARTITION)
+
+var TEST_DNS_LISTENER_RESOURCE = TEST_DNS_PROFILE_RESOURCE + `
+resource "bigip_ltm_virtual_server" "test-dns-listener" {
+	name = "` + TEST_DNS_LISTENER_NAME + `"
+	destination = "10.255.255.253"
+	port = 53
+	ip_protocol = "udp"
+	profiles = ["/Common/udp_gtm_dns", "${bigip_ltm_profile_dns.test-dns.name}"]
+}
+`
+
+func TestAccBigipLtmProfileDns_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileDnsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileDnsExists(TEST_DNS_PROFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "name", TEST_DNS_PROFILE_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "defaults_from", "/Common/dns"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "enable_dns_express", "no"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "enable_dnssec", "yes"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "enable_gtm", "no"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "use_local_bind", "no"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_dns.test-dns", "unhandled_query_action", "reject"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileDns_listener(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileDnsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_LISTENER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileDnsExists(TEST_DNS_PROFILE_NAME, true),
+					testCheckVSExists(TEST_DNS_LISTENER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-dns-listener", "ip_protocol", "udp"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-dns-listener", "profiles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileDns_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileDnsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileDnsExists(TEST_DNS_PROFILE_NAME, true),
+				),
+				ResourceName:      TEST_DNS_PROFILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileDnsExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p dnsProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriDns, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("DNS profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("DNS profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileDnsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_dns" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p dnsProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriDns, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("DNS profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -135,7 +135,7 @@ func resourceBigipLtmProfileFasthttpCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipLtmProfileFasthttpRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileFasthttpRead)
 }
 
 func resourceBigipLtmProfileFasthttpUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -171,12 +171,14 @@ func resourceBigipLtmProfileFasthttpUpdate(d *schema.ResourceData, meta interfac
 func resourceBigipLtmProfileFasthttpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	obj, err := client.GetFasthttp(name)
+	// client.GetFasthttp reads the collection of the profiles, not the profile named
+	obj := &bigip.Fasthttp{}
+	ok, err := getForEntity(client, obj, uriLtm, uriProfile, "fasthttp", name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Fasthttp   (%s) (%v) ", name, err)
 		return err
 	}
-	if obj == nil {
+	if !ok {
 		log.Printf("[WARN] Fasthttp profile  (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -0,0 +1,55 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipLtmProfileFasthttpPartition(t *testing.T) {
+	objects := map[string]map[string]interface{}{
+		// Another profile, read instead of the one of the resource when the collection is read
+		"/mgmt/tm/ltm/profile/fasthttp/~Common~fasthttp": {"name": "fasthttp", "idleTimeout": 300},
+	}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/tm/ltm/profile/fasthttp", objectsHandler(objects))
+	mux.Handle("/mgmt/tm/ltm/profile/fasthttp/", objectsHandler(objects))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_ltm_profile_fasthttp" "tenant" {
+						name = "/Tenant/fasthttp"
+						defaults_from = "/Common/fasthttp"
+						idle_timeout = 600
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_fasthttp.tenant", "id", "/Tenant/fasthttp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_fasthttp.tenant", "idle_timeout", "600"),
+				),
+			},
+		},
+	})
+	assert.NotContains(t, objects, "/mgmt/tm/ltm/profile/fasthttp/~Tenant~fasthttp")
+}
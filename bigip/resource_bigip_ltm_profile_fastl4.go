@@ -121,7 +121,7 @@ func resourceBigipProfileLtmFastl4Create(d *schema.ResourceData, meta interface{
 	}
 
 	d.SetId(name)
-	return resourceBigipLtmProfileFastl4Read(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileFastl4Read)
 }
 
 func resourceBigipLtmProfileFastl4Update(d *schema.ResourceData, meta interface{}) error {
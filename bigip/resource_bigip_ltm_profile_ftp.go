@@ -0,0 +1,214 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriFtp = "ftp"
+
+type ftpProfile struct {
+	Name                 string `json:"name,omitempty"`
+	DefaultsFrom         string `json:"defaultsFrom,omitempty"`
+	TranslateExtended    string `json:"translateExtended,omitempty"`
+	Port                 int    `json:"port,omitempty"`
+	Security             string `json:"security,omitempty"`
+	AllowFtps            string `json:"allowFtps,omitempty"`
+	InheritParentProfile string `json:"inheritParentProfile,omitempty"`
+	InheritVlanList      string `json:"inheritVlanList,omitempty"`
+	AllowActiveMode      string `json:"allowActiveMode,omitempty"`
+	FtpsMode             string `json:"ftpsMode,omitempty"`
+	EnforceTlsReuse      string `json:"enforceTlsSessionReuse,omitempty"`
+}
+
+func resourceBigipLtmProfileFtp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileFtpCreate,
+		Update: resourceBigipLtmProfileFtpUpdate,
+		Read:   resourceBigipLtmProfileFtpRead,
+		Delete: resourceBigipLtmProfileFtpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the FTP Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/ftp",
+				Description: "Use the parent FTP profile",
+			},
+			"translate_extended": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable translating EPSV and EPRT commands for IPv4 clients and IPv6 servers",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Data channel port the server uses for active mode transfers",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"security": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable FTP security through the ASM module",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"allow_ftps": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "To enable _ disable passing FTPS (AUTH TLS) sessions through",
+				ValidateFunc:  validateEnabledDisabled,
+				ConflictsWith: []string{"ftps_mode"},
+			},
+			"ftps_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether FTPS (AUTH TLS) sessions are disallowed, allowed or required, it replaces allow_ftps from BIG-IP 14",
+				ValidateFunc: validateStringValue([]string{"disallow", "allow", "require"}),
+			},
+			"enforce_tls_session_reuse": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable requiring the data channel to reuse the TLS session of the control channel",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"allow_active_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable active mode transfers, where the server opens the data channel to the client",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"inherit_parent_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable inheriting the data channel TCP profile from the control channel",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"inherit_vlan_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable accepting the data channel on the VLANs of the virtual server of the control channel",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileFtpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating FTP profile " + name)
+
+	p := getFtpProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriFtp)
+	if err != nil {
+		return fmt.Errorf("Error creating profile FTP (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileFtpRead)
+}
+
+func resourceBigipLtmProfileFtpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getFtpProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriFtp, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile FTP (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileFtpRead(d, meta)
+}
+
+func resourceBigipLtmProfileFtpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p ftpProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriFtp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve FTP Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] FTP Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("translate_extended", p.TranslateExtended)
+	d.Set("port", p.Port)
+	d.Set("security", p.Security)
+	d.Set("allow_ftps", p.AllowFtps)
+	d.Set("inherit_parent_profile", p.InheritParentProfile)
+	d.Set("inherit_vlan_list", p.InheritVlanList)
+	d.Set("allow_active_mode", p.AllowActiveMode)
+	d.Set("ftps_mode", p.FtpsMode)
+	d.Set("enforce_tls_session_reuse", p.EnforceTlsReuse)
+
+	return nil
+}
+
+func resourceBigipLtmProfileFtpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting FTP Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriFtp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete FTP Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getFtpProfileConfig(d *schema.ResourceData) *ftpProfile {
+	return &ftpProfile{
+		DefaultsFrom:         d.Get("defaults_from").(string),
+		TranslateExtended:    d.Get("translate_extended").(string),
+		Port:                 d.Get("port").(int),
+		Security:             d.Get("security").(string),
+		AllowFtps:            d.Get("allow_ftps").(string),
+		InheritParentProfile: d.Get("inherit_parent_profile").(string),
+		InheritVlanList:      d.Get("inherit_vlan_list").(string),
+		AllowActiveMode:      d.Get("allow_active_mode").(string),
+		FtpsMode:             d.Get("ftps_mode").(string),
+		EnforceTlsReuse:      d.Get("enforce_tls_session_reuse").(string),
+	}
+}
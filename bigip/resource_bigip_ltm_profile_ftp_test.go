@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_FTP_NAME = fmt.Sprintf("/%s/test-ftp", TEST_PARTITION)
+
+var TEST_FTP_RESOURCE = `
+resource "bigip_ltm_profile_ftp" "test-ftp" {
+	name = "` + TEST_FTP_NAME + `"
+	defaults_from = "/Common/ftp"
+	translate_extended = "enabled"
+	port = 2020
+	allow_ftps = "disabled"
+	allow_active_mode = "enabled"
+	inherit_vlan_list = "enabled"
+}
+`
+
+func TestAccBigipLtmProfileFtp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileFtpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_FTP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileFtpExists(TEST_FTP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "name", TEST_FTP_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "defaults_from", "/Common/ftp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "translate_extended", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "port", "2020"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "allow_ftps", "disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "allow_active_mode", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_ftp.test-ftp", "inherit_vlan_list", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileFtp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileFtpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_FTP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileFtpExists(TEST_FTP_NAME, true),
+				),
+				ResourceName:      TEST_FTP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileFtpExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p ftpProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriFtp, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("FTP profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("FTP profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileFtpDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_ftp" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p ftpProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriFtp, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("FTP profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
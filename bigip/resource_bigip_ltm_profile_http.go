@@ -6,11 +6,26 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
+	"fmt"
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 )
 
+// The explicit proxy settings of an HTTP profile, which go-bigip doesn't support
+type httpExplicitProxy struct {
+	DnsResolver            string   `json:"dnsResolver,omitempty"`
+	TunnelName             string   `json:"tunnelName,omitempty"`
+	RouteDomain            string   `json:"routeDomain,omitempty"`
+	DefaultConnectHandling string   `json:"defaultConnectHandling,omitempty"`
+	HostNames              []string `json:"hostNames"`
+	Ipv6                   string   `json:"ipv6,omitempty"`
+}
+
+type httpExplicitProxySettings struct {
+	ExplicitProxy httpExplicitProxy `json:"explicitProxy"`
+}
+
 func resourceBigipLtmProfileHttp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipLtmProfileHttpCreate,
@@ -136,6 +151,56 @@ func resourceBigipLtmProfileHttp() *schema.Resource {
 				Description: "Specifies the type of HTTP proxy. ",
 			},
 
+			"explicit_proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings of an explicit proxy, proxy_type must be explicit",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_resolver": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the DNS resolver resolving the host names of the requests",
+							ValidateFunc: validateF5Name,
+						},
+						"tunnel_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "/Common/http-tunnel",
+							Description:  "Full path of the HTTP tunnel CONNECT requests are sent through",
+							ValidateFunc: validateF5Name,
+						},
+						"route_domain": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "/Common/0",
+							Description: "Full path of the route domain the requests are sent in",
+						},
+						"default_connect_handling": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deny",
+							Description:  "Whether CONNECT requests not handled by a virtual server on the tunnel are allowed or denied",
+							ValidateFunc: validateStringValue([]string{"allow", "deny"}),
+						},
+						"host_names": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Host names of the proxy itself, the requests to them are not proxied",
+						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "no",
+							Description:  "Whether host names are resolved to IPv6 addresses",
+							ValidateFunc: validateStringValue([]string{"yes", "no"}),
+						},
+					},
+				},
+			},
+
 			"redirect_rewrite": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -217,7 +282,7 @@ func resourceBigipLtmProfileHttpCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	return resourceBigipLtmProfileHttpRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileHttpRead)
 
 }
 
@@ -263,6 +328,26 @@ func resourceBigipLtmProfileHttpRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("via_request", pp.ViaRequest)
 	d.Set("via_response", pp.ViaResponse)
 	d.Set("xff_alternative_names", pp.XffAlternativeNames)
+
+	if _, ok := d.GetOk("explicit_proxy"); ok || pp.ProxyType == "explicit" {
+		var settings httpExplicitProxySettings
+		if _, err := getForEntity(client, &settings, uriLtm, uriProfile, "http", name); err != nil {
+			log.Printf("[ERROR] Unable to retrive the explicit proxy settings of HTTP Profile (%s) (%v)", name, err)
+			return err
+		}
+		p := settings.ExplicitProxy
+		explicitProxy := []interface{}{map[string]interface{}{
+			"dns_resolver":             p.DnsResolver,
+			"tunnel_name":              p.TunnelName,
+			"route_domain":             p.RouteDomain,
+			"default_connect_handling": p.DefaultConnectHandling,
+			"host_names":               p.HostNames,
+			"ipv6":                     p.Ipv6,
+		}}
+		if err := d.Set("explicit_proxy", explicitProxy); err != nil {
+			return fmt.Errorf("[DEBUG] Error saving ExplicitProxy to state for HTTP Profile (%s): %s", name, err)
+		}
+	}
 	return nil
 }
 
@@ -271,6 +356,11 @@ func resourceBigipLtmProfileHttpUpdate(d *schema.ResourceData, meta interface{})
 
 	name := d.Id()
 
+	explicitProxy := d.Get("explicit_proxy").([]interface{})
+	if len(explicitProxy) > 0 && d.Get("proxy_type").(string) != "explicit" {
+		return fmt.Errorf("explicit_proxy of HTTP Profile (%s) requires proxy_type explicit", name)
+	}
+
 	pp := &bigip.HttpProfile{
 		AppService:                d.Get("app_service").(string),
 		DefaultsFrom:              d.Get("defaults_from").(string),
@@ -304,6 +394,22 @@ func resourceBigipLtmProfileHttpUpdate(d *schema.ResourceData, meta interface{})
 		log.Printf("[ERROR] Unable to Modify HTTP Profile  (%s) (%v)", name, err)
 		return err
 	}
+	if len(explicitProxy) > 0 {
+		p := explicitProxy[0].(map[string]interface{})
+		settings := &httpExplicitProxySettings{
+			ExplicitProxy: httpExplicitProxy{
+				DnsResolver:            p["dns_resolver"].(string),
+				TunnelName:             p["tunnel_name"].(string),
+				RouteDomain:            p["route_domain"].(string),
+				DefaultConnectHandling: p["default_connect_handling"].(string),
+				HostNames:              listToStringSlice(p["host_names"].([]interface{})),
+				Ipv6:                   p["ipv6"].(string),
+			},
+		}
+		if err := patchEntity(client, settings, uriLtm, uriProfile, "http", name); err != nil {
+			return fmt.Errorf("Error modifying the explicit proxy settings of HTTP Profile (%s): %s", name, err)
+		}
+	}
 
 	return resourceBigipLtmProfileHttpRead(d, meta)
 }
@@ -92,7 +92,7 @@ func resourceBigipLtmProfileHttp2Create(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating profile Http2 (%s): %s", name, err)
 	}
 	d.SetId(name)
-	return resourceBigipLtmProfileHttp2Read(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileHttp2Read)
 }
 
 func resourceBigipLtmProfileHttp2Update(d *schema.ResourceData, meta interface{}) error {
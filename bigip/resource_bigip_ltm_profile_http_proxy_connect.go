@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriHttpProxyConnect = "http-proxy-connect"
+
+type httpProxyConnectProfile struct {
+	Name         string `json:"name,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Description  string `json:"description,omitempty"`
+	DefaultState string `json:"defaultState,omitempty"`
+}
+
+// bigip_ltm_profile_http_proxy_connect makes a virtual server send its requests through an upstream proxy,
+// opening a tunnel with an HTTP CONNECT request to the pool member
+func resourceBigipLtmProfileHttpProxyConnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileHttpProxyConnectCreate,
+		Update: resourceBigipLtmProfileHttpProxyConnectUpdate,
+		Read:   resourceBigipLtmProfileHttpProxyConnectRead,
+		Delete: resourceBigipLtmProfileHttpProxyConnectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the HTTP proxy connect profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/http-proxy-connect",
+				Description: "Use the parent HTTP proxy connect profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"default_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether the CONNECT request is sent, it can be disabled per connection with the HTTP::proxy iRule command",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileHttpProxyConnectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating HTTP proxy connect profile " + name)
+
+	p := getHttpProxyConnectProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriHttpProxyConnect)
+	if err != nil {
+		return fmt.Errorf("Error creating profile HTTP proxy connect (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileHttpProxyConnectRead)
+}
+
+func resourceBigipLtmProfileHttpProxyConnectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getHttpProxyConnectProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriHttpProxyConnect, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile HTTP proxy connect (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileHttpProxyConnectRead(d, meta)
+}
+
+func resourceBigipLtmProfileHttpProxyConnectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p httpProxyConnectProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriHttpProxyConnect, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve HTTP proxy connect Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] HTTP proxy connect Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("description", p.Description)
+	d.Set("default_state", p.DefaultState)
+
+	return nil
+}
+
+func resourceBigipLtmProfileHttpProxyConnectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting HTTP proxy connect Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriHttpProxyConnect, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete HTTP proxy connect Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getHttpProxyConnectProfileConfig(d *schema.ResourceData) *httpProxyConnectProfile {
+	return &httpProxyConnectProfile{
+		DefaultsFrom: d.Get("defaults_from").(string),
+		Description:  d.Get("description").(string),
+		DefaultState: d.Get("default_state").(string),
+	}
+}
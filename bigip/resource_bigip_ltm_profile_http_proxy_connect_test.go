@@ -0,0 +1,108 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_HTTP_PROXY_CONNECT_NAME = fmt.Sprintf("/%s/test-http-proxy-connect", TEST_PARTITION)
+
+var TEST_HTTP_PROXY_CONNECT_RESOURCE = `
+resource "bigip_ltm_profile_http_proxy_connect" "test-http-proxy-connect" {
+	name = "` + TEST_HTTP_PROXY_CONNECT_NAME + `"
+	defaults_from = "/Common/http-proxy-connect"
+	description = "upstream proxy"
+	default_state = "enabled"
+}
+`
+
+func TestAccBigipLtmProfileHttpProxyConnect_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileHttpProxyConnectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_HTTP_PROXY_CONNECT_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileHttpProxyConnectExists(TEST_HTTP_PROXY_CONNECT_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http_proxy_connect.test-http-proxy-connect", "name", TEST_HTTP_PROXY_CONNECT_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http_proxy_connect.test-http-proxy-connect", "defaults_from", "/Common/http-proxy-connect"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http_proxy_connect.test-http-proxy-connect", "description", "upstream proxy"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http_proxy_connect.test-http-proxy-connect", "default_state", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileHttpProxyConnect_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileHttpProxyConnectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_HTTP_PROXY_CONNECT_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileHttpProxyConnectExists(TEST_HTTP_PROXY_CONNECT_NAME, true),
+				),
+				ResourceName:      TEST_HTTP_PROXY_CONNECT_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileHttpProxyConnectExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p httpProxyConnectProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriHttpProxyConnect, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("HTTP proxy connect profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("HTTP proxy connect profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileHttpProxyConnectDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_http_proxy_connect" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p httpProxyConnectProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriHttpProxyConnect, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("HTTP proxy connect profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
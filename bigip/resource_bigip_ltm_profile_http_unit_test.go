@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmProfileHttpExplicitProxy(url, proxyType string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_profile_http" "test-explicit" {
+			name = "/Common/test-explicit"
+			defaults_from = "/Common/http-explicit"
+			proxy_type = "%s"
+			explicit_proxy {
+				dns_resolver = "/Common/test-resolver"
+				default_connect_handling = "allow"
+				host_names = ["proxy.example.com"]
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, proxyType, url)
+}
+
+func TestAccBigipLtmProfileHttpExplicitProxy(t *testing.T) {
+	var profile map[string]interface{}
+	var explicitProxy string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &profile)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-explicit", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		switch r.Method {
+		case "DELETE":
+			profile = nil
+			return
+		case "PUT":
+			json.Unmarshal(b, &profile)
+		case "PATCH":
+			var settings map[string]json.RawMessage
+			json.Unmarshal(b, &settings)
+			explicitProxy = string(settings["explicitProxy"])
+		}
+		if profile == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		read := map[string]interface{}{}
+		for k, v := range profile {
+			read[k] = v
+		}
+		read["explicitProxy"] = json.RawMessage(explicitProxy)
+		json.NewEncoder(w).Encode(read)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmProfileHttpExplicitProxy(server.URL, "explicit"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http.test-explicit", "explicit_proxy.0.dns_resolver", "/Common/test-resolver"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http.test-explicit", "explicit_proxy.0.tunnel_name", "/Common/http-tunnel"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_http.test-explicit", "explicit_proxy.0.host_names.0", "proxy.example.com"),
+				),
+			},
+		},
+	})
+	assert.JSONEq(t, `{"dnsResolver":"/Common/test-resolver","tunnelName":"/Common/http-tunnel","routeDomain":"/Common/0",
+		"defaultConnectHandling":"allow","hostNames":["proxy.example.com"],"ipv6":"no"}`, explicitProxy)
+}
+
+func TestAccBigipLtmProfileHttpExplicitProxyRequiresExplicitType(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http/~Common~test-explicit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipLtmProfileHttpExplicitProxy(server.URL, "reverse"),
+				ExpectError: regexp.MustCompile("requires proxy_type explicit"),
+			},
+		},
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceBigipLtmProfileHttpcompress() *schema.Resource {
@@ -42,20 +43,23 @@ func resourceBigipLtmProfileHttpcompress() *schema.Resource {
 				Set:         schema.HashString,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Description: "Servers Address",
+				Computed:    true,
+				Description: "Regular expressions matching the Request-URIs of the responses not to compress, inherited from the parent profile when not set",
 			},
 			"uri_include": {
 				Type:        schema.TypeSet,
 				Set:         schema.HashString,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Description: "Servers Address",
+				Computed:    true,
+				Description: "Regular expressions matching the Request-URIs of the responses to compress, inherited from the parent profile when not set",
 			},
 			"content_type_include": {
 				Type:        schema.TypeSet,
 				Set:         schema.HashString,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies a list of content types for compression of HTTP Content-Type responses. Use a string list to specify a list of content types you want to compress.",
 			},
 			"content_type_exclude": {
@@ -63,8 +67,16 @@ func resourceBigipLtmProfileHttpcompress() *schema.Resource {
 				Set:         schema.HashString,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies a list of content types for compression of HTTP Content-Type responses. Use a string list to specify a list of content types you want to exclude.",
 			},
+			"gzip_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the degree to which the system compresses the content, from 1 (fastest) to 9 (smallest)",
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
 		},
 	}
 }
@@ -73,28 +85,17 @@ func resourceBigipLtmProfileHttpcompressCreate(d *schema.ResourceData, meta inte
 	client := meta.(*bigip.BigIP)
 
 	name := d.Get("name").(string)
-	defaultsFrom := d.Get("defaults_from").(string)
-	uriExclude := setToStringSlice(d.Get("uri_exclude").(*schema.Set))
-	uriInclude := setToStringSlice(d.Get("uri_include").(*schema.Set))
-	contentTypeInclude := setToStringSlice(d.Get("content_type_include").(*schema.Set))
-	contentTypeExclude := setToStringSlice(d.Get("content_type_exclude").(*schema.Set))
 
 	log.Println("[INFO] Creating Httpcompress profile")
 
-	err := client.CreateHttpcompress(
-		name,
-		defaultsFrom,
-		uriExclude,
-		uriInclude,
-		contentTypeInclude,
-		contentTypeExclude,
-	)
-
+	r := getHttpcompressConfig(d)
+	r.Name = name
+	err := client.AddHttpCompressionProfile(r)
 	if err != nil {
 		return fmt.Errorf("Error retrieving profile Http compress (%s): %s", name, err)
 	}
 	d.SetId(name)
-	return resourceBigipLtmProfileHttpcompressRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileHttpcompressRead)
 }
 
 func resourceBigipLtmProfileHttpcompressUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -102,15 +103,9 @@ func resourceBigipLtmProfileHttpcompressUpdate(d *schema.ResourceData, meta inte
 
 	name := d.Id()
 
-	r := &bigip.Httpcompress{
-		Name:               name,
-		DefaultsFrom:       d.Get("defaults_from").(string),
-		UriExclude:         setToStringSlice(d.Get("uri_exclude").(*schema.Set)),
-		UriInclude:         setToStringSlice(d.Get("uri_include").(*schema.Set)),
-		ContentTypeInclude: setToStringSlice(d.Get("content_type_include").(*schema.Set)),
-	}
-
-	err := client.ModifyHttpcompress(name, r)
+	r := getHttpcompressConfig(d)
+	r.Name = name
+	err := client.ModifyHttpCompressionProfile(name, r)
 	if err != nil {
 		return fmt.Errorf("Error modifying  profile Http compress (%s): %s", name, err)
 	}
@@ -120,7 +115,7 @@ func resourceBigipLtmProfileHttpcompressUpdate(d *schema.ResourceData, meta inte
 func resourceBigipLtmProfileHttpcompressRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	obj, err := client.GetHttpcompress(name)
+	obj, err := client.GetHttpCompressionProfile(name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to retrieve Http Compress Profile (%s) (%v)", name, err)
 		return err
@@ -131,6 +126,7 @@ func resourceBigipLtmProfileHttpcompressRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 	d.Set("name", name)
+	d.Set("defaults_from", obj.DefaultsFrom)
 	if err := d.Set("uri_include", obj.UriInclude); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving UriInclude to state for Http Compress profile  (%s): %s", d.Id(), err)
 	}
@@ -143,6 +139,7 @@ func resourceBigipLtmProfileHttpcompressRead(d *schema.ResourceData, meta interf
 	if err := d.Set("content_type_exclude", obj.ContentTypeExclude); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving ContentTypeExclude to state for Http Compress profile  (%s): %s", d.Id(), err)
 	}
+	d.Set("gzip_level", obj.GzipLevel)
 
 	return nil
 }
@@ -153,7 +150,7 @@ func resourceBigipLtmProfileHttpcompressDelete(d *schema.ResourceData, meta inte
 	name := d.Id()
 	log.Println("[INFO] Deleting Httpcompress Profile " + name)
 
-	err := client.DeleteHttpcompress(name)
+	err := client.DeleteHttpCompressionProfile(name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Httpcompress  (%s) (%v) ", name, err)
 		return err
@@ -161,3 +158,16 @@ func resourceBigipLtmProfileHttpcompressDelete(d *schema.ResourceData, meta inte
 	d.SetId("")
 	return nil
 }
+
+// getHttpcompressConfig sends the lists sorted, the order of a set is the order of its hashes, so the same
+// lists are always sent the same way whatever order they are configured or returned in
+func getHttpcompressConfig(d *schema.ResourceData) *bigip.HttpCompressionProfile {
+	return &bigip.HttpCompressionProfile{
+		DefaultsFrom:       d.Get("defaults_from").(string),
+		UriExclude:         setToSortedStringSlice(d.Get("uri_exclude").(*schema.Set)),
+		UriInclude:         setToSortedStringSlice(d.Get("uri_include").(*schema.Set)),
+		ContentTypeInclude: setToSortedStringSlice(d.Get("content_type_include").(*schema.Set)),
+		ContentTypeExclude: setToSortedStringSlice(d.Get("content_type_exclude").(*schema.Set)),
+		GzipLevel:          d.Get("gzip_level").(int),
+	}
+}
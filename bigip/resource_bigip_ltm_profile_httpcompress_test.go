@@ -26,6 +26,7 @@ resource "bigip_ltm_profile_httpcompress" "test-httpcompress" {
             uri_include = ["cisco.com"]
 	    content_type_include = ["nicecontent.com"]
 	    content_type_exclude = ["nicecontentexclude.com"]
+	    gzip_level = 6
         }
 `
 
@@ -55,6 +56,7 @@ func TestAccBigipLtmProfileHttpcompress_create(t *testing.T) {
 					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-httpcompress",
 						fmt.Sprintf("content_type_exclude.%d", schema.HashString("nicecontentexclude.com")),
 						"nicecontentexclude.com"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-httpcompress", "gzip_level", "6"),
 				),
 			},
 		},
@@ -0,0 +1,111 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmProfileHttpcompressLists(url, uriExclude string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_profile_httpcompress" "test-lists" {
+			name = "/Common/test-lists"
+			defaults_from = "/Common/httpcompression"
+			uri_exclude = [%s]
+			content_type_exclude = ["video/", "image/", "audio/"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, uriExclude, url)
+}
+
+func TestAccBigipLtmProfileHttpcompressLists(t *testing.T) {
+	var profile map[string]interface{}
+	var sent []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	// The device returns the lists in its own order, and the content types to compress of the parent
+	store := func(b []byte) {
+		json.Unmarshal(b, &profile)
+		sent = append(sent, string(b))
+		for _, key := range []string{"uriExclude", "contentTypeExclude"} {
+			if list, ok := profile[key].([]interface{}); ok {
+				for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+					list[i], list[j] = list[j], list[i]
+				}
+			}
+		}
+		if _, ok := profile["contentTypeInclude"]; !ok {
+			profile["contentTypeInclude"] = []string{"text/", "application/(xml|x-javascript)"}
+		}
+	}
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http-compression", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		store(b)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/profile/http-compression/~Common~test-lists", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			profile = nil
+			return
+		case "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			store(b)
+		}
+		if profile == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(profile)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmProfileHttpcompressLists(server.URL, `"/static/.*", "/api/.*", "/download/.*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-lists", "uri_exclude.#", "3"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-lists", "content_type_exclude.#", "3"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-lists", "content_type_include.#", "2"),
+				),
+			},
+			{
+				Config: testBigipLtmProfileHttpcompressLists(server.URL, `"/static/.*", "/api/.*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_httpcompress.test-lists", "uri_exclude.#", "2"),
+				),
+			},
+		},
+	})
+	if assert.Len(t, sent, 2) {
+		var created, updated map[string]interface{}
+		json.Unmarshal([]byte(sent[0]), &created)
+		json.Unmarshal([]byte(sent[1]), &updated)
+		assert.Equal(t, []interface{}{"/api/.*", "/download/.*", "/static/.*"}, created["uriExclude"])
+		assert.Equal(t, []interface{}{"audio/", "image/", "video/"}, created["contentTypeExclude"])
+		assert.NotContains(t, created, "contentTypeInclude", "Unset lists are inherited from the parent")
+		assert.Equal(t, []interface{}{"/api/.*", "/static/.*"}, updated["uriExclude"])
+		assert.Equal(t, []interface{}{"application/(xml|x-javascript)", "text/"}, updated["contentTypeInclude"])
+	}
+}
@@ -114,7 +114,7 @@ func resourceBigipLtmProfileOneconnectCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error create profile oneConnect (%s): %s", name, err)
 	}
 	d.SetId(name)
-	return resourceBigipLtmProfileOneconnectRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileOneconnectRead)
 }
 
 func resourceBigipLtmProfileOneconnectUpdate(d *schema.ResourceData, meta interface{}) error {
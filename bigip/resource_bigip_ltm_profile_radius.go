@@ -0,0 +1,150 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriRadius = "radius"
+
+type radiusProfile struct {
+	Name                string `json:"name,omitempty"`
+	DefaultsFrom        string `json:"defaultsFrom,omitempty"`
+	PersistAvp          string `json:"persistAvp,omitempty"`
+	SubscriberDiscovery string `json:"subscriberDiscovery,omitempty"`
+	Clients             string `json:"clients,omitempty"`
+}
+
+func resourceBigipLtmProfileRadius() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileRadiusCreate,
+		Update: resourceBigipLtmProfileRadiusUpdate,
+		Read:   resourceBigipLtmProfileRadiusRead,
+		Delete: resourceBigipLtmProfileRadiusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the RADIUS Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/radiusLB",
+				Description: "Use the parent RADIUS profile",
+			},
+			"persist_avp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute value pair used to persist RADIUS messages, e.g. 31 for Calling-Station-Id",
+			},
+			"subscriber_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable discovering subscribers from RADIUS accounting messages",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"clients": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Data group of the RADIUS clients and their shared secrets",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileRadiusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating RADIUS profile " + name)
+
+	p := getRadiusProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriRadius)
+	if err != nil {
+		return fmt.Errorf("Error creating profile RADIUS (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileRadiusRead)
+}
+
+func resourceBigipLtmProfileRadiusUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getRadiusProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriRadius, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile RADIUS (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileRadiusRead(d, meta)
+}
+
+func resourceBigipLtmProfileRadiusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p radiusProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRadius, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve RADIUS Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] RADIUS Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("persist_avp", p.PersistAvp)
+	d.Set("subscriber_discovery", p.SubscriberDiscovery)
+	d.Set("clients", p.Clients)
+
+	return nil
+}
+
+func resourceBigipLtmProfileRadiusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting RADIUS Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriRadius, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getRadiusProfileConfig(d *schema.ResourceData) *radiusProfile {
+	return &radiusProfile{
+		DefaultsFrom:        d.Get("defaults_from").(string),
+		PersistAvp:          d.Get("persist_avp").(string),
+		SubscriberDiscovery: d.Get("subscriber_discovery").(string),
+		Clients:             d.Get("clients").(string),
+	}
+}
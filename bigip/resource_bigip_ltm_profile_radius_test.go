@@ -0,0 +1,108 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_RADIUS_NAME = fmt.Sprintf("/%s/test-radius", TEST_PARTITION)
+
+var TEST_RADIUS_RESOURCE = `
+resource "bigip_ltm_profile_radius" "test-radius" {
+	name = "` + TEST_RADIUS_NAME + `"
+	defaults_from = "/Common/radiusLB"
+	persist_avp = "31"
+	subscriber_discovery = "disabled"
+}
+`
+
+func TestAccBigipLtmProfileRadius_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRadiusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RADIUS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRadiusExists(TEST_RADIUS_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_radius.test-radius", "name", TEST_RADIUS_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_radius.test-radius", "defaults_from", "/Common/radiusLB"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_radius.test-radius", "persist_avp", "31"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_radius.test-radius", "subscriber_discovery", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileRadius_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRadiusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RADIUS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRadiusExists(TEST_RADIUS_NAME, true),
+				),
+				ResourceName:      TEST_RADIUS_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileRadiusExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p radiusProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRadius, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("RADIUS profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("RADIUS profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileRadiusDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_radius" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p radiusProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRadius, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("RADIUS profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
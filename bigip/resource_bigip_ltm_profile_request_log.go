@@ -0,0 +1,204 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriRequestLog = "request-log"
+
+type requestLogProfile struct {
+	Name                 string `json:"name,omitempty"`
+	DefaultsFrom         string `json:"defaultsFrom,omitempty"`
+	RequestLogging       string `json:"requestLogging,omitempty"`
+	RequestLogTemplate   string `json:"requestLogTemplate,omitempty"`
+	RequestLogPool       string `json:"requestLogPool,omitempty"`
+	RequestLogProtocol   string `json:"requestLogProtocol,omitempty"`
+	ResponseLogging      string `json:"responseLogging,omitempty"`
+	ResponseLogTemplate  string `json:"responseLogTemplate,omitempty"`
+	ResponseLogPool      string `json:"responseLogPool,omitempty"`
+	ResponseLogProtocol  string `json:"responseLogProtocol,omitempty"`
+	LogResponseByDefault string `json:"logResponseByDefault,omitempty"`
+}
+
+func resourceBigipLtmProfileRequestLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileRequestLogCreate,
+		Update: resourceBigipLtmProfileRequestLogUpdate,
+		Read:   resourceBigipLtmProfileRequestLogRead,
+		Delete: resourceBigipLtmProfileRequestLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Request Logging Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/request-log",
+				Description: "Use the parent Request Logging profile",
+			},
+			"request_logging": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable logging of requests",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"request_log_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template of the request log line, e.g. $CLIENT_IP $HTTP_METHOD $HTTP_URI",
+			},
+			"request_log_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Pool of high-speed logging servers requests are logged to",
+			},
+			"request_log_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Protocol used to send request logs to the pool: mds-udp or mds-tcp",
+				ValidateFunc: validateStringValue([]string{"mds-udp", "mds-tcp"}),
+			},
+			"response_logging": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable logging of responses",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"response_log_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template of the response log line, e.g. $CLIENT_IP $HTTP_STATUS",
+			},
+			"response_log_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Pool of high-speed logging servers responses are logged to",
+			},
+			"response_log_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Protocol used to send response logs to the pool: mds-udp or mds-tcp",
+				ValidateFunc: validateStringValue([]string{"mds-udp", "mds-tcp"}),
+			},
+			"log_response_by_default": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable logging every response, not only those of logged requests",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileRequestLogCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating Request Logging profile " + name)
+
+	p := getRequestLogProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriRequestLog)
+	if err != nil {
+		return fmt.Errorf("Error creating profile Request Logging (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileRequestLogRead)
+}
+
+func resourceBigipLtmProfileRequestLogUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getRequestLogProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriRequestLog, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile Request Logging (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileRequestLogRead(d, meta)
+}
+
+func resourceBigipLtmProfileRequestLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p requestLogProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRequestLog, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Request Logging Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Request Logging Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("request_logging", p.RequestLogging)
+	d.Set("request_log_template", p.RequestLogTemplate)
+	d.Set("request_log_pool", p.RequestLogPool)
+	d.Set("request_log_protocol", p.RequestLogProtocol)
+	d.Set("response_logging", p.ResponseLogging)
+	d.Set("response_log_template", p.ResponseLogTemplate)
+	d.Set("response_log_pool", p.ResponseLogPool)
+	d.Set("response_log_protocol", p.ResponseLogProtocol)
+	d.Set("log_response_by_default", p.LogResponseByDefault)
+
+	return nil
+}
+
+func resourceBigipLtmProfileRequestLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Request Logging Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriRequestLog, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Request Logging Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getRequestLogProfileConfig(d *schema.ResourceData) *requestLogProfile {
+	return &requestLogProfile{
+		DefaultsFrom:         d.Get("defaults_from").(string),
+		RequestLogging:       d.Get("request_logging").(string),
+		RequestLogTemplate:   d.Get("request_log_template").(string),
+		RequestLogPool:       d.Get("request_log_pool").(string),
+		RequestLogProtocol:   d.Get("request_log_protocol").(string),
+		ResponseLogging:      d.Get("response_logging").(string),
+		ResponseLogTemplate:  d.Get("response_log_template").(string),
+		ResponseLogPool:      d.Get("response_log_pool").(string),
+		ResponseLogProtocol:  d.Get("response_log_protocol").(string),
+		LogResponseByDefault: d.Get("log_response_by_default").(string),
+	}
+}
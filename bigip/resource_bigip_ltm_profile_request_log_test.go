@@ -0,0 +1,112 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_REQUESTLOG_NAME = fmt.Sprintf("/%s/test-request-log", TEST_PARTITION)
+
+var TEST_REQUESTLOG_RESOURCE = `
+resource "bigip_ltm_profile_request_log" "test-request-log" {
+	name = "` + TEST_REQUESTLOG_NAME + `"
+	defaults_from = "/Common/request-log"
+	request_logging = "enabled"
+	request_log_template = "$CLIENT_IP $HTTP_METHOD $HTTP_URI"
+	request_log_protocol = "mds-tcp"
+	response_logging = "disabled"
+}
+`
+
+func TestAccBigipLtmProfileRequestLog_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRequestLogDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_REQUESTLOG_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRequestLogExists(TEST_REQUESTLOG_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "name", TEST_REQUESTLOG_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "defaults_from", "/Common/request-log"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "request_logging", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "request_log_template", "$CLIENT_IP $HTTP_METHOD $HTTP_URI"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "request_log_protocol", "mds-tcp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_log.test-request-log", "response_logging", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileRequestLog_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRequestLogDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_REQUESTLOG_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRequestLogExists(TEST_REQUESTLOG_NAME, true),
+				),
+				ResourceName:      TEST_REQUESTLOG_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileRequestLogExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p requestLogProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRequestLog, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("request logging profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("request logging profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileRequestLogDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_request_log" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p requestLogProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRequestLog, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("request logging profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
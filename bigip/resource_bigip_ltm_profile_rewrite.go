@@ -0,0 +1,322 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriRewrite = "rewrite"
+
+type rewriteProfile struct {
+	Name           string                 `json:"name,omitempty"`
+	DefaultsFrom   string                 `json:"defaultsFrom,omitempty"`
+	RewriteMode    string                 `json:"rewriteMode,omitempty"`
+	BypassList     []string               `json:"bypassList,omitempty"`
+	RewriteList    []string               `json:"rewriteList,omitempty"`
+	UriRules       []rewriteUriRule       `json:"uriRules"`
+	SetCookieRules []rewriteSetCookieRule `json:"setCookieRules"`
+}
+
+type rewriteUriRule struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type,omitempty"`
+	Client rewriteUriPart `json:"client"`
+	Server rewriteUriPart `json:"server"`
+}
+
+type rewriteUriPart struct {
+	Scheme string `json:"scheme,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Port   string `json:"port,omitempty"`
+}
+
+type rewriteSetCookieRule struct {
+	Name   string            `json:"name"`
+	Client rewriteCookiePart `json:"client"`
+	Server rewriteCookiePart `json:"server"`
+}
+
+type rewriteCookiePart struct {
+	Domain string `json:"domain,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+func resourceBigipLtmProfileRewrite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileRewriteCreate,
+		Update: resourceBigipLtmProfileRewriteUpdate,
+		Read:   resourceBigipLtmProfileRewriteRead,
+		Delete: resourceBigipLtmProfileRewriteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Rewrite Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/rewrite",
+				Description: "Use the parent Rewrite profile",
+			},
+			"rewrite_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "uri-translation",
+				ForceNew:     true,
+				Description:  "Rewrite mode of the profile: uri-translation or portal",
+				ValidateFunc: validateStringValue([]string{"uri-translation", "portal"}),
+			},
+			"bypass_list": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "URIs that are not rewritten in portal mode",
+			},
+			"rewrite_list": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "URIs that are rewritten in portal mode",
+			},
+			"uri_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "URI translation rules, evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "both",
+							Description:  "Whether the rule applies to the request, the response or both",
+							ValidateFunc: validateStringValue([]string{"request", "response", "both"}),
+						},
+						"client_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"client_host": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"client_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "none",
+						},
+						"server_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_host": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"server_port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "none",
+						},
+					},
+				},
+			},
+			"set_cookie_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Set-Cookie translation rules, evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"server_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileRewriteCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating Rewrite profile " + name)
+
+	p := getRewriteProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriRewrite)
+	if err != nil {
+		return fmt.Errorf("Error creating profile Rewrite (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileRewriteRead)
+}
+
+func resourceBigipLtmProfileRewriteUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getRewriteProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriRewrite, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile Rewrite (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileRewriteRead(d, meta)
+}
+
+func resourceBigipLtmProfileRewriteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p rewriteProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRewrite, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Rewrite Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Rewrite Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("rewrite_mode", p.RewriteMode)
+	d.Set("bypass_list", p.BypassList)
+	d.Set("rewrite_list", p.RewriteList)
+
+	uriRules := make([]interface{}, len(p.UriRules))
+	for i, r := range p.UriRules {
+		uriRules[i] = map[string]interface{}{
+			"type":          r.Type,
+			"client_scheme": r.Client.Scheme,
+			"client_host":   r.Client.Host,
+			"client_path":   r.Client.Path,
+			"client_port":   r.Client.Port,
+			"server_scheme": r.Server.Scheme,
+			"server_host":   r.Server.Host,
+			"server_path":   r.Server.Path,
+			"server_port":   r.Server.Port,
+		}
+	}
+	if err := d.Set("uri_rule", uriRules); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving UriRules to state for Rewrite profile (%s): %s", name, err)
+	}
+
+	cookieRules := make([]interface{}, len(p.SetCookieRules))
+	for i, r := range p.SetCookieRules {
+		cookieRules[i] = map[string]interface{}{
+			"client_domain": r.Client.Domain,
+			"client_path":   r.Client.Path,
+			"server_domain": r.Server.Domain,
+			"server_path":   r.Server.Path,
+		}
+	}
+	if err := d.Set("set_cookie_rule", cookieRules); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving SetCookieRules to state for Rewrite profile (%s): %s", name, err)
+	}
+
+	return nil
+}
+
+func resourceBigipLtmProfileRewriteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Rewrite Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriRewrite, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Rewrite Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getRewriteProfileConfig(d *schema.ResourceData) *rewriteProfile {
+	p := &rewriteProfile{
+		DefaultsFrom:   d.Get("defaults_from").(string),
+		RewriteMode:    d.Get("rewrite_mode").(string),
+		BypassList:     listToStringSlice(d.Get("bypass_list").([]interface{})),
+		RewriteList:    listToStringSlice(d.Get("rewrite_list").([]interface{})),
+		UriRules:       []rewriteUriRule{},
+		SetCookieRules: []rewriteSetCookieRule{},
+	}
+
+	// Rules are subcollection items and need a name, generate one from their position
+	for i, r := range d.Get("uri_rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		p.UriRules = append(p.UriRules, rewriteUriRule{
+			Name: fmt.Sprintf("uri-rule-%d", i),
+			Type: rule["type"].(string),
+			Client: rewriteUriPart{
+				Scheme: rule["client_scheme"].(string),
+				Host:   rule["client_host"].(string),
+				Path:   rule["client_path"].(string),
+				Port:   rule["client_port"].(string),
+			},
+			Server: rewriteUriPart{
+				Scheme: rule["server_scheme"].(string),
+				Host:   rule["server_host"].(string),
+				Path:   rule["server_path"].(string),
+				Port:   rule["server_port"].(string),
+			},
+		})
+	}
+	for i, r := range d.Get("set_cookie_rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		p.SetCookieRules = append(p.SetCookieRules, rewriteSetCookieRule{
+			Name: fmt.Sprintf("set-cookie-rule-%d", i),
+			Client: rewriteCookiePart{
+				Domain: rule["client_domain"].(string),
+				Path:   rule["client_path"].(string),
+			},
+			Server: rewriteCookiePart{
+				Domain: rule["server_domain"].(string),
+				Path:   rule["server_path"].(string),
+			},
+		})
+	}
+	return p
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_REWRITE_NAME = fmt.Sprintf("/%s/test-rewrite", TEST_PARTITION)
+
+var TEST_REWRITE_RESOURCE = `
+resource "bigip_ltm_profile_rewrite" "test-rewrite" {
+	name = "` + TEST_REWRITE_NAME + `"
+	defaults_from = "/Common/rewrite"
+	rewrite_mode = "uri-translation"
+	uri_rule {
+		type = "both"
+		client_scheme = "https"
+		client_host = "www.example.com"
+		client_path = "/"
+		server_scheme = "http"
+		server_host = "app.internal"
+		server_path = "/app/"
+	}
+	set_cookie_rule {
+		client_domain = "www.example.com"
+		client_path = "/"
+		server_domain = "app.internal"
+		server_path = "/app/"
+	}
+}
+`
+
+func TestAccBigipLtmProfileRewrite_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRewriteDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_REWRITE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRewriteExists(TEST_REWRITE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "name", TEST_REWRITE_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "defaults_from", "/Common/rewrite"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "rewrite_mode", "uri-translation"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "uri_rule.#", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "uri_rule.0.client_host", "www.example.com"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "uri_rule.0.server_path", "/app/"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "set_cookie_rule.#", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_rewrite.test-rewrite", "set_cookie_rule.0.server_domain", "app.internal"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileRewrite_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileRewriteDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_REWRITE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileRewriteExists(TEST_REWRITE_NAME, true),
+				),
+				ResourceName:      TEST_REWRITE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileRewriteExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p rewriteProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRewrite, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("rewrite profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("rewrite profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileRewriteDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_rewrite" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p rewriteProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriRewrite, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("rewrite profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriSip = "sip"
+
+type sipProfile struct {
+	Name                    string `json:"name,omitempty"`
+	DefaultsFrom            string `json:"defaultsFrom,omitempty"`
+	MaxSize                 int    `json:"maxSize,omitempty"`
+	MaxMediaSessions        int    `json:"maxMediaSessions,omitempty"`
+	TerminateOnBye          string `json:"terminateOnBye,omitempty"`
+	InsertViaHeader         string `json:"insertViaHeader,omitempty"`
+	InsertRecordRouteHeader string `json:"insertRecordRouteHeader,omitempty"`
+	SecureViaHeader         string `json:"secureViaHeader,omitempty"`
+	DialogAware             string `json:"dialogAware,omitempty"`
+	Community               string `json:"community,omitempty"`
+}
+
+func resourceBigipLtmProfileSip() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileSipCreate,
+		Update: resourceBigipLtmProfileSipUpdate,
+		Read:   resourceBigipLtmProfileSipRead,
+		Delete: resourceBigipLtmProfileSipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the SIP Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/sip",
+				Description: "Use the parent SIP profile",
+			},
+			"max_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Largest SIP message in bytes the profile accepts",
+			},
+			"max_media_sessions": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of media sessions of a SIP dialog",
+			},
+			"terminate_on_bye": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable closing the connection when a BYE transaction completes",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"insert_via_header": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable inserting a Via header into SIP requests",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"insert_record_route_header": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable inserting a Record-Route header into SIP requests",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"secure_via_header": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable inserting a secure Via header into SIP requests",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"dialog_aware": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable tracking SIP dialogs",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"community": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Community of the SIP dialogs, used to share them between virtual servers",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileSipCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating SIP profile " + name)
+
+	p := getSipProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriSip)
+	if err != nil {
+		return fmt.Errorf("Error creating profile SIP (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileSipRead)
+}
+
+func resourceBigipLtmProfileSipUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getSipProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriSip, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile SIP (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileSipRead(d, meta)
+}
+
+func resourceBigipLtmProfileSipRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p sipProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriSip, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve SIP Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] SIP Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("max_size", p.MaxSize)
+	d.Set("max_media_sessions", p.MaxMediaSessions)
+	d.Set("terminate_on_bye", p.TerminateOnBye)
+	d.Set("insert_via_header", p.InsertViaHeader)
+	d.Set("insert_record_route_header", p.InsertRecordRouteHeader)
+	d.Set("secure_via_header", p.SecureViaHeader)
+	d.Set("dialog_aware", p.DialogAware)
+	d.Set("community", p.Community)
+
+	return nil
+}
+
+func resourceBigipLtmProfileSipDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SIP Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriSip, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SIP Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSipProfileConfig(d *schema.ResourceData) *sipProfile {
+	return &sipProfile{
+		DefaultsFrom:            d.Get("defaults_from").(string),
+		MaxSize:                 d.Get("max_size").(int),
+		MaxMediaSessions:        d.Get("max_media_sessions").(int),
+		TerminateOnBye:          d.Get("terminate_on_bye").(string),
+		InsertViaHeader:         d.Get("insert_via_header").(string),
+		InsertRecordRouteHeader: d.Get("insert_record_route_header").(string),
+		SecureViaHeader:         d.Get("secure_via_header").(string),
+		DialogAware:             d.Get("dialog_aware").(string),
+		Community:               d.Get("community").(string),
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SIP_NAME = fmt.Sprintf("/%s/test-sip", TEST_PARTITION)
+
+var TEST_SIP_RESOURCE = `
+resource "bigip_ltm_profile_sip" "test-sip" {
+	name = "` + TEST_SIP_NAME + `"
+	defaults_from = "/Common/sip"
+	max_size = 65535
+	terminate_on_bye = "enabled"
+	insert_via_header = "enabled"
+	dialog_aware = "enabled"
+}
+`
+
+func TestAccBigipLtmProfileSip_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileSipDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileSipExists(TEST_SIP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "name", TEST_SIP_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "defaults_from", "/Common/sip"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "max_size", "65535"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "terminate_on_bye", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "insert_via_header", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_sip.test-sip", "dialog_aware", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileSip_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileSipDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileSipExists(TEST_SIP_NAME, true),
+				),
+				ResourceName:      TEST_SIP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileSipExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p sipProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriSip, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("SIP profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("SIP profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileSipDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_sip" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p sipProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriSip, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("SIP profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriSocks = "socks"
+
+type socksProfile struct {
+	Name                   string   `json:"name,omitempty"`
+	DefaultsFrom           string   `json:"defaultsFrom,omitempty"`
+	ProtocolVersions       []string `json:"protocolVersions,omitempty"`
+	DnsResolver            string   `json:"dnsResolver,omitempty"`
+	RouteDomain            string   `json:"routeDomain,omitempty"`
+	TunnelName             string   `json:"tunnelName,omitempty"`
+	DefaultConnectHandling string   `json:"defaultConnectHandling,omitempty"`
+	Ipv6                   string   `json:"ipv6,omitempty"`
+}
+
+// bigip_ltm_profile_socks makes a virtual server a SOCKS proxy, the connections the clients request are
+// opened through tunnel_name to the addresses resolved with dns_resolver
+func resourceBigipLtmProfileSocks() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileSocksCreate,
+		Update: resourceBigipLtmProfileSocksUpdate,
+		Read:   resourceBigipLtmProfileSocksRead,
+		Delete: resourceBigipLtmProfileSocksDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the SOCKS Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/socks",
+				Description: "Use the parent SOCKS profile",
+			},
+			"protocol_versions": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateStringValue([]string{"socks4", "socks4a", "socks5"})},
+				Optional:    true,
+				Computed:    true,
+				Description: "SOCKS versions accepted from the clients, socks4, socks4a or socks5",
+			},
+			"dns_resolver": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the DNS resolver resolving the host names the clients connect to",
+				ValidateFunc: validateF5Name,
+			},
+			"route_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/0",
+				Description: "Full path of the route domain the connections are opened in",
+			},
+			"tunnel_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/Common/socks-tunnel",
+				Description:  "Full path of the tunnel the connections are opened through",
+				ValidateFunc: validateF5Name,
+			},
+			"default_connect_handling": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "deny",
+				Description:  "Whether connections not handled by a virtual server on the tunnel are allowed or denied",
+				ValidateFunc: validateStringValue([]string{"allow", "deny"}),
+			},
+			"ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "no",
+				Description:  "Whether host names are resolved to IPv6 addresses",
+				ValidateFunc: validateStringValue([]string{"yes", "no"}),
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileSocksCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating SOCKS profile " + name)
+
+	p := getSocksProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriSocks)
+	if err != nil {
+		return fmt.Errorf("Error creating profile SOCKS (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileSocksRead)
+}
+
+func resourceBigipLtmProfileSocksUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getSocksProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriSocks, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile SOCKS (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileSocksRead(d, meta)
+}
+
+func resourceBigipLtmProfileSocksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p socksProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriSocks, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve SOCKS Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] SOCKS Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	if err := d.Set("protocol_versions", p.ProtocolVersions); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ProtocolVersions to state for SOCKS profile (%s): %s", name, err)
+	}
+	d.Set("dns_resolver", p.DnsResolver)
+	d.Set("route_domain", p.RouteDomain)
+	d.Set("tunnel_name", p.TunnelName)
+	d.Set("default_connect_handling", p.DefaultConnectHandling)
+	d.Set("ipv6", p.Ipv6)
+
+	return nil
+}
+
+func resourceBigipLtmProfileSocksDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SOCKS Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriSocks, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SOCKS Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSocksProfileConfig(d *schema.ResourceData) *socksProfile {
+	return &socksProfile{
+		DefaultsFrom:           d.Get("defaults_from").(string),
+		ProtocolVersions:       setToSortedStringSlice(d.Get("protocol_versions").(*schema.Set)),
+		DnsResolver:            d.Get("dns_resolver").(string),
+		RouteDomain:            d.Get("route_domain").(string),
+		TunnelName:             d.Get("tunnel_name").(string),
+		DefaultConnectHandling: d.Get("default_connect_handling").(string),
+		Ipv6:                   d.Get("ipv6").(string),
+	}
+}
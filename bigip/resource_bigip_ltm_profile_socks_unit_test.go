@@ -0,0 +1,72 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmProfileSocks(url, handling string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_profile_socks" "proxy" {
+			name = "/Common/socks-proxy"
+			protocol_versions = ["socks5", "socks4a"]
+			dns_resolver = "/Common/resolver"
+			default_connect_handling = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, handling, url)
+}
+
+func TestAccBigipLtmProfileSocks(t *testing.T) {
+	const profilePath = "/mgmt/tm/ltm/profile/socks/~Common~socks-proxy"
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.NotContains(t, objects, profilePath)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmProfileSocks(server.URL, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_socks.proxy", "protocol_versions.#", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_socks.proxy", "tunnel_name", "/Common/socks-tunnel"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []interface{}{"socks4a", "socks5"}, objects[profilePath]["protocolVersions"])
+						assert.Equal(t, "/Common/resolver", objects[profilePath]["dnsResolver"])
+						return nil
+					},
+				),
+			},
+			{
+				Config: testBigipLtmProfileSocks(server.URL, "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_socks.proxy", "default_connect_handling", "allow"),
+				),
+			},
+		},
+	})
+}
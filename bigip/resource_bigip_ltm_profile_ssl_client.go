@@ -13,6 +13,23 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// clientSslC3d holds the client certificate constrained delegation (C3D) settings of a client SSL profile, which the
+// client doesn't know
+type clientSslC3d struct {
+	SslC3d                   string `json:"sslC3d,omitempty"`
+	C3dClientFallbackCert    string `json:"c3dClientFallbackCert,omitempty"`
+	C3dDropUnknownOcspStatus string `json:"c3dDropUnknownOcspStatus,omitempty"`
+	C3dOcsp                  string `json:"c3dOcsp,omitempty"`
+}
+
+// clientSslRevocation holds the settings checking the revocation of client certificates, which the client doesn't know,
+// and the stapling of the OCSP response of the profile's certificates
+type clientSslRevocation struct {
+	AllowExpiredCrl string `json:"allowExpiredCrl,omitempty"`
+	Ocsp            string `json:"ocsp,omitempty"`
+	OcspStapling    string `json:"ocspStapling,omitempty"`
+}
+
 func resourceBigipLtmProfileClientSsl() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipLtmProfileClientSSLCreate,
@@ -195,6 +212,28 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 				Description: "Certificate revocation file name",
 			},
 
+			"allow_expired_crl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the client certificates are checked against crl_file once it has expired (enabled / disabled)",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled"}),
+			},
+
+			"ocsp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OCSP certificate validator checking the revocation of the client certificates",
+			},
+
+			"ocsp_stapling": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the OCSP response of the certificates, from their cert_validators, is stapled to the handshake (enabled / disabled)",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled"}),
+			},
+
 			"defaults_from": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -412,6 +451,34 @@ func resourceBigipLtmProfileClientSsl() *schema.Resource {
 				Computed:    true,
 				Description: "Unclean Shutdown (enabled / disabled)",
 			},
+
+			"c3d": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client certificate constrained delegation (C3D), the server SSL profile presents a certificate for the client certificate",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_fallback_cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Certificate the server SSL profile is given for the clients without one",
+						},
+						"drop_unknown_ocsp_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "drop",
+							Description:  "Whether the connection is dropped when the OCSP status of the client certificate is unknown (drop / ignore)",
+							ValidateFunc: validateStringValue([]string{"drop", "ignore"}),
+						},
+						"ocsp": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "OCSP profile checking the client certificates",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -441,7 +508,7 @@ func resourceBigipLtmProfileClientSSLCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	return resourceBigipLtmProfileClientSSLRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileClientSSLRead)
 }
 
 func resourceBigipLtmProfileClientSSLUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -547,6 +614,40 @@ func resourceBigipLtmProfileClientSSLUpdate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("Error create profile Ssl (%s): %s", name, err)
 	}
+	if c3d := d.Get("c3d").([]interface{}); len(c3d) > 0 || d.HasChange("c3d") {
+		settings := &clientSslC3d{SslC3d: "disabled"}
+		if len(c3d) > 0 && c3d[0] != nil {
+			p := c3d[0].(map[string]interface{})
+			settings = &clientSslC3d{
+				SslC3d:                   "enabled",
+				C3dClientFallbackCert:    p["client_fallback_cert"].(string),
+				C3dDropUnknownOcspStatus: p["drop_unknown_ocsp_status"].(string),
+				C3dOcsp:                  p["ocsp"].(string),
+			}
+			if settings.C3dClientFallbackCert == "" {
+				settings.C3dClientFallbackCert = "none"
+			}
+			if settings.C3dOcsp == "" {
+				settings.C3dOcsp = "none"
+			}
+		}
+		if err := patchEntity(client, settings, uriLtm, uriProfile, "client-ssl", name); err != nil {
+			return fmt.Errorf("Error modifying the C3D settings of Client SSL Profile (%s): %s", name, err)
+		}
+	}
+	revocation := &clientSslRevocation{
+		AllowExpiredCrl: d.Get("allow_expired_crl").(string),
+		Ocsp:            d.Get("ocsp").(string),
+		OcspStapling:    d.Get("ocsp_stapling").(string),
+	}
+	if revocation.Ocsp == "" && d.HasChange("ocsp") {
+		revocation.Ocsp = "none"
+	}
+	if *revocation != (clientSslRevocation{}) {
+		if err := patchEntity(client, revocation, uriLtm, uriProfile, "client-ssl", name); err != nil {
+			return fmt.Errorf("Error modifying the revocation settings of Client SSL Profile (%s): %s", name, err)
+		}
+	}
 	return resourceBigipLtmProfileClientSSLRead(d, meta)
 }
 
@@ -764,6 +865,40 @@ func resourceBigipLtmProfileClientSSLRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("[DEBUG] Error saving UncleanShutdown to state for Ssl profile  (%s): %s", d.Id(), err)
 	}
 
+	var extra struct {
+		clientSslC3d
+		clientSslRevocation
+	}
+	if _, err := getForEntity(client, &extra, uriLtm, uriProfile, "client-ssl", name); err != nil {
+		log.Printf("[ERROR] Unable to retrive the C3D and revocation settings of Client SSL Profile (%s) (%v)", name, err)
+		return err
+	}
+	d.Set("allow_expired_crl", extra.AllowExpiredCrl)
+	if extra.Ocsp == "none" {
+		extra.Ocsp = ""
+	}
+	d.Set("ocsp", extra.Ocsp)
+	d.Set("ocsp_stapling", extra.OcspStapling)
+
+	c3d := extra.clientSslC3d
+	var settings []interface{}
+	if c3d.SslC3d == "enabled" {
+		if c3d.C3dClientFallbackCert == "none" {
+			c3d.C3dClientFallbackCert = ""
+		}
+		if c3d.C3dOcsp == "none" {
+			c3d.C3dOcsp = ""
+		}
+		settings = []interface{}{map[string]interface{}{
+			"client_fallback_cert":     c3d.C3dClientFallbackCert,
+			"drop_unknown_ocsp_status": c3d.C3dDropUnknownOcspStatus,
+			"ocsp":                     c3d.C3dOcsp,
+		}}
+	}
+	if err := d.Set("c3d", settings); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving C3D to state for Ssl profile  (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
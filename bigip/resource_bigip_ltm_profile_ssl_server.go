@@ -11,8 +11,21 @@ import (
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// serverSslC3d holds the client certificate constrained delegation (C3D) settings of a server SSL profile, which the
+// client doesn't know
+type serverSslC3d struct {
+	SslC3d                     string   `json:"sslC3d,omitempty"`
+	C3dCaCert                  string   `json:"c3dCaCert,omitempty"`
+	C3dCaKey                   string   `json:"c3dCaKey,omitempty"`
+	C3dCaPassphrase            string   `json:"c3dCaPassphrase,omitempty"`
+	C3dCertExtensionCustomOids []string `json:"c3dCertExtensionCustomOids"`
+	C3dCertExtensionIncludes   []string `json:"c3dCertExtensionIncludes,omitempty"`
+	C3dCertLifespan            int      `json:"c3dCertLifespan,omitempty"`
+}
+
 func resourceBigipLtmProfileServerSsl() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipLtmProfileServerSslCreate,
@@ -300,6 +313,54 @@ func resourceBigipLtmProfileServerSsl() *schema.Resource {
 				Computed:    true,
 				Description: "Unclean Shutdown (drop / ignore)",
 			},
+
+			"c3d": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client certificate constrained delegation (C3D), the certificate of the client is presented to the server signed by a CA",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ca_cert": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Certificate of the CA signing the certificates presented to the server",
+						},
+						"ca_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the CA signing the certificates presented to the server",
+						},
+						"ca_passphrase": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Passphrase of the CA key",
+						},
+						"cert_extension_includes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Extensions of the client certificate copied to the certificate presented to the server",
+						},
+						"cert_extension_custom_oids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "OIDs of the custom extensions of the client certificate copied to the certificate presented to the server",
+						},
+						"cert_lifespan": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      24,
+							Description:  "Lifespan in hours of the certificates presented to the server",
+							ValidateFunc: validation.IntBetween(1, 8760),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -329,7 +390,7 @@ func resourceBigipLtmProfileServerSslCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	return resourceBigipLtmProfileServerSslRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileServerSslRead)
 }
 
 func resourceBigipLtmProfileServerSslUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -391,6 +452,27 @@ func resourceBigipLtmProfileServerSslUpdate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("Error create profile Ssl (%s): %s", name, err)
 	}
+	if c3d := d.Get("c3d").([]interface{}); len(c3d) > 0 || d.HasChange("c3d") {
+		settings := &serverSslC3d{SslC3d: "disabled"}
+		if len(c3d) > 0 && c3d[0] != nil {
+			p := c3d[0].(map[string]interface{})
+			settings = &serverSslC3d{
+				SslC3d:                     "enabled",
+				C3dCaCert:                  p["ca_cert"].(string),
+				C3dCaKey:                   p["ca_key"].(string),
+				C3dCaPassphrase:            p["ca_passphrase"].(string),
+				C3dCertExtensionCustomOids: listToStringSlice(p["cert_extension_custom_oids"].([]interface{})),
+				C3dCertExtensionIncludes:   setToStringSlice(p["cert_extension_includes"].(*schema.Set)),
+				C3dCertLifespan:            p["cert_lifespan"].(int),
+			}
+			if settings.C3dCertExtensionCustomOids == nil {
+				settings.C3dCertExtensionCustomOids = []string{}
+			}
+		}
+		if err := patchEntity(client, settings, uriLtm, uriProfile, "server-ssl", name); err != nil {
+			return fmt.Errorf("Error modifying the C3D settings of Server SSL Profile (%s): %s", name, err)
+		}
+	}
 	return resourceBigipLtmProfileServerSslRead(d, meta)
 }
 
@@ -542,6 +624,28 @@ func resourceBigipLtmProfileServerSslRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("[DEBUG] Error saving UntrustedCertResponseControl to state for Ssl profile  (%s): %s", d.Id(), err)
 	}
 
+	var c3d serverSslC3d
+	if _, err := getForEntity(client, &c3d, uriLtm, uriProfile, "server-ssl", name); err != nil {
+		log.Printf("[ERROR] Unable to retrive the C3D settings of Server SSL Profile (%s) (%v)", name, err)
+		return err
+	}
+	var settings []interface{}
+	if c3d.SslC3d == "enabled" {
+		// The device only returns the passphrase encrypted
+		passphrase := d.Get("c3d.0.ca_passphrase").(string)
+		settings = []interface{}{map[string]interface{}{
+			"ca_cert":                    c3d.C3dCaCert,
+			"ca_key":                     c3d.C3dCaKey,
+			"ca_passphrase":              passphrase,
+			"cert_extension_includes":    c3d.C3dCertExtensionIncludes,
+			"cert_extension_custom_oids": c3d.C3dCertExtensionCustomOids,
+			"cert_lifespan":              c3d.C3dCertLifespan,
+		}}
+	}
+	if err := d.Set("c3d", settings); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving C3D to state for Ssl profile  (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
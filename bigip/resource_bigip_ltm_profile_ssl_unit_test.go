@@ -0,0 +1,174 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipProfileSslC3d(url string, c3d bool) string {
+	clientC3d, serverC3d := "", ""
+	if c3d {
+		clientC3d = `
+				c3d {
+					ocsp = "/Common/ocsp"
+				}`
+		serverC3d = `
+				c3d {
+					ca_cert = "/Common/c3d-ca.crt"
+					ca_key = "/Common/c3d-ca.key"
+					ca_passphrase = "secret"
+					cert_extension_includes = ["key-usage", "subject-alternative-name"]
+					cert_lifespan = 12
+				}`
+	}
+	return fmt.Sprintf(`
+		resource "bigip_ltm_profile_client_ssl" "c3d" {
+			name = "/Common/c3d-client"
+			defaults_from = "/Common/clientssl"
+			cert_key_chain {
+				name = "default"
+				cert = "/Common/default.crt"
+				key = "/Common/default.key"
+			}%s
+		}
+		resource "bigip_ltm_profile_server_ssl" "c3d" {
+			name = "/Common/c3d-server"
+			defaults_from = "/Common/serverssl"%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, clientC3d, serverC3d, url)
+}
+
+func TestAccBigipLtmProfileSslC3d(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+
+	clientPath := "/mgmt/tm/ltm/profile/client-ssl/~Common~c3d-client"
+	serverPath := "/mgmt/tm/ltm/profile/server-ssl/~Common~c3d-server"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipProfileSslC3d(server.URL, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.c3d", "c3d.0.ocsp", "/Common/ocsp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.c3d", "c3d.0.client_fallback_cert", ""),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_server_ssl.c3d", "c3d.0.ca_cert", "/Common/c3d-ca.crt"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_server_ssl.c3d", "c3d.0.ca_passphrase", "secret"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_server_ssl.c3d", "c3d.0.cert_extension_includes.#", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_server_ssl.c3d", "c3d.0.cert_lifespan", "12"),
+					func(*terraform.State) error {
+						assert.Equal(t, "enabled", objects[clientPath]["sslC3d"])
+						assert.Equal(t, "none", objects[clientPath]["c3dClientFallbackCert"])
+						assert.Equal(t, "enabled", objects[serverPath]["sslC3d"])
+						assert.Equal(t, "/Common/c3d-ca.key", objects[serverPath]["c3dCaKey"])
+						return nil
+					},
+				),
+			},
+			{
+				Config: testBigipProfileSslC3d(server.URL, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.c3d", "c3d.#", "0"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_server_ssl.c3d", "c3d.#", "0"),
+					func(*terraform.State) error {
+						assert.Equal(t, "disabled", objects[clientPath]["sslC3d"])
+						assert.Equal(t, "disabled", objects[serverPath]["sslC3d"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testBigipProfileClientSslRevocation(url, ocsp string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_profile_client_ssl" "mtls" {
+			name = "/Common/mtls"
+			defaults_from = "/Common/clientssl"
+			peer_cert_mode = "require"
+			ca_file = "/Common/client-ca.crt"
+			crl_file = "/Common/client-ca.crl"
+			allow_expired_crl = "disabled"
+			ocsp = "%s"
+			ocsp_stapling = "enabled"
+			cert_key_chain {
+				name = "default"
+				cert = "/Common/default.crt"
+				key = "/Common/default.key"
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, ocsp, url)
+}
+
+func TestAccBigipLtmProfileClientSslRevocation(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+
+	path := "/mgmt/tm/ltm/profile/client-ssl/~Common~mtls"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipProfileClientSslRevocation(server.URL, "/Common/ocsp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.mtls", "crl_file", "/Common/client-ca.crl"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.mtls", "allow_expired_crl", "disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.mtls", "ocsp", "/Common/ocsp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.mtls", "ocsp_stapling", "enabled"),
+					func(*terraform.State) error {
+						assert.Equal(t, "/Common/client-ca.crl", objects[path]["crlFile"])
+						assert.Equal(t, "disabled", objects[path]["allowExpiredCrl"])
+						assert.Equal(t, "/Common/ocsp", objects[path]["ocsp"])
+						assert.Equal(t, "enabled", objects[path]["ocspStapling"])
+						return nil
+					},
+				),
+			},
+			{
+				Config: testBigipProfileClientSslRevocation(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_profile_client_ssl.mtls", "ocsp", ""),
+					func(*terraform.State) error {
+						assert.Equal(t, "none", objects[path]["ocsp"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriStream = "stream"
+
+type streamProfile struct {
+	Name         string `json:"name,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Source       string `json:"source,omitempty"`
+	Target       string `json:"tmTarget,omitempty"`
+	Chunking     string `json:"chunking,omitempty"`
+	ChunkSize    int    `json:"chunkSize,omitempty"`
+}
+
+func resourceBigipLtmProfileStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileStreamCreate,
+		Update: resourceBigipLtmProfileStreamUpdate,
+		Read:   resourceBigipLtmProfileStreamRead,
+		Delete: resourceBigipLtmProfileStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Stream Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/stream",
+				Description: "Use the parent Stream profile",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "String to find in the payload",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Replacement for the source string, or a list of @find@replace@ expressions when source is empty",
+			},
+			"chunking": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable chunking of the rewritten payload",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"chunk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Size in bytes of the chunks sent when chunking is enabled",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating Stream profile " + name)
+
+	p := getStreamProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriStream)
+	if err != nil {
+		return fmt.Errorf("Error creating profile Stream (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileStreamRead)
+}
+
+func resourceBigipLtmProfileStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getStreamProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriStream, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile Stream (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileStreamRead(d, meta)
+}
+
+func resourceBigipLtmProfileStreamRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p streamProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriStream, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Stream Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Stream Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("source", p.Source)
+	d.Set("target", p.Target)
+	d.Set("chunking", p.Chunking)
+	d.Set("chunk_size", p.ChunkSize)
+
+	return nil
+}
+
+func resourceBigipLtmProfileStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Stream Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriStream, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Stream Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getStreamProfileConfig(d *schema.ResourceData) *streamProfile {
+	return &streamProfile{
+		DefaultsFrom: d.Get("defaults_from").(string),
+		Source:       d.Get("source").(string),
+		Target:       d.Get("target").(string),
+		Chunking:     d.Get("chunking").(string),
+		ChunkSize:    d.Get("chunk_size").(int),
+	}
+}
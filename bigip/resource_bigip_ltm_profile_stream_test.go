@@ -0,0 +1,110 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_STREAM_NAME = fmt.Sprintf("/%s/test-stream", TEST_PARTITION)
+
+var TEST_STREAM_RESOURCE = `
+resource "bigip_ltm_profile_stream" "test-stream" {
+	name = "` + TEST_STREAM_NAME + `"
+	defaults_from = "/Common/stream"
+	source = "http://"
+	target = "https://"
+	chunking = "enabled"
+}
+`
+
+func TestAccBigipLtmProfileStream_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileStreamDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_STREAM_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileStreamExists(TEST_STREAM_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_stream.test-stream", "name", TEST_STREAM_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_stream.test-stream", "defaults_from", "/Common/stream"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_stream.test-stream", "source", "http://"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_stream.test-stream", "target", "https://"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_stream.test-stream", "chunking", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileStream_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileStreamDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_STREAM_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileStreamExists(TEST_STREAM_NAME, true),
+				),
+				ResourceName:      TEST_STREAM_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileStreamExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p streamProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriStream, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("stream profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("stream profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileStreamDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_stream" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p streamProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriStream, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("stream profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
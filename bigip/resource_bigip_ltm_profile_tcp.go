@@ -127,7 +127,7 @@ func resourceBigipLtmProfileTcpCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipLtmProfileTcpRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileTcpRead)
 }
 
 func resourceBigipLtmProfileTcpUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,245 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriWebAcceleration = "web-acceleration"
+
+type webAccelerationProfile struct {
+	Name                        string   `json:"name,omitempty"`
+	DefaultsFrom                string   `json:"defaultsFrom,omitempty"`
+	CacheSize                   int      `json:"cacheSize,omitempty"`
+	CacheMaxEntries             int      `json:"cacheMaxEntries,omitempty"`
+	CacheMaxAge                 int      `json:"cacheMaxAge,omitempty"`
+	CacheObjectMinSize          int      `json:"cacheObjectMinSize,omitempty"`
+	CacheObjectMaxSize          int      `json:"cacheObjectMaxSize,omitempty"`
+	CacheUriExclude             []string `json:"cacheUriExclude,omitempty"`
+	CacheUriInclude             []string `json:"cacheUriInclude,omitempty"`
+	CacheUriIncludeOverride     []string `json:"cacheUriIncludeOverride,omitempty"`
+	CacheUriPinned              []string `json:"cacheUriPinned,omitempty"`
+	CacheClientCacheControlMode string   `json:"cacheClientCacheControlMode,omitempty"`
+	CacheInsertAgeHeader        string   `json:"cacheInsertAgeHeader,omitempty"`
+	CacheAgingRate              int      `json:"cacheAgingRate,omitempty"`
+}
+
+func resourceBigipLtmProfileWebAcceleration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileWebAccelerationCreate,
+		Update: resourceBigipLtmProfileWebAccelerationUpdate,
+		Read:   resourceBigipLtmProfileWebAccelerationRead,
+		Delete: resourceBigipLtmProfileWebAccelerationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Web Acceleration Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/webacceleration",
+				Description: "Use the parent Web Acceleration profile",
+			},
+			"cache_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum size in megabytes of the cache",
+			},
+			"cache_max_entries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of entries in the cache",
+			},
+			"cache_max_age": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum age in seconds of an object in the cache",
+			},
+			"cache_object_min_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Smallest object in bytes the cache stores",
+			},
+			"cache_object_max_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Largest object in bytes the cache stores",
+			},
+			"cache_uri_exclude": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "URIs that are never cached",
+			},
+			"cache_uri_include": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "URIs that are cached",
+			},
+			"cache_uri_include_override": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "URIs that are cached even if they would not be by default",
+			},
+			"cache_uri_pinned": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "URIs that are kept in the cache until they are explicitly invalidated",
+			},
+			"cache_client_cache_control_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Which client cache control headers the cache honors: all, max-age or none",
+				ValidateFunc: validateStringValue([]string{"all", "max-age", "none"}),
+			},
+			"cache_insert_age_header": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable inserting Age and Date headers in the response",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"cache_aging_rate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "How quickly the cache ages objects, from 0 (slowest) to 10 (fastest)",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileWebAccelerationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating Web Acceleration profile " + name)
+
+	p := getWebAccelerationConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriWebAcceleration)
+	if err != nil {
+		return fmt.Errorf("Error creating profile Web Acceleration (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileWebAccelerationRead)
+}
+
+func resourceBigipLtmProfileWebAccelerationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getWebAccelerationConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriWebAcceleration, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile Web Acceleration (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileWebAccelerationRead(d, meta)
+}
+
+func resourceBigipLtmProfileWebAccelerationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p webAccelerationProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebAcceleration, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve Web Acceleration Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Web Acceleration Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("cache_size", p.CacheSize)
+	d.Set("cache_max_entries", p.CacheMaxEntries)
+	d.Set("cache_max_age", p.CacheMaxAge)
+	d.Set("cache_object_min_size", p.CacheObjectMinSize)
+	d.Set("cache_object_max_size", p.CacheObjectMaxSize)
+	if err := d.Set("cache_uri_exclude", p.CacheUriExclude); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving CacheUriExclude to state for Web Acceleration profile (%s): %s", name, err)
+	}
+	if err := d.Set("cache_uri_include", p.CacheUriInclude); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving CacheUriInclude to state for Web Acceleration profile (%s): %s", name, err)
+	}
+	if err := d.Set("cache_uri_include_override", p.CacheUriIncludeOverride); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving CacheUriIncludeOverride to state for Web Acceleration profile (%s): %s", name, err)
+	}
+	if err := d.Set("cache_uri_pinned", p.CacheUriPinned); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving CacheUriPinned to state for Web Acceleration profile (%s): %s", name, err)
+	}
+	d.Set("cache_client_cache_control_mode", p.CacheClientCacheControlMode)
+	d.Set("cache_insert_age_header", p.CacheInsertAgeHeader)
+	d.Set("cache_aging_rate", p.CacheAgingRate)
+
+	return nil
+}
+
+func resourceBigipLtmProfileWebAccelerationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Web Acceleration Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriWebAcceleration, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Web Acceleration Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getWebAccelerationConfig(d *schema.ResourceData) *webAccelerationProfile {
+	return &webAccelerationProfile{
+		DefaultsFrom:                d.Get("defaults_from").(string),
+		CacheSize:                   d.Get("cache_size").(int),
+		CacheMaxEntries:             d.Get("cache_max_entries").(int),
+		CacheMaxAge:                 d.Get("cache_max_age").(int),
+		CacheObjectMinSize:          d.Get("cache_object_min_size").(int),
+		CacheObjectMaxSize:          d.Get("cache_object_max_size").(int),
+		CacheUriExclude:             setToStringSlice(d.Get("cache_uri_exclude").(*schema.Set)),
+		CacheUriInclude:             setToStringSlice(d.Get("cache_uri_include").(*schema.Set)),
+		CacheUriIncludeOverride:     setToStringSlice(d.Get("cache_uri_include_override").(*schema.Set)),
+		CacheUriPinned:              setToStringSlice(d.Get("cache_uri_pinned").(*schema.Set)),
+		CacheClientCacheControlMode: d.Get("cache_client_cache_control_mode").(string),
+		CacheInsertAgeHeader:        d.Get("cache_insert_age_header").(string),
+		CacheAgingRate:              d.Get("cache_aging_rate").(int),
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_WEBACCELERATION_NAME = fmt.Sprintf("/%s/test-webacceleration", TEST_PARTITION)
+
+var TEST_WEBACCELERATION_RESOURCE = `
+resource "bigip_ltm_profile_web_acceleration" "test-webacceleration" {
+	name = "` + TEST_WEBACCELERATION_NAME + `"
+	defaults_from = "/Common/webacceleration"
+	cache_size = 100
+	cache_max_age = 3600
+	cache_max_entries = 10000
+	cache_uri_exclude = ["/admin/*"]
+	cache_uri_include = ["/static/*"]
+	cache_client_cache_control_mode = "max-age"
+}
+`
+
+func TestAccBigipLtmProfileWebAcceleration_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWebAccelerationDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WEBACCELERATION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWebAccelerationExists(TEST_WEBACCELERATION_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "name", TEST_WEBACCELERATION_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "defaults_from", "/Common/webacceleration"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "cache_size", "100"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "cache_max_age", "3600"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "cache_max_entries", "10000"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration",
+						fmt.Sprintf("cache_uri_exclude.%d", schema.HashString("/admin/*")),
+						"/admin/*"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration",
+						fmt.Sprintf("cache_uri_include.%d", schema.HashString("/static/*")),
+						"/static/*"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_web_acceleration.test-webacceleration", "cache_client_cache_control_mode", "max-age"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileWebAcceleration_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWebAccelerationDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WEBACCELERATION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWebAccelerationExists(TEST_WEBACCELERATION_NAME, true),
+				),
+				ResourceName:      TEST_WEBACCELERATION_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckWebAccelerationExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p webAccelerationProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebAcceleration, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("web acceleration profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("web acceleration profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckWebAccelerationDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_web_acceleration" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p webAccelerationProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebAcceleration, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("web acceleration profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
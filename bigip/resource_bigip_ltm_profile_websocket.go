@@ -0,0 +1,173 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriWebsocket = "websocket"
+
+type websocketProfile struct {
+	Name         string `json:"name,omitempty"`
+	DefaultsFrom string `json:"defaultsFrom,omitempty"`
+	Masking      string `json:"masking,omitempty"`
+	Compression  string `json:"compression,omitempty"`
+	CompressMode string `json:"compressMode,omitempty"`
+	WindowBits   int    `json:"windowBits,omitempty"`
+	NoDelay      string `json:"noDelay,omitempty"`
+}
+
+func resourceBigipLtmProfileWebsocket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmProfileWebsocketCreate,
+		Update: resourceBigipLtmProfileWebsocketUpdate,
+		Read:   resourceBigipLtmProfileWebsocketRead,
+		Delete: resourceBigipLtmProfileWebsocketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the WebSocket Profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/websocket",
+				Description: "Use the parent WebSocket profile",
+			},
+			"masking": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How frames from the client are masked: preserve, remask, selective or unmask",
+				ValidateFunc: validateStringValue([]string{"preserve", "remask", "selective", "unmask"}),
+			},
+			"compression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable per-message compression",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"compress_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether compression settings are preserved from the server or typed: preserved or typed",
+				ValidateFunc: validateStringValue([]string{"preserved", "typed"}),
+			},
+			"window_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Size of the compression window, from 8 to 15",
+				ValidateFunc: validation.IntBetween(8, 15),
+			},
+			"no_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable sending frames without waiting to fill the TCP segment",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileWebsocketCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+
+	log.Println("[INFO] Creating WebSocket profile " + name)
+
+	p := getWebsocketProfileConfig(d)
+	p.Name = name
+	err := postEntity(client, p, uriLtm, uriProfile, uriWebsocket)
+	if err != nil {
+		return fmt.Errorf("Error creating profile WebSocket (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipLtmProfileWebsocketRead)
+}
+
+func resourceBigipLtmProfileWebsocketUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getWebsocketProfileConfig(d)
+	err := putEntity(client, p, uriLtm, uriProfile, uriWebsocket, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying profile WebSocket (%s): %s", name, err)
+	}
+	return resourceBigipLtmProfileWebsocketRead(d, meta)
+}
+
+func resourceBigipLtmProfileWebsocketRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p websocketProfile
+	ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebsocket, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve WebSocket Profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] WebSocket Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("masking", p.Masking)
+	d.Set("compression", p.Compression)
+	d.Set("compress_mode", p.CompressMode)
+	d.Set("window_bits", p.WindowBits)
+	d.Set("no_delay", p.NoDelay)
+
+	return nil
+}
+
+func resourceBigipLtmProfileWebsocketDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting WebSocket Profile " + name)
+
+	err := deleteEntity(client, uriLtm, uriProfile, uriWebsocket, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete WebSocket Profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getWebsocketProfileConfig(d *schema.ResourceData) *websocketProfile {
+	return &websocketProfile{
+		DefaultsFrom: d.Get("defaults_from").(string),
+		Masking:      d.Get("masking").(string),
+		Compression:  d.Get("compression").(string),
+		CompressMode: d.Get("compress_mode").(string),
+		WindowBits:   d.Get("window_bits").(int),
+		NoDelay:      d.Get("no_delay").(string),
+	}
+}
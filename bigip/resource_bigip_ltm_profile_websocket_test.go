@@ -0,0 +1,110 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_WEBSOCKET_NAME = fmt.Sprintf("/%s/test-websocket", TEST_PARTITION)
+
+var TEST_WEBSOCKET_RESOURCE = `
+resource "bigip_ltm_profile_websocket" "test-websocket" {
+	name = "` + TEST_WEBSOCKET_NAME + `"
+	defaults_from = "/Common/websocket"
+	masking = "unmask"
+	compression = "enabled"
+	window_bits = 10
+}
+`
+
+func TestAccBigipLtmProfileWebsocket_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileWebsocketDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WEBSOCKET_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileWebsocketExists(TEST_WEBSOCKET_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "name", TEST_WEBSOCKET_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "defaults_from", "/Common/websocket"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "masking", "unmask"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "compression", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "window_bits", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileWebsocket_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmProfileWebsocketDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WEBSOCKET_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipLtmProfileWebsocketExists(TEST_WEBSOCKET_NAME, true),
+				),
+				ResourceName:      TEST_WEBSOCKET_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipLtmProfileWebsocketExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p websocketProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebsocket, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("websocket profile %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("websocket profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmProfileWebsocketDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_websocket" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p websocketProfile
+		ok, err := getForEntity(client, &p, uriLtm, uriProfile, uriWebsocket, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("websocket profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -115,7 +115,7 @@ func resourceBigipLtmSnatCreate(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[ERROR] Unable to Create Snat  (%s) (%v) ", name, err)
 		return err
 	}
-	return resourceBigipLtmSnatRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmSnatRead)
 }
 
 func resourceBigipLtmSnatRead(d *schema.ResourceData, meta interface{}) error {
@@ -61,7 +61,7 @@ func resourceBigipLtmSnatpoolCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(name)
 
-	return resourceBigipLtmSnatpoolRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmSnatpoolRead)
 }
 
 func resourceBigipLtmSnatpoolUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -79,7 +79,7 @@ func resourceBigipLtmVirtualAddress() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "/Common/traffic-group-1",
-				Description:  "Specify the partition and traffic group",
+				Description:  "Specify the partition and traffic group, the address fails over with its traffic group",
 				ValidateFunc: validateF5Name,
 			},
 		},
@@ -92,10 +92,14 @@ func resourceBigipLtmVirtualAddressCreate(d *schema.ResourceData, meta interface
 	name := d.Get("name").(string)
 	log.Println("[INFO] Creating virtual address " + name)
 
+	trafficGroup := d.Get("traffic_group").(string)
+	if err := checkReferenceExists(client, "Virtual address "+name, "Traffic group", trafficGroup, "cm", uriTrafficGroup, trafficGroup); err != nil {
+		return err
+	}
 	client.CreateVirtualAddress(name, hydrateVirtualAddress(d))
 
 	d.SetId(name)
-	return resourceBigipLtmVirtualAddressRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmVirtualAddressRead)
 }
 
 func resourceBigipLtmVirtualAddressRead(d *schema.ResourceData, meta interface{}) error {
@@ -106,24 +110,17 @@ func resourceBigipLtmVirtualAddressRead(d *schema.ResourceData, meta interface{}
 	log.Println("[INFO] Fetching virtual address " + name)
 
 	var va bigip.VirtualAddress
-	vas, err := client.VirtualAddresses()
+	ok, err := getForEntity(client, &va, uriLtm, "virtual-address",
+		name+selectQuery("fullPath", "arp", "autoDelete", "connectionLimit", "enabled", "icmpEcho", "routeAdvertisement", "trafficGroup"))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Virtual Address (%s) (%v) ", name, err)
 		return err
 	}
-	if vas == nil {
+	if !ok {
 		log.Printf("[WARN] VirtualAddress (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
-	for _, va = range vas.VirtualAddresses {
-		if va.FullPath == name {
-			break
-		}
-	}
-	if va.FullPath != name {
-		return fmt.Errorf("virtual address %s not found", name)
-	}
 
 	d.Set("name", name)
 	if err := d.Set("arp", va.ARP); err != nil {
@@ -149,24 +146,16 @@ func resourceBigipLtmVirtualAddressExists(d *schema.ResourceData, meta interface
 	name := d.Id()
 	log.Println("[INFO] Fetching virtual address " + name)
 
-	var va *bigip.VirtualAddress
-	vas, err := client.VirtualAddresses()
+	var va struct{}
+	ok, err := getForEntity(client, &va, uriLtm, "virtual-address", name+selectQuery("name"))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Virtual Address  (%s) (%v) ", name, err)
 		return false, err
 	}
-	for _, cand := range vas.VirtualAddresses {
-		if cand.FullPath == name {
-			va = &cand
-			break
-		}
-	}
-
-	if &va == nil {
+	if !ok {
 		d.SetId("")
 	}
-
-	return va != nil, nil
+	return ok, nil
 }
 
 func resourceBigipLtmVirtualAddressUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -175,6 +164,11 @@ func resourceBigipLtmVirtualAddressUpdate(d *schema.ResourceData, meta interface
 	name := d.Id()
 
 	va := hydrateVirtualAddress(d)
+	if d.HasChange("traffic_group") {
+		if err := checkReferenceExists(client, "Virtual address "+name, "Traffic group", va.TrafficGroup, "cm", uriTrafficGroup, va.TrafficGroup); err != nil {
+			return err
+		}
+	}
 
 	err := client.ModifyVirtualAddress(name, va)
 	if err != nil {
@@ -11,11 +11,26 @@ import (
 	"log"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// The traffic limits and policies of a virtual server, which go-bigip does not model or leaves out when they are 0
+type virtualServerLimits struct {
+	RateClass          string `json:"rateClass,omitempty"`
+	BwcPolicy          string `json:"bwcPolicy,omitempty"`
+	FlowEvictionPolicy string `json:"flowEvictionPolicy,omitempty"`
+	ConnectionLimit    int    `json:"connectionLimit"`
+	RateLimit          string `json:"rateLimit,omitempty"`
+	RateLimitMode      string `json:"rateLimitMode,omitempty"`
+	RateLimitSrcMask   int    `json:"rateLimitSrcMask"`
+	RateLimitDstMask   int    `json:"rateLimitDstMask"`
+	ServicePolicy      string `json:"servicePolicy,omitempty"`
+}
+
 func resourceBigipLtmVirtualServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipLtmVirtualServerCreate,
@@ -61,8 +76,17 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 			},
 
 			"destination": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Destination address of the virtual server, e.g. 10.1.1.1, or 10.1.1.1%2 in route domain 2",
+			},
+
+			"route_domain": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Route domain of the destination and source addresses, an alternative to their %<id> suffix",
+				ValidateFunc: validation.IntBetween(0, 65534),
 			},
 
 			"pool": {
@@ -180,6 +204,57 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Computed:    true,
 				Description: "Enables the virtual server on the VLANs specified by the VLANs option.",
 			},
+			"rate_class": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the rate class limiting the traffic of the virtual server",
+			},
+			"bwc_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the bandwidth controller policy limiting the bandwidth of the virtual server",
+			},
+			"flow_eviction_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the flow eviction policy choosing the connections dropped when the virtual server is overloaded",
+			},
+			"connection_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of concurrent connections, 0 for no limit",
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"rate_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of connections per second, 0 for no limit",
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"rate_limit_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "object",
+				Description:  "What rate_limit applies to: object, object-source, object-destination, object-source-destination, source, destination or source-destination",
+				ValidateFunc: validateStringValue([]string{"object", "object-source", "object-destination", "object-source-destination", "source", "destination", "source-destination"}),
+			},
+			"rate_limit_src_mask": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Prefix length grouping the source addresses a source rate_limit_mode counts together, 0 for each address",
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"rate_limit_dst_mask": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Prefix length grouping the destination addresses a destination rate_limit_mode counts together, 0 for each address",
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"service_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the service policy, e.g. with a timer policy overriding the idle timeout of the profiles",
+			},
 		},
 	}
 }
@@ -192,10 +267,14 @@ func resourceBigipLtmVirtualServerCreate(d *schema.ResourceData, meta interface{
 	TranslateAddress := d.Get("translate_port").(string)
 	TranslatePort := d.Get("translate_port").(string)
 
+	if err := checkVirtualServerRouteDomain(d); err != nil {
+		return err
+	}
+
 	log.Println("[INFO] Creating virtual server " + name)
 	err := client.CreateVirtualServer(
 		name,
-		d.Get("destination").(string),
+		withRouteDomain(d.Get("destination").(string), d.Get("route_domain").(int)),
 		d.Get("mask").(string),
 		d.Get("pool").(string),
 		d.Get("vlans_enabled").(bool),
@@ -216,7 +295,7 @@ func resourceBigipLtmVirtualServerCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return resourceBigipLtmVirtualServerRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipLtmVirtualServerRead)
 }
 
 func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{}) error {
@@ -239,10 +318,11 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 	// Extract destination address from "/partition_name/(virtual_server_address)[%route_domain]:port"
 	regex := regexp.MustCompile(`(\/.+\/)((?:[0-9]{1,3}\.){3}[0-9]{1,3})(\%\d+)?(\:\d+)`)
 	destination := regex.FindStringSubmatch(vs.Destination)
-	parsedDestination := destination[2] + destination[3]
 	if len(destination) < 3 {
 		return fmt.Errorf("Unable to extract destination address from virtual server destination: " + vs.Destination)
 	}
+	// The route domain id of the addresses is kept when it is configured
+	parsedDestination := configuredAddress(d.Get("destination").(string), destination[2]+destination[3])
 	if err := d.Set("destination", parsedDestination); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Destination to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
@@ -251,10 +331,13 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 	//regex = regexp.MustCompile(`((?:[0-9]{1,3}\.){3}[0-9]{1,3})(?:\%\d+)?(\/\d+)`)
 	//source := regex.FindStringSubmatch(vs.Source)
 	//parsedSource := source[1] + source[2]
-	if err := d.Set("source", vs.Source); err != nil {
+	if err := d.Set("source", configuredAddress(d.Get("source").(string), vs.Source)); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Source to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
 
+	_, routeDomain, _ := splitRouteDomain(destination[2] + destination[3])
+	d.Set("route_domain", routeDomain)
+
 	d.Set("protocol", vs.IPProtocol)
 	d.Set("name", name)
 	if err := d.Set("pool", vs.Pool); err != nil {
@@ -275,7 +358,13 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 	parsedPort, _ := strconv.Atoi(port[1])
 	d.Set("port", parsedPort)
 
-	d.Set("irules", makeStringList(&vs.Rules))
+	var rules []string
+	for _, r := range vs.Rules {
+		if !attachedByOtherResource(r) {
+			rules = append(rules, r)
+		}
+	}
+	d.Set("irules", makeStringList(&rules))
 	d.Set("ip_protocol", vs.IPProtocol)
 	d.Set("description", vs.Description)
 	if vs.Enabled {
@@ -287,7 +376,13 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("snatpool", vs.SourceAddressTranslation.Pool); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Snatpool to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
-	if err := d.Set("policies", vs.Policies); err != nil {
+	var policies []string
+	for _, p := range vs.Policies {
+		if !attachedByOtherResource(p) {
+			policies = append(policies, p)
+		}
+	}
+	if err := d.Set("policies", policies); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Policies to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
 	d.Set("vlans", vs.Vlans)
@@ -302,16 +397,35 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[DEBUG] Error saving FallbackPersistenceProfile to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
 	d.Set("vlans_enabled", vs.VlansEnabled)
+
+	var limits virtualServerLimits
+	if _, err := getForEntity(client, &limits, uriLtm, "virtual", name); err != nil {
+		return fmt.Errorf("[DEBUG] Error retrieving the limits of Virtual Server  (%s): %s", d.Id(), err)
+	}
+	d.Set("rate_class", noneToEmpty(limits.RateClass))
+	d.Set("bwc_policy", noneToEmpty(limits.BwcPolicy))
+	d.Set("flow_eviction_policy", noneToEmpty(limits.FlowEvictionPolicy))
+	d.Set("connection_limit", limits.ConnectionLimit)
+	rateLimit, _ := strconv.Atoi(limits.RateLimit)
+	d.Set("rate_limit", rateLimit)
+	d.Set("rate_limit_mode", limits.RateLimitMode)
+	d.Set("rate_limit_src_mask", limits.RateLimitSrcMask)
+	d.Set("rate_limit_dst_mask", limits.RateLimitDstMask)
+	d.Set("service_policy", noneToEmpty(limits.ServicePolicy))
 	profiles, err := client.VirtualServerProfiles(name)
 	if err != nil {
 		return err
 	}
 
 	if profiles != nil && len(profiles.Profiles) > 0 {
+		fraudProfiles := getFraudProfiles(client)
 		profile_names := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
 		client_profile_names := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
 		server_profile_names := schema.NewSet(schema.HashString, make([]interface{}, 0, len(profiles.Profiles)))
 		for _, profile := range profiles.Profiles {
+			if fraudProfiles[profile.FullPath] {
+				continue
+			}
 			switch profile.Context {
 			case bigip.CONTEXT_CLIENT:
 				client_profile_names.Add(profile.FullPath)
@@ -360,6 +474,10 @@ func resourceBigipLtmVirtualServerUpdate(d *schema.ResourceData, meta interface{
 	client := meta.(*bigip.BigIP)
 
 	name := d.Id()
+	if err := checkVirtualServerRouteDomain(d); err != nil {
+		return err
+	}
+	routeDomain := d.Get("route_domain").(int)
 
 	var profiles []bigip.Profile
 	if p, ok := d.GetOk("profiles"); ok {
@@ -404,10 +522,45 @@ func resourceBigipLtmVirtualServerUpdate(d *schema.ResourceData, meta interface{
 		rules = listToStringSlice(cfg_rules.([]interface{}))
 	}
 
+	// Keep the iRules and policies other resources attached
+	current, err := client.GetVirtualServer(name)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		partition := "Common"
+		if strings.HasPrefix(name, "/") {
+			partition, _ = parseF5Identifier(name)
+		}
+		for _, p := range current.Policies {
+			if attachedByOtherResource(p) {
+				policies = append(policies, fmt.Sprintf("/%s/%s", partition, p))
+			}
+		}
+		for _, r := range current.Rules {
+			if attachedByOtherResource(r) {
+				rules = append(rules, r)
+			}
+		}
+		// Fraud protection profiles are attached by bigip_ltm_virtual_server_fraud_profile
+		currentProfiles, err := client.VirtualServerProfiles(name)
+		if err != nil {
+			return err
+		}
+		if currentProfiles != nil {
+			fraudProfiles := getFraudProfiles(client)
+			for _, p := range currentProfiles.Profiles {
+				if fraudProfiles[p.FullPath] {
+					profiles = append(profiles, bigip.Profile{Name: p.FullPath, Context: p.Context})
+				}
+			}
+		}
+	}
+
 	vs := &bigip.VirtualServer{
-		Destination:                fmt.Sprintf("%s:%d", d.Get("destination").(string), d.Get("port").(int)),
+		Destination:                fmt.Sprintf("%s:%d", withRouteDomain(d.Get("destination").(string), routeDomain), d.Get("port").(int)),
 		FallbackPersistenceProfile: d.Get("fallback_persistence_profile").(string),
-		Source:                     d.Get("source").(string),
+		Source:                     withRouteDomain(d.Get("source").(string), routeDomain),
 		Pool:                       d.Get("pool").(string),
 		Mask:                       d.Get("mask").(string),
 		Description:                d.Get("description").(string),
@@ -431,11 +584,30 @@ func resourceBigipLtmVirtualServerUpdate(d *schema.ResourceData, meta interface{
 	if d.Get("state").(string) == "disabled" {
 		vs.Disabled = true
 	}
-	err := client.ModifyVirtualServer(name, vs)
+	err = client.ModifyVirtualServer(name, vs)
 	if err != nil {
 		return err
 	}
 
+	limits := &virtualServerLimits{
+		RateClass:          emptyToNone(d.Get("rate_class").(string)),
+		BwcPolicy:          emptyToNone(d.Get("bwc_policy").(string)),
+		FlowEvictionPolicy: emptyToNone(d.Get("flow_eviction_policy").(string)),
+		ConnectionLimit:    d.Get("connection_limit").(int),
+		RateLimit:          "disabled",
+		RateLimitMode:      d.Get("rate_limit_mode").(string),
+		RateLimitSrcMask:   d.Get("rate_limit_src_mask").(int),
+		RateLimitDstMask:   d.Get("rate_limit_dst_mask").(int),
+		ServicePolicy:      emptyToNone(d.Get("service_policy").(string)),
+	}
+	if rateLimit := d.Get("rate_limit").(int); rateLimit > 0 {
+		limits.RateLimit = strconv.Itoa(rateLimit)
+	}
+	err = patchEntity(client, limits, uriLtm, "virtual", name)
+	if err != nil {
+		return fmt.Errorf("Error setting the limits of Virtual Server (%s): %s", name, err)
+	}
+
 	return resourceBigipLtmVirtualServerRead(d, meta)
 }
 
@@ -453,3 +625,37 @@ func resourceBigipLtmVirtualServerDelete(d *schema.ResourceData, meta interface{
 	d.SetId("")
 	return nil
 }
+
+// attachedByOtherResource reports whether an iRule or policy of a virtual server is managed by another
+// resource, e.g. bigip_ltm_virtual_server_asm_policy, and has to be left alone. Fraud protection profiles
+// are told apart by getFraudProfiles instead.
+func attachedByOtherResource(name string) bool {
+	name = name[strings.LastIndex(name, "/")+1:]
+	return strings.HasPrefix(name, asmAutoPolicyPrefix) || strings.HasPrefix(name, acmeChallengePrefix)
+}
+
+// emptyToNone returns the value of an unset reference to another object, which is "none" rather than ""
+func emptyToNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func noneToEmpty(s string) string {
+	if s == "none" {
+		return ""
+	}
+	return s
+}
+
+// checkVirtualServerRouteDomain returns an error when the destination or the source address carries the id of
+// another route domain than route_domain
+func checkVirtualServerRouteDomain(d *schema.ResourceData) error {
+	for _, address := range []string{d.Get("destination").(string), d.Get("source").(string)} {
+		if err := checkRouteDomain(address, d.Get("route_domain").(int)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The GUI attaches an ASM policy to a virtual server through an LTM policy with this
+// name prefix, followed by the name of the virtual server.
+const asmAutoPolicyPrefix = "asm_auto_l7_policy__"
+
+type asmAutoPolicy struct {
+	Name      string              `json:"name,omitempty"`
+	Partition string              `json:"partition,omitempty"`
+	SubPath   string              `json:"subPath,omitempty"`
+	Strategy  string              `json:"strategy,omitempty"`
+	Controls  []string            `json:"controls,omitempty"`
+	Requires  []string            `json:"requires,omitempty"`
+	Rules     []asmAutoPolicyRule `json:"rules,omitempty"`
+}
+
+type asmAutoPolicyRule struct {
+	Name    string                   `json:"name"`
+	Ordinal int                      `json:"ordinal"`
+	Actions []bigip.PolicyRuleAction `json:"actions"`
+}
+
+// asmAutoPolicyExpanded is an LTM policy read with expandSubcollections=true
+type asmAutoPolicyExpanded struct {
+	RulesReference struct {
+		Items []struct {
+			ActionsReference struct {
+				Items []bigip.PolicyRuleAction `json:"items"`
+			} `json:"actionsReference"`
+		} `json:"items"`
+	} `json:"rulesReference"`
+}
+
+type virtualServerPolicyRefs struct {
+	Items []struct {
+		FullPath string `json:"fullPath"`
+	} `json:"items"`
+}
+
+func resourceBigipLtmVirtualServerAsmPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmVirtualServerAsmPolicyCreate,
+		Update: resourceBigipLtmVirtualServerAsmPolicyUpdate,
+		Read:   resourceBigipLtmVirtualServerAsmPolicyRead,
+		Delete: resourceBigipLtmVirtualServerAsmPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_server": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the virtual server the ASM policy protects",
+				ValidateFunc: validateF5Name,
+			},
+			"asm_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the ASM policy",
+				ValidateFunc: validateF5Name,
+			},
+			"ltm_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the LTM policy enabling the ASM policy",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmVirtualServerAsmPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Get("virtual_server").(string)
+	asmPolicy := d.Get("asm_policy").(string)
+	partition, name := asmAutoPolicyName(vs)
+	ltmPolicy := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Enabling ASM policy %s on virtual server %s", asmPolicy, vs)
+
+	p := getAsmAutoPolicyConfig(asmPolicy)
+	p.Name = name
+	p.Partition = partition
+	p.SubPath = "Drafts"
+	err := postEntity(client, p, uriLtm, "policy")
+	if err != nil {
+		return fmt.Errorf("Error creating LTM policy (%s): %s", ltmPolicy, err)
+	}
+	err = publishAsmAutoPolicy(client, partition, name)
+	if err != nil {
+		return fmt.Errorf("Error publishing LTM policy (%s): %s", ltmPolicy, err)
+	}
+	err = postEntity(client, map[string]string{"name": name, "partition": partition}, uriLtm, "virtual", vs, "policies")
+	if err != nil {
+		return fmt.Errorf("Error attaching LTM policy (%s) to virtual server (%s): %s", ltmPolicy, vs, err)
+	}
+
+	d.SetId(vs)
+	return readAfterCreate(d, meta, resourceBigipLtmVirtualServerAsmPolicyRead)
+}
+
+func resourceBigipLtmVirtualServerAsmPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	asmPolicy := d.Get("asm_policy").(string)
+	partition, name := asmAutoPolicyName(vs)
+	ltmPolicy := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Enabling ASM policy %s on virtual server %s", asmPolicy, vs)
+
+	// Published policies are read only, changes go through a draft
+	err := patchEntity(client, struct{}{}, uriLtm, "policy", ltmPolicy+"?options=create-draft")
+	if err != nil {
+		return fmt.Errorf("Error creating draft of LTM policy (%s): %s", ltmPolicy, err)
+	}
+	err = putEntity(client, getAsmAutoPolicyConfig(asmPolicy), uriLtm, "policy", fmt.Sprintf("/%s/Drafts/%s", partition, name))
+	if err != nil {
+		return fmt.Errorf("Error modifying LTM policy (%s): %s", ltmPolicy, err)
+	}
+	err = publishAsmAutoPolicy(client, partition, name)
+	if err != nil {
+		return fmt.Errorf("Error publishing LTM policy (%s): %s", ltmPolicy, err)
+	}
+	return resourceBigipLtmVirtualServerAsmPolicyRead(d, meta)
+}
+
+func resourceBigipLtmVirtualServerAsmPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	partition, name := asmAutoPolicyName(vs)
+	ltmPolicy := fmt.Sprintf("/%s/%s", partition, name)
+
+	var refs virtualServerPolicyRefs
+	ok, err := getForEntity(client, &refs, uriLtm, "virtual", vs, "policies")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve policies of Virtual Server (%s) (%v)", vs, err)
+		return err
+	}
+	attached := false
+	for _, r := range refs.Items {
+		attached = attached || r.FullPath == ltmPolicy
+	}
+	if !ok || !attached {
+		log.Printf("[WARN] LTM policy (%s) not attached to Virtual Server (%s), removing from state", ltmPolicy, vs)
+		d.SetId("")
+		return nil
+	}
+
+	var p asmAutoPolicyExpanded
+	ok, err = getForEntity(client, &p, uriLtm, "policy", ltmPolicy+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve LTM policy (%s) (%v)", ltmPolicy, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] LTM policy (%s) not found, removing from state", ltmPolicy)
+		d.SetId("")
+		return nil
+	}
+	for _, r := range p.RulesReference.Items {
+		for _, a := range r.ActionsReference.Items {
+			if a.Asm && a.Enable {
+				d.Set("asm_policy", a.Policy)
+			}
+		}
+	}
+	d.Set("virtual_server", vs)
+	d.Set("ltm_policy", ltmPolicy)
+
+	return nil
+}
+
+func resourceBigipLtmVirtualServerAsmPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	partition, name := asmAutoPolicyName(vs)
+	ltmPolicy := fmt.Sprintf("/%s/%s", partition, name)
+
+	log.Printf("[INFO] Disabling ASM on virtual server %s", vs)
+
+	err := deleteEntity(client, uriLtm, "virtual", vs, "policies", ltmPolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to detach LTM policy (%s) from Virtual Server (%s) (%v)", ltmPolicy, vs, err)
+		return err
+	}
+	err = deleteEntity(client, uriLtm, "policy", ltmPolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete LTM policy (%s) (%v)", ltmPolicy, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// asmAutoPolicyName returns the partition and name of the LTM policy the GUI would create for a virtual server
+func asmAutoPolicyName(vs string) (string, string) {
+	partition, name := "Common", vs
+	if strings.HasPrefix(vs, "/") {
+		partition, name = parseF5Identifier(vs)
+	}
+	return partition, asmAutoPolicyPrefix + name
+}
+
+func publishAsmAutoPolicy(client *bigip.BigIP, partition, name string) error {
+	return postEntity(client, map[string]string{
+		"command": "publish",
+		"name":    fmt.Sprintf("/%s/Drafts/%s", partition, name),
+	}, uriLtm, "policy")
+}
+
+func getAsmAutoPolicyConfig(asmPolicy string) *asmAutoPolicy {
+	return &asmAutoPolicy{
+		Strategy: "/Common/first-match",
+		Controls: []string{"asm"},
+		Requires: []string{"http"},
+		Rules: []asmAutoPolicyRule{
+			{
+				Name:    "default",
+				Ordinal: 1,
+				Actions: []bigip.PolicyRuleAction{
+					{Name: "1", Asm: true, Enable: true, Request: true, Policy: asmPolicy},
+				},
+			},
+		},
+	}
+}
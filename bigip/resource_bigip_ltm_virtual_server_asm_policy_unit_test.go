@@ -0,0 +1,100 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmVirtualServerAsmPolicy(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_virtual_server_asm_policy" "test-waf" {
+			virtual_server = "/Common/test-vs"
+			asm_policy = "/Common/test-waf"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipLtmVirtualServerAsmPolicyCreate(t *testing.T) {
+	var published, attached, deleted bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/policy", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]interface{}
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		if body["command"] == "publish" {
+			assert.Equal(t, "/Common/Drafts/asm_auto_l7_policy__test-vs", body["name"])
+			published = true
+		} else {
+			assert.Equal(t, "asm_auto_l7_policy__test-vs", body["name"])
+			assert.Equal(t, "Drafts", body["subPath"])
+			assert.Equal(t, []interface{}{"asm"}, body["controls"])
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/policy/~Common~asm_auto_l7_policy__test-vs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deleted = true
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		fmt.Fprintf(w, `{"name":"asm_auto_l7_policy__test-vs","rulesReference":{"items":[{"name":"default",
+			"actionsReference":{"items":[{"name":"1","asm":true,"enable":true,"request":true,"policy":"/Common/test-waf"}]}}]}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/policies", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			b, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name":"asm_auto_l7_policy__test-vs","partition":"Common"}`, string(b))
+			attached = true
+		}
+		if attached {
+			fmt.Fprintf(w, `{"items":[{"name":"asm_auto_l7_policy__test-vs","fullPath":"/Common/asm_auto_l7_policy__test-vs"}]}`)
+		} else {
+			fmt.Fprintf(w, `{"items":[]}`)
+		}
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/policies/~Common~asm_auto_l7_policy__test-vs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		attached = false
+		fmt.Fprintf(w, `{}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmVirtualServerAsmPolicy(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server_asm_policy.test-waf", "asm_policy", "/Common/test-waf"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server_asm_policy.test-waf", "ltm_policy", "/Common/asm_auto_l7_policy__test-vs"),
+				),
+			},
+		},
+	})
+	assert.True(t, published, "LTM policy was not published")
+	assert.False(t, attached, "LTM policy was not detached")
+	assert.True(t, deleted, "LTM policy was not deleted")
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriAntiFraud = "anti-fraud"
+
+type virtualServerProfileRefs struct {
+	Items []struct {
+		FullPath string `json:"fullPath"`
+	} `json:"items"`
+}
+
+func resourceBigipLtmVirtualServerFraudProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmVirtualServerFraudProfileCreate,
+		Update: resourceBigipLtmVirtualServerFraudProfileUpdate,
+		Read:   resourceBigipLtmVirtualServerFraudProfileRead,
+		Delete: resourceBigipLtmVirtualServerFraudProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_server": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the virtual server the fraud protection profile protects",
+				ValidateFunc: validateF5Name,
+			},
+			"fraud_profile": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the fraud protection (anti-fraud) profile",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmVirtualServerFraudProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Get("virtual_server").(string)
+	profile := d.Get("fraud_profile").(string)
+	log.Printf("[INFO] Attaching fraud protection profile %s to virtual server %s", profile, vs)
+
+	if err := attachFraudProfile(client, vs, profile); err != nil {
+		return err
+	}
+	d.SetId(vs)
+	return readAfterCreate(d, meta, resourceBigipLtmVirtualServerFraudProfileRead)
+}
+
+func resourceBigipLtmVirtualServerFraudProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	o, n := d.GetChange("fraud_profile")
+	log.Printf("[INFO] Replacing fraud protection profile %s of virtual server %s with %s", o, vs, n)
+
+	err := deleteEntity(client, uriLtm, "virtual", vs, "profiles", o.(string))
+	if err != nil {
+		return fmt.Errorf("Error detaching fraud protection profile (%s) from virtual server (%s): %s", o, vs, err)
+	}
+	if err := attachFraudProfile(client, vs, n.(string)); err != nil {
+		return err
+	}
+	return resourceBigipLtmVirtualServerFraudProfileRead(d, meta)
+}
+
+func resourceBigipLtmVirtualServerFraudProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+
+	var refs virtualServerProfileRefs
+	ok, err := getForEntity(client, &refs, uriLtm, "virtual", vs, "profiles")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve profiles of Virtual Server (%s) (%v)", vs, err)
+		return err
+	}
+	fraudProfiles := getFraudProfiles(client)
+	profile := ""
+	for _, r := range refs.Items {
+		if fraudProfiles[r.FullPath] {
+			profile = r.FullPath
+		}
+	}
+	if !ok || profile == "" {
+		log.Printf("[WARN] No fraud protection profile attached to Virtual Server (%s), removing from state", vs)
+		d.SetId("")
+		return nil
+	}
+	d.Set("virtual_server", vs)
+	d.Set("fraud_profile", profile)
+	return nil
+}
+
+func resourceBigipLtmVirtualServerFraudProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	vs := d.Id()
+	profile := d.Get("fraud_profile").(string)
+	log.Printf("[INFO] Detaching fraud protection profile %s from virtual server %s", profile, vs)
+
+	err := deleteEntity(client, uriLtm, "virtual", vs, "profiles", profile)
+	if err != nil {
+		log.Printf("[ERROR] Unable to detach fraud protection profile (%s) from Virtual Server (%s) (%v)", profile, vs, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func attachFraudProfile(client *bigip.BigIP, vs, profile string) error {
+	err := checkReferenceExists(client, "Virtual Server "+vs, "Fraud protection profile", profile, uriSecurity, uriAntiFraud, uriProfile, profile)
+	if err != nil {
+		return err
+	}
+	err = postEntity(client, &bigip.Profile{Name: profile, Context: bigip.CONTEXT_ALL}, uriLtm, "virtual", vs, "profiles")
+	if err != nil {
+		return fmt.Errorf("Error attaching fraud protection profile (%s) to virtual server (%s): %s", profile, vs, err)
+	}
+	return nil
+}
+
+// getFraudProfiles returns the full paths of the fraud protection profiles. They are attached to virtual servers by
+// bigip_ltm_virtual_server_fraud_profile, so bigip_ltm_virtual_server leaves them alone. None are returned when
+// fraud protection is not provisioned.
+func getFraudProfiles(client *bigip.BigIP) map[string]bool {
+	var items []struct {
+		FullPath string `json:"fullPath"`
+	}
+	profiles := map[string]bool{}
+	_, err := getCollection(client, &items, selectQuery("fullPath"), uriSecurity, uriAntiFraud, uriProfile)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list fraud protection profiles, assuming it is not provisioned (%v)", err)
+		return profiles
+	}
+	for _, i := range items {
+		profiles[i.FullPath] = true
+	}
+	return profiles
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipLtmVirtualServerFraudProfile(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_virtual_server_fraud_profile" "test-fps" {
+			virtual_server = "/Common/test-vs"
+			fraud_profile = "/Common/test-antifraud"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipLtmVirtualServerFraudProfileCreate(t *testing.T) {
+	attached := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/fps", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/security/anti-fraud/profile", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/test-antifraud"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/security/anti-fraud/profile/~Common~test-antifraud", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-antifraud","fullPath":"/Common/test-antifraud"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/profiles", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			b, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name":"/Common/test-antifraud","context":"all"}`, string(b))
+			attached = true
+		}
+		if attached {
+			fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/http"},{"fullPath":"/Common/test-antifraud"}]}`)
+		} else {
+			fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/http"}]}`)
+		}
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/virtual/~Common~test-vs/profiles/~Common~test-antifraud", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		attached = false
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipLtmVirtualServerFraudProfile(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server_fraud_profile.test-fps", "id", "/Common/test-vs"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server_fraud_profile.test-fps", "fraud_profile", "/Common/test-antifraud"),
+				),
+			},
+		},
+	})
+	assert.False(t, attached, "Fraud protection profile was not detached")
+}
@@ -129,6 +129,37 @@ func TestAccBigipLtmVS_create_Defaultstate(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmVS_limits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testVSCreateLimits("test-vs-sample", 100, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("test-vs-sample", true),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "connection_limit", "100"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "rate_limit", "10"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "rate_limit_mode", "source"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "rate_limit_src_mask", "24"),
+				),
+			},
+			{
+				Config: testVSCreateLimits("test-vs-sample", 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "connection_limit", "0"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "rate_limit", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBigipLtmVS_Modify_stateDisabledtoEnabled(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -242,6 +273,52 @@ func TestAccBigipLtmVS_import(t *testing.T) {
 	})
 }
 
+var TEST_RD_VS_RESOURCE = TEST_ROUTEDOMAIN_RESOURCE + `
+resource "bigip_ltm_virtual_server" "test-rd-vs" {
+	name = "/` + TEST_PARTITION + `/test-rd-vs"
+	destination = "10.255.254.1%12"
+	source = "0.0.0.0%12/0"
+	port = 80
+	depends_on = ["bigip_net_routedomain.test-routedomain"]
+}
+resource "bigip_ltm_virtual_server" "test-rd-attribute-vs" {
+	name = "/` + TEST_PARTITION + `/test-rd-attribute-vs"
+	destination = "10.255.254.2"
+	route_domain = 12
+	port = 80
+	depends_on = ["bigip_net_routedomain.test-routedomain"]
+}
+`
+
+// Virtual servers in a route domain keep their addresses as configured, the plan is empty once they are applied
+func TestAccBigipLtmVS_routeDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVSsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RD_VS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists("/"+TEST_PARTITION+"/test-rd-vs", true),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-vs", "destination", "10.255.254.1%12"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-vs", "source", "0.0.0.0%12/0"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-vs", "route_domain", "12"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-attribute-vs", "destination", "10.255.254.2"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-attribute-vs", "source", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-rd-attribute-vs", "route_domain", "12"),
+				),
+			},
+			{
+				Config:   TEST_RD_VS_RESOURCE,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testCheckVSExists(name string, exists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -291,6 +368,20 @@ resource "bigip_ltm_virtual_server" "test-vs" {
 }`, vs_name)
 }
 
+func testVSCreateLimits(vs_name string, connectionLimit, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_virtual_server" "test-vs" {
+        name = "/Common/%s"
+        destination = "192.168.50.1"
+        port = 800
+        mask = "255.255.255.255"
+        connection_limit = %d
+        rate_limit = %d
+        rate_limit_mode = "source"
+        rate_limit_src_mask = 24
+}`, vs_name, connectionLimit, rateLimit)
+}
+
 func testCheckVSsDestroyed(s *terraform.State) error {
 	client := testAccProvider.Meta().(*bigip.BigIP)
 
@@ -0,0 +1,125 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriArp = "arp"
+
+// netNeighbor is a static ARP entry, or an IPv6 neighbor entry
+type netNeighbor struct {
+	Name       string `json:"name,omitempty"`
+	IPAddress  string `json:"ipAddress,omitempty"`
+	MACAddress string `json:"macAddress,omitempty"`
+}
+
+func resourceBigipNetArp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetArpCreate,
+		Update: resourceBigipNetArpUpdate,
+		Read:   resourceBigipNetArpRead,
+		Delete: resourceBigipNetArpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the static ARP entry",
+				ValidateFunc: validateF5Name,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IPv4 address of the entry",
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "MAC address the IPv4 address resolves to",
+				ValidateFunc:     validateMACAddress,
+				DiffSuppressFunc: suppressEquivalentMAC,
+			},
+		},
+	}
+}
+
+func resourceBigipNetArpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating static ARP entry " + name)
+
+	err := postEntity(client, &netNeighbor{
+		Name:       name,
+		IPAddress:  d.Get("ip_address").(string),
+		MACAddress: d.Get("mac_address").(string),
+	}, "net", uriArp)
+	if err != nil {
+		return fmt.Errorf("Error creating static ARP entry (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetArpRead)
+}
+
+func resourceBigipNetArpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, &netNeighbor{MACAddress: d.Get("mac_address").(string)}, "net", uriArp, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying static ARP entry (%s): %s", name, err)
+	}
+	return resourceBigipNetArpRead(d, meta)
+}
+
+func resourceBigipNetArpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var arp netNeighbor
+	ok, err := getForEntity(client, &arp, "net", uriArp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve static ARP entry (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Static ARP entry (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("ip_address", arp.IPAddress)
+	d.Set("mac_address", arp.MACAddress)
+	return nil
+}
+
+func resourceBigipNetArpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting static ARP entry " + name)
+
+	err := deleteEntity(client, "net", uriArp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete static ARP entry (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
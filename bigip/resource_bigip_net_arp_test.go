@@ -0,0 +1,105 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_ARP_NAME = fmt.Sprintf("/%s/test-arp", TEST_PARTITION)
+
+var TEST_ARP_RESOURCE = `
+resource "bigip_net_arp" "test-arp" {
+	name = "` + TEST_ARP_NAME + `"
+	ip_address = "10.10.10.50"
+	mac_address = "00:50:56:8a:12:34"
+}
+`
+
+func TestAccBigipNetArp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetArpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ARP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetArpExists(TEST_ARP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_arp.test-arp", "name", TEST_ARP_NAME),
+					resource.TestCheckResourceAttr("bigip_net_arp.test-arp", "ip_address", "10.10.10.50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetArp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetArpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ARP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetArpExists(TEST_ARP_NAME, true),
+				),
+				ResourceName:      TEST_ARP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetArpExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p netNeighbor
+		ok, err := getForEntity(client, &p, "net", uriArp, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("static ARP entry %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("static ARP entry %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetArpDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_arp" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p netNeighbor
+		ok, err := getForEntity(client, &p, "net", uriArp, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("static ARP entry %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriDnsResolver = "dns-resolver"
+
+type dnsResolver struct {
+	Name                   string           `json:"name,omitempty"`
+	Description            string           `json:"description,omitempty"`
+	RouteDomain            string           `json:"routeDomain,omitempty"`
+	CacheSize              int              `json:"cacheSize,omitempty"`
+	AnswerDefaultZones     string           `json:"answerDefaultZones,omitempty"`
+	RandomizeQueryNameCase string           `json:"randomizeQueryNameCase,omitempty"`
+	UseIpv4                string           `json:"useIpv4,omitempty"`
+	UseIpv6                string           `json:"useIpv6,omitempty"`
+	UseTcp                 string           `json:"useTcp,omitempty"`
+	UseUdp                 string           `json:"useUdp,omitempty"`
+	ForwardZones           []dnsForwardZone `json:"forwardZones"`
+}
+
+type dnsForwardZone struct {
+	Name        string                 `json:"name"`
+	Nameservers []dnsForwardNameserver `json:"nameservers"`
+}
+
+type dnsForwardNameserver struct {
+	Name string `json:"name"`
+}
+
+func resourceBigipNetDnsResolver() *schema.Resource {
+	yesNo := validateStringValue([]string{"yes", "no"})
+	return &schema.Resource{
+		Create: resourceBigipNetDnsResolverCreate,
+		Update: resourceBigipNetDnsResolverUpdate,
+		Read:   resourceBigipNetDnsResolverRead,
+		Delete: resourceBigipNetDnsResolverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the DNS resolver",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"route_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Route domain the resolver sends queries from, e.g. /Common/0",
+			},
+			"cache_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Size of the cache in bytes",
+			},
+			"answer_default_zones": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the resolver answers queries for the default zones, e.g. localhost, itself",
+				ValidateFunc: yesNo,
+			},
+			"randomize_query_name_case": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the case of the names queried is randomized, to protect against cache poisoning",
+				ValidateFunc: yesNo,
+			},
+			"use_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether queries are sent over IPv4",
+				ValidateFunc: yesNo,
+			},
+			"use_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether queries are sent over IPv6",
+				ValidateFunc: yesNo,
+			},
+			"use_tcp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether queries are sent over TCP",
+				ValidateFunc: yesNo,
+			},
+			"use_udp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether queries are sent over UDP",
+				ValidateFunc: yesNo,
+			},
+			"forward_zone": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Zones whose queries are forwarded to nameservers rather than resolved from the root servers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the zone, e.g. example.com, or . for all queries",
+						},
+						"nameservers": {
+							Type:        schema.TypeSet,
+							Set:         schema.HashString,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							Description: "Nameservers the queries are forwarded to, as <address>:<port>",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipNetDnsResolverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating DNS resolver " + name)
+
+	r := getNetDnsResolverConfig(d)
+	r.Name = name
+	err := postEntity(client, r, "net", uriDnsResolver)
+	if err != nil {
+		return fmt.Errorf("Error creating DNS resolver (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetDnsResolverRead)
+}
+
+func resourceBigipNetDnsResolverUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getNetDnsResolverConfig(d), "net", uriDnsResolver, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying DNS resolver (%s): %s", name, err)
+	}
+	return resourceBigipNetDnsResolverRead(d, meta)
+}
+
+func resourceBigipNetDnsResolverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r dnsResolver
+	ok, err := getForEntity(client, &r, "net", uriDnsResolver, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve DNS resolver (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] DNS resolver (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("route_domain", r.RouteDomain)
+	d.Set("cache_size", r.CacheSize)
+	d.Set("answer_default_zones", r.AnswerDefaultZones)
+	d.Set("randomize_query_name_case", r.RandomizeQueryNameCase)
+	d.Set("use_ipv4", r.UseIpv4)
+	d.Set("use_ipv6", r.UseIpv6)
+	d.Set("use_tcp", r.UseTcp)
+	d.Set("use_udp", r.UseUdp)
+
+	var zones []interface{}
+	for _, z := range r.ForwardZones {
+		var nameservers []string
+		for _, ns := range z.Nameservers {
+			nameservers = append(nameservers, ns.Name)
+		}
+		zones = append(zones, map[string]interface{}{
+			"name":        z.Name,
+			"nameservers": nameservers,
+		})
+	}
+	if err := d.Set("forward_zone", zones); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ForwardZone to state for DNS resolver (%s): %s", name, err)
+	}
+	return nil
+}
+
+func resourceBigipNetDnsResolverDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting DNS resolver " + name)
+
+	err := deleteEntity(client, "net", uriDnsResolver, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DNS resolver (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetDnsResolverConfig(d *schema.ResourceData) *dnsResolver {
+	r := &dnsResolver{
+		Description:            d.Get("description").(string),
+		RouteDomain:            d.Get("route_domain").(string),
+		CacheSize:              d.Get("cache_size").(int),
+		AnswerDefaultZones:     d.Get("answer_default_zones").(string),
+		RandomizeQueryNameCase: d.Get("randomize_query_name_case").(string),
+		UseIpv4:                d.Get("use_ipv4").(string),
+		UseIpv6:                d.Get("use_ipv6").(string),
+		UseTcp:                 d.Get("use_tcp").(string),
+		UseUdp:                 d.Get("use_udp").(string),
+		ForwardZones:           []dnsForwardZone{},
+	}
+	for _, z := range d.Get("forward_zone").(*schema.Set).List() {
+		zone := z.(map[string]interface{})
+		fz := dnsForwardZone{Name: zone["name"].(string)}
+		for _, ns := range setToStringSlice(zone["nameservers"].(*schema.Set)) {
+			fz.Nameservers = append(fz.Nameservers, dnsForwardNameserver{Name: ns})
+		}
+		r.ForwardZones = append(r.ForwardZones, fz)
+	}
+	return r
+}
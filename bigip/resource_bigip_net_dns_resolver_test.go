@@ -0,0 +1,113 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DNS_RESOLVER_NAME = fmt.Sprintf("/%s/test-dns-resolver", TEST_PARTITION)
+
+var TEST_DNS_RESOLVER_RESOURCE = `
+resource "bigip_net_dns_resolver" "test-dns-resolver" {
+	name = "` + TEST_DNS_RESOLVER_NAME + `"
+	description = "test DNS resolver"
+	cache_size = 1048576
+	use_ipv6 = "no"
+	forward_zone {
+		name = "example.com"
+		nameservers = ["10.10.10.53:53"]
+	}
+}
+`
+
+func TestAccBigipNetDnsResolver_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetDnsResolverDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_RESOLVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetDnsResolverExists(TEST_DNS_RESOLVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_dns_resolver.test-dns-resolver", "name", TEST_DNS_RESOLVER_NAME),
+					resource.TestCheckResourceAttr("bigip_net_dns_resolver.test-dns-resolver", "description", "test DNS resolver"),
+					resource.TestCheckResourceAttr("bigip_net_dns_resolver.test-dns-resolver", "cache_size", "1048576"),
+					resource.TestCheckResourceAttr("bigip_net_dns_resolver.test-dns-resolver", "use_ipv6", "no"),
+					resource.TestCheckResourceAttr("bigip_net_dns_resolver.test-dns-resolver", "forward_zone.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetDnsResolver_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetDnsResolverDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DNS_RESOLVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetDnsResolverExists(TEST_DNS_RESOLVER_NAME, true),
+				),
+				ResourceName:      TEST_DNS_RESOLVER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetDnsResolverExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p dnsResolver
+		ok, err := getForEntity(client, &p, "net", uriDnsResolver, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("DNS resolver %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("DNS resolver %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetDnsResolverDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_dns_resolver" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p dnsResolver
+		ok, err := getForEntity(client, &p, "net", uriDnsResolver, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("DNS resolver %s not destroyed.", name)
+		}
+	}
+	return nil
+}
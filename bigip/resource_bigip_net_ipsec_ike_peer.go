@@ -0,0 +1,339 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriIkePeer = "ike-peer"
+
+type ikePeer struct {
+	Name                        string   `json:"name,omitempty"`
+	Description                 string   `json:"description,omitempty"`
+	RemoteAddress               string   `json:"remoteAddress,omitempty"`
+	State                       string   `json:"state,omitempty"`
+	Version                     []string `json:"version,omitempty"`
+	Mode                        string   `json:"mode,omitempty"`
+	Passive                     string   `json:"passive,omitempty"`
+	MyIdType                    string   `json:"myIdType,omitempty"`
+	MyIdValue                   string   `json:"myIdValue,omitempty"`
+	PeersIdType                 string   `json:"peersIdType,omitempty"`
+	PeersIdValue                string   `json:"peersIdValue,omitempty"`
+	Phase1AuthMethod            string   `json:"phase1AuthMethod,omitempty"`
+	Phase1Cert                  string   `json:"phase1Cert,omitempty"`
+	Phase1Key                   string   `json:"phase1Key,omitempty"`
+	PresharedKey                string   `json:"presharedKey,omitempty"`
+	Phase1EncryptAlgorithm      string   `json:"phase1EncryptAlgorithm,omitempty"`
+	Phase1HashAlgorithm         string   `json:"phase1HashAlgorithm,omitempty"`
+	Phase1PerfectForwardSecrecy string   `json:"phase1PerfectForwardSecrecy,omitempty"`
+	Prf                         string   `json:"prf,omitempty"`
+	Lifetime                    int      `json:"lifetime,omitempty"`
+	NatTraversal                string   `json:"natTraversal,omitempty"`
+	DpdDelay                    int      `json:"dpdDelay,omitempty"`
+	GeneratePolicy              string   `json:"generatePolicy,omitempty"`
+	TrafficSelector             []string `json:"trafficSelector,omitempty"`
+}
+
+// bigip_net_ipsec_ike_peer manages the IKE phase 1 settings of a remote IPsec peer
+func resourceBigipNetIpsecIkePeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetIpsecIkePeerCreate,
+		Update: resourceBigipNetIpsecIkePeerUpdate,
+		Read:   resourceBigipNetIpsecIkePeerRead,
+		Delete: resourceBigipNetIpsecIkePeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the IKE peer",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"remote_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Address of the remote peer",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether the peer is enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"version": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateStringValue([]string{"v1", "v2"})},
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    2,
+				Description: "IKE versions the peer negotiates, v1 and/or v2",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "main",
+				Description:  "IKEv1 phase 1 exchange mode, main or aggressive",
+				ValidateFunc: validateStringValue([]string{"main", "aggressive"}),
+			},
+			"passive": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "false",
+				Description:  "Whether the BIG-IP only responds to the peer, never initiating the negotiation",
+				ValidateFunc: validateStringValue([]string{"true", "false"}),
+			},
+			"my_id_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "address",
+				Description:  "Type of the identifier the BIG-IP sends, address, asn1dn, fqdn, keyid-tag or user-fqdn",
+				ValidateFunc: validateStringValue([]string{"address", "asn1dn", "fqdn", "keyid-tag", "user-fqdn"}),
+			},
+			"my_id_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier the BIG-IP sends",
+			},
+			"peers_id_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "address",
+				Description:  "Type of the identifier expected from the peer, address, asn1dn, fqdn, keyid-tag or user-fqdn",
+				ValidateFunc: validateStringValue([]string{"address", "asn1dn", "fqdn", "keyid-tag", "user-fqdn"}),
+			},
+			"peers_id_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier expected from the peer",
+			},
+			"auth_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rsa-signature",
+				Description:  "IKE phase 1 authentication method, pre-shared-key or rsa-signature",
+				ValidateFunc: validateStringValue([]string{"pre-shared-key", "rsa-signature"}),
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Full path of the certificate of the rsa-signature authentication",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Full path of the key of the rsa-signature authentication",
+			},
+			"preshared_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Key of the pre-shared-key authentication. It is only written, changes made on the BIG-IP are not detected",
+			},
+			"encrypt_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "3des",
+				Description:  "IKE phase 1 encryption algorithm",
+				ValidateFunc: validateStringValue([]string{"3des", "des", "blowfish", "cast128", "aes128", "aes192", "aes256", "camellia"}),
+			},
+			"hash_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha1",
+				Description:  "IKE phase 1 hash algorithm",
+				ValidateFunc: validateStringValue([]string{"md5", "sha1", "sha256", "sha384", "sha512"}),
+			},
+			"perfect_forward_secrecy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "modp1024",
+				Description:  "Diffie-Hellman group of IKE phase 1",
+				ValidateFunc: validateStringValue(ipsecDhGroups),
+			},
+			"prf": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha256",
+				Description:  "IKEv2 pseudo-random function",
+				ValidateFunc: validateStringValue([]string{"sha1", "sha256", "sha384", "sha512"}),
+			},
+			"lifetime": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1440,
+				Description: "Minutes before the IKE security association is renegotiated",
+			},
+			"nat_traversal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "off",
+				Description:  "NAT traversal, on, off or force",
+				ValidateFunc: validateStringValue([]string{"on", "off", "force"}),
+			},
+			"dpd_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Seconds between dead peer detection messages, 0 to disable them",
+			},
+			"generate_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "off",
+				Description:  "Whether IKEv1 policies proposed by the peer are generated, off, on or unique",
+				ValidateFunc: validateStringValue([]string{"off", "on", "unique"}),
+			},
+			"traffic_selectors": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateF5Name},
+				Optional:    true,
+				Description: "Full paths of the traffic selectors of the IKEv2 peer",
+			},
+		},
+	}
+}
+
+func resourceBigipNetIpsecIkePeerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating IKE peer " + name)
+
+	p := getNetIpsecIkePeerConfig(d)
+	p.Name = name
+	p.PresharedKey = d.Get("preshared_key").(string)
+	err := postEntity(client, p, "net", uriIpsec, uriIkePeer)
+	if err != nil {
+		return fmt.Errorf("Error creating IKE peer (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetIpsecIkePeerRead)
+}
+
+func resourceBigipNetIpsecIkePeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p := getNetIpsecIkePeerConfig(d)
+	if d.HasChange("preshared_key") {
+		log.Println("[INFO] Changing the pre-shared key of IKE peer " + name)
+		p.PresharedKey = d.Get("preshared_key").(string)
+	}
+	err := patchEntity(client, p, "net", uriIpsec, uriIkePeer, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying IKE peer (%s): %s", name, err)
+	}
+	return resourceBigipNetIpsecIkePeerRead(d, meta)
+}
+
+func resourceBigipNetIpsecIkePeerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p ikePeer
+	ok, err := getForEntity(client, &p, "net", uriIpsec, uriIkePeer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve IKE peer (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] IKE peer (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("remote_address", p.RemoteAddress)
+	d.Set("state", p.State)
+	if err := d.Set("version", p.Version); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Version to state for IKE peer (%s): %s", name, err)
+	}
+	d.Set("mode", p.Mode)
+	d.Set("passive", p.Passive)
+	d.Set("my_id_type", p.MyIdType)
+	d.Set("my_id_value", p.MyIdValue)
+	d.Set("peers_id_type", p.PeersIdType)
+	d.Set("peers_id_value", p.PeersIdValue)
+	d.Set("auth_method", p.Phase1AuthMethod)
+	d.Set("cert", p.Phase1Cert)
+	d.Set("key", p.Phase1Key)
+	d.Set("encrypt_algorithm", p.Phase1EncryptAlgorithm)
+	d.Set("hash_algorithm", p.Phase1HashAlgorithm)
+	d.Set("perfect_forward_secrecy", p.Phase1PerfectForwardSecrecy)
+	d.Set("prf", p.Prf)
+	d.Set("lifetime", p.Lifetime)
+	d.Set("nat_traversal", p.NatTraversal)
+	d.Set("dpd_delay", p.DpdDelay)
+	d.Set("generate_policy", p.GeneratePolicy)
+	if err := d.Set("traffic_selectors", p.TrafficSelector); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving TrafficSelectors to state for IKE peer (%s): %s", name, err)
+	}
+	// The pre-shared key is only returned encrypted, the one of the configuration is kept
+	return nil
+}
+
+func resourceBigipNetIpsecIkePeerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting IKE peer " + name)
+
+	err := deleteEntity(client, "net", uriIpsec, uriIkePeer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IKE peer (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetIpsecIkePeerConfig(d *schema.ResourceData) *ikePeer {
+	return &ikePeer{
+		Description:                 d.Get("description").(string),
+		RemoteAddress:               d.Get("remote_address").(string),
+		State:                       d.Get("state").(string),
+		Version:                     listToStringSlice(d.Get("version").([]interface{})),
+		Mode:                        d.Get("mode").(string),
+		Passive:                     d.Get("passive").(string),
+		MyIdType:                    d.Get("my_id_type").(string),
+		MyIdValue:                   d.Get("my_id_value").(string),
+		PeersIdType:                 d.Get("peers_id_type").(string),
+		PeersIdValue:                d.Get("peers_id_value").(string),
+		Phase1AuthMethod:            d.Get("auth_method").(string),
+		Phase1Cert:                  d.Get("cert").(string),
+		Phase1Key:                   d.Get("key").(string),
+		Phase1EncryptAlgorithm:      d.Get("encrypt_algorithm").(string),
+		Phase1HashAlgorithm:         d.Get("hash_algorithm").(string),
+		Phase1PerfectForwardSecrecy: d.Get("perfect_forward_secrecy").(string),
+		Prf:                         d.Get("prf").(string),
+		Lifetime:                    d.Get("lifetime").(int),
+		NatTraversal:                d.Get("nat_traversal").(string),
+		DpdDelay:                    d.Get("dpd_delay").(int),
+		GeneratePolicy:              d.Get("generate_policy").(string),
+		TrafficSelector:             listToStringSlice(d.Get("traffic_selectors").([]interface{})),
+	}
+}
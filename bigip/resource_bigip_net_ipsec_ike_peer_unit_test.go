@@ -0,0 +1,109 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipNetIpsecIkePeer(url string, dpdDelay int) string {
+	return fmt.Sprintf(`
+		resource "bigip_net_ipsec_ike_peer" "test-peer" {
+			name = "/Common/test-peer"
+			remote_address = "192.0.2.10"
+			version = ["v2"]
+			auth_method = "pre-shared-key"
+			preshared_key = "s3cret"
+			encrypt_algorithm = "aes256"
+			hash_algorithm = "sha256"
+			perfect_forward_secrecy = "modp2048"
+			dpd_delay = %d
+			traffic_selectors = ["/Common/test-traffic-selector"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, dpdDelay, url)
+}
+
+func TestAccBigipNetIpsecIkePeerCreate(t *testing.T) {
+	var bodies []map[string]interface{}
+	var peer map[string]interface{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/net/ipsec/ike-peer", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &peer)
+		bodies = append(bodies, peer)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/ipsec/ike-peer/~Common~test-peer", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			peer = nil
+			return
+		case "PATCH":
+			var patch map[string]interface{}
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &patch)
+			bodies = append(bodies, patch)
+			for k, v := range patch {
+				peer[k] = v
+			}
+		}
+		if peer == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		// The pre-shared key is only returned encrypted
+		read := map[string]interface{}{"presharedKeyEncrypted": "$M$xxxx"}
+		for k, v := range peer {
+			if k != "presharedKey" {
+				read[k] = v
+			}
+		}
+		json.NewEncoder(w).Encode(read)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipNetIpsecIkePeer(server.URL, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_net_ipsec_ike_peer.test-peer", "version.0", "v2"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_ike_peer.test-peer", "preshared_key", "s3cret"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_ike_peer.test-peer", "traffic_selectors.0", "/Common/test-traffic-selector"),
+				),
+			},
+			{
+				Config: testBigipNetIpsecIkePeer(server.URL, 10),
+				Check:  resource.TestCheckResourceAttr("bigip_net_ipsec_ike_peer.test-peer", "dpd_delay", "10"),
+			},
+		},
+	})
+	assert.Nil(t, peer, "IKE peer was not deleted")
+	if assert.Len(t, bodies, 2) {
+		assert.Equal(t, "s3cret", bodies[0]["presharedKey"])
+		assert.Equal(t, []interface{}{"v2"}, bodies[0]["version"])
+		assert.NotContains(t, bodies[1], "presharedKey", "An unchanged pre-shared key is not sent again")
+	}
+}
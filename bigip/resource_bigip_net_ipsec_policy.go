@@ -0,0 +1,220 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	uriIpsec       = "ipsec"
+	uriIpsecPolicy = "ipsec-policy"
+)
+
+type ipsecPolicy struct {
+	Name                           string `json:"name,omitempty"`
+	Description                    string `json:"description,omitempty"`
+	Protocol                       string `json:"protocol,omitempty"`
+	Mode                           string `json:"mode,omitempty"`
+	TunnelLocalAddress             string `json:"tunnelLocalAddress,omitempty"`
+	TunnelRemoteAddress            string `json:"tunnelRemoteAddress,omitempty"`
+	IkePhase2AuthAlgorithm         string `json:"ikePhase2AuthAlgorithm,omitempty"`
+	IkePhase2EncryptAlgorithm      string `json:"ikePhase2EncryptAlgorithm,omitempty"`
+	IkePhase2PerfectForwardSecrecy string `json:"ikePhase2PerfectForwardSecrecy,omitempty"`
+	IkePhase2Lifetime              int    `json:"ikePhase2Lifetime,omitempty"`
+	IkePhase2LifetimeKilobytes     int    `json:"ikePhase2LifetimeKilobytes,omitempty"`
+	Ipcomp                         string `json:"ipcomp,omitempty"`
+}
+
+// Diffie-Hellman groups of IKE phase 1 and of the perfect forward secrecy of phase 2
+var ipsecDhGroups = []string{"modp768", "modp1024", "modp1536", "modp2048", "modp3072", "modp4096", "modp6144", "modp8192",
+	"ecp256", "ecp384", "ecp521"}
+
+// bigip_net_ipsec_policy manages the phase 2 security association settings of the IPsec traffic matched by
+// bigip_net_ipsec_traffic_selector
+func resourceBigipNetIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetIpsecPolicyCreate,
+		Update: resourceBigipNetIpsecPolicyUpdate,
+		Read:   resourceBigipNetIpsecPolicyRead,
+		Delete: resourceBigipNetIpsecPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the IPsec policy",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "esp",
+				Description:  "IPsec protocol, esp or ah",
+				ValidateFunc: validateStringValue([]string{"esp", "ah"}),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tunnel",
+				Description:  "Mode of the policy, transport, tunnel, interface or isession. In interface mode, the traffic is routed through an ipsec tunnel",
+				ValidateFunc: validateStringValue([]string{"transport", "tunnel", "interface", "isession"}),
+			},
+			"tunnel_local_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Local endpoint of a tunnel mode policy",
+			},
+			"tunnel_remote_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Remote endpoint of a tunnel mode policy",
+			},
+			"auth_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha1",
+				Description:  "IKE phase 2 authentication algorithm",
+				ValidateFunc: validateStringValue([]string{"sha1", "sha256", "sha384", "sha512", "aes-gcm128", "aes-gcm192", "aes-gcm256", "aes-gmac128", "aes-gmac192", "aes-gmac256"}),
+			},
+			"encrypt_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "aes128",
+				Description:  "IKE phase 2 encryption algorithm",
+				ValidateFunc: validateStringValue([]string{"null", "3des", "aes128", "aes192", "aes256", "aes-gmac128", "aes-gmac192", "aes-gmac256", "aes-gcm128", "aes-gcm192", "aes-gcm256"}),
+			},
+			"perfect_forward_secrecy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "modp1024",
+				Description:  "Diffie-Hellman group of the IKE phase 2 perfect forward secrecy, none to disable it",
+				ValidateFunc: validateStringValue(append([]string{"none"}, ipsecDhGroups...)),
+			},
+			"lifetime": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1440,
+				Description: "Minutes before the security association is renegotiated",
+			},
+			"lifetime_kilobytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Kilobytes before the security association is renegotiated, 0 for no limit",
+			},
+			"ipcomp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				Description:  "IP payload compression, none or deflate",
+				ValidateFunc: validateStringValue([]string{"none", "deflate"}),
+			},
+		},
+	}
+}
+
+func resourceBigipNetIpsecPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating IPsec policy " + name)
+
+	p := getNetIpsecPolicyConfig(d)
+	p.Name = name
+	err := postEntity(client, p, "net", uriIpsec, uriIpsecPolicy)
+	if err != nil {
+		return fmt.Errorf("Error creating IPsec policy (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetIpsecPolicyRead)
+}
+
+func resourceBigipNetIpsecPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getNetIpsecPolicyConfig(d), "net", uriIpsec, uriIpsecPolicy, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying IPsec policy (%s): %s", name, err)
+	}
+	return resourceBigipNetIpsecPolicyRead(d, meta)
+}
+
+func resourceBigipNetIpsecPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p ipsecPolicy
+	ok, err := getForEntity(client, &p, "net", uriIpsec, uriIpsecPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve IPsec policy (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] IPsec policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("protocol", p.Protocol)
+	d.Set("mode", p.Mode)
+	d.Set("tunnel_local_address", p.TunnelLocalAddress)
+	d.Set("tunnel_remote_address", p.TunnelRemoteAddress)
+	d.Set("auth_algorithm", p.IkePhase2AuthAlgorithm)
+	d.Set("encrypt_algorithm", p.IkePhase2EncryptAlgorithm)
+	d.Set("perfect_forward_secrecy", p.IkePhase2PerfectForwardSecrecy)
+	d.Set("lifetime", p.IkePhase2Lifetime)
+	d.Set("lifetime_kilobytes", p.IkePhase2LifetimeKilobytes)
+	d.Set("ipcomp", p.Ipcomp)
+	return nil
+}
+
+func resourceBigipNetIpsecPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting IPsec policy " + name)
+
+	err := deleteEntity(client, "net", uriIpsec, uriIpsecPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IPsec policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetIpsecPolicyConfig(d *schema.ResourceData) *ipsecPolicy {
+	return &ipsecPolicy{
+		Description:                    d.Get("description").(string),
+		Protocol:                       d.Get("protocol").(string),
+		Mode:                           d.Get("mode").(string),
+		TunnelLocalAddress:             d.Get("tunnel_local_address").(string),
+		TunnelRemoteAddress:            d.Get("tunnel_remote_address").(string),
+		IkePhase2AuthAlgorithm:         d.Get("auth_algorithm").(string),
+		IkePhase2EncryptAlgorithm:      d.Get("encrypt_algorithm").(string),
+		IkePhase2PerfectForwardSecrecy: d.Get("perfect_forward_secrecy").(string),
+		IkePhase2Lifetime:              d.Get("lifetime").(int),
+		IkePhase2LifetimeKilobytes:     d.Get("lifetime_kilobytes").(int),
+		Ipcomp:                         d.Get("ipcomp").(string),
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_IPSEC_POLICY_NAME = fmt.Sprintf("/%s/test-ipsec-policy", TEST_PARTITION)
+
+var TEST_IPSEC_POLICY_RESOURCE = `
+resource "bigip_net_ipsec_policy" "test-ipsec-policy" {
+	name = "` + TEST_IPSEC_POLICY_NAME + `"
+	mode = "tunnel"
+	tunnel_local_address = "10.10.1.1"
+	tunnel_remote_address = "192.0.2.10"
+	auth_algorithm = "sha256"
+	encrypt_algorithm = "aes256"
+	perfect_forward_secrecy = "modp2048"
+	lifetime = 480
+}
+`
+
+func TestAccBigipNetIpsecPolicy_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetIpsecPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IPSEC_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetIpsecPolicyExists(TEST_IPSEC_POLICY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "name", TEST_IPSEC_POLICY_NAME),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "mode", "tunnel"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "tunnel_local_address", "10.10.1.1"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "tunnel_remote_address", "192.0.2.10"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "auth_algorithm", "sha256"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "encrypt_algorithm", "aes256"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "perfect_forward_secrecy", "modp2048"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_policy.test-ipsec-policy", "lifetime", "480"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetIpsecPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetIpsecPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IPSEC_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetIpsecPolicyExists(TEST_IPSEC_POLICY_NAME, true),
+				),
+				ResourceName:      TEST_IPSEC_POLICY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetIpsecPolicyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p ipsecPolicy
+		ok, err := getForEntity(client, &p, "net", uriIpsec, uriIpsecPolicy, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("IPsec policy %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("IPsec policy %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetIpsecPolicyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_ipsec_policy" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p ipsecPolicy
+		ok, err := getForEntity(client, &p, "net", uriIpsec, uriIpsecPolicy, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("IPsec policy %s not destroyed.", name)
+		}
+	}
+	return nil
+}
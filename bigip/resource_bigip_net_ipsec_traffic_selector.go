@@ -0,0 +1,197 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriTrafficSelector = "traffic-selector"
+
+type ipsecTrafficSelector struct {
+	Name               string `json:"name,omitempty"`
+	Description        string `json:"description,omitempty"`
+	SourceAddress      string `json:"sourceAddress,omitempty"`
+	SourcePort         int    `json:"sourcePort"`
+	DestinationAddress string `json:"destinationAddress,omitempty"`
+	DestinationPort    int    `json:"destinationPort"`
+	IpProtocol         int    `json:"ipProtocol,omitempty"`
+	Direction          string `json:"direction,omitempty"`
+	IpsecPolicy        string `json:"ipsecPolicy,omitempty"`
+	Order              int    `json:"order,omitempty"`
+}
+
+// bigip_net_ipsec_traffic_selector selects the traffic protected by an IPsec policy
+func resourceBigipNetIpsecTrafficSelector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetIpsecTrafficSelectorCreate,
+		Update: resourceBigipNetIpsecTrafficSelectorUpdate,
+		Read:   resourceBigipNetIpsecTrafficSelectorRead,
+		Delete: resourceBigipNetIpsecTrafficSelectorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the traffic selector",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"source_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Source network of the selected traffic, e.g. 10.1.0.0/16",
+				ValidateFunc: validateAddressWithPrefix,
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Source port of the selected traffic, 0 for any",
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"destination_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Destination network of the selected traffic, e.g. 10.2.0.0/16",
+				ValidateFunc: validateAddressWithPrefix,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Destination port of the selected traffic, 0 for any",
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"ip_protocol": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      255,
+				Description:  "IP protocol number of the selected traffic, 255 for any",
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "both",
+				Description:  "Direction of the selected traffic, both, in or out",
+				ValidateFunc: validateStringValue([]string{"both", "in", "out"}),
+			},
+			"ipsec_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the IPsec policy protecting the selected traffic",
+				ValidateFunc: validateF5Name,
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Order the traffic selectors are evaluated in, lowest first",
+			},
+		},
+	}
+}
+
+func resourceBigipNetIpsecTrafficSelectorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating IPsec traffic selector " + name)
+
+	t := getNetIpsecTrafficSelectorConfig(d)
+	if err := checkReferenceExists(client, "IPsec traffic selector", "IPsec policy", t.IpsecPolicy, "net", uriIpsec, uriIpsecPolicy, t.IpsecPolicy); err != nil {
+		return err
+	}
+	t.Name = name
+	err := postEntity(client, t, "net", uriIpsec, uriTrafficSelector)
+	if err != nil {
+		return fmt.Errorf("Error creating IPsec traffic selector (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetIpsecTrafficSelectorRead)
+}
+
+func resourceBigipNetIpsecTrafficSelectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getNetIpsecTrafficSelectorConfig(d), "net", uriIpsec, uriTrafficSelector, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying IPsec traffic selector (%s): %s", name, err)
+	}
+	return resourceBigipNetIpsecTrafficSelectorRead(d, meta)
+}
+
+func resourceBigipNetIpsecTrafficSelectorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var t ipsecTrafficSelector
+	ok, err := getForEntity(client, &t, "net", uriIpsec, uriTrafficSelector, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve IPsec traffic selector (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] IPsec traffic selector (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", t.Description)
+	d.Set("source_address", t.SourceAddress)
+	d.Set("source_port", t.SourcePort)
+	d.Set("destination_address", t.DestinationAddress)
+	d.Set("destination_port", t.DestinationPort)
+	d.Set("ip_protocol", t.IpProtocol)
+	d.Set("direction", t.Direction)
+	d.Set("ipsec_policy", t.IpsecPolicy)
+	d.Set("order", t.Order)
+	return nil
+}
+
+func resourceBigipNetIpsecTrafficSelectorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting IPsec traffic selector " + name)
+
+	err := deleteEntity(client, "net", uriIpsec, uriTrafficSelector, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IPsec traffic selector (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetIpsecTrafficSelectorConfig(d *schema.ResourceData) *ipsecTrafficSelector {
+	return &ipsecTrafficSelector{
+		Description:        d.Get("description").(string),
+		SourceAddress:      d.Get("source_address").(string),
+		SourcePort:         d.Get("source_port").(int),
+		DestinationAddress: d.Get("destination_address").(string),
+		DestinationPort:    d.Get("destination_port").(int),
+		IpProtocol:         d.Get("ip_protocol").(int),
+		Direction:          d.Get("direction").(string),
+		IpsecPolicy:        d.Get("ipsec_policy").(string),
+		Order:              d.Get("order").(int),
+	}
+}
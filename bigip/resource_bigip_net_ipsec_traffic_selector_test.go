@@ -0,0 +1,116 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_IPSEC_TRAFFIC_SELECTOR_NAME = fmt.Sprintf("/%s/test-traffic-selector", TEST_PARTITION)
+
+var TEST_IPSEC_TRAFFIC_SELECTOR_RESOURCE = `
+resource "bigip_net_ipsec_policy" "test-ts-policy" {
+	name = "/Common/test-ts-policy"
+	tunnel_local_address = "10.10.1.1"
+	tunnel_remote_address = "192.0.2.10"
+}
+
+resource "bigip_net_ipsec_traffic_selector" "test-traffic-selector" {
+	name = "` + TEST_IPSEC_TRAFFIC_SELECTOR_NAME + `"
+	source_address = "10.1.0.0/16"
+	destination_address = "10.2.0.0/16"
+	ipsec_policy = "${bigip_net_ipsec_policy.test-ts-policy.name}"
+	direction = "both"
+}
+`
+
+func TestAccBigipNetIpsecTrafficSelector_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetIpsecTrafficSelectorDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IPSEC_TRAFFIC_SELECTOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetIpsecTrafficSelectorExists(TEST_IPSEC_TRAFFIC_SELECTOR_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_traffic_selector.test-traffic-selector", "name", TEST_IPSEC_TRAFFIC_SELECTOR_NAME),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_traffic_selector.test-traffic-selector", "source_address", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_traffic_selector.test-traffic-selector", "destination_address", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_traffic_selector.test-traffic-selector", "direction", "both"),
+					resource.TestCheckResourceAttr("bigip_net_ipsec_traffic_selector.test-traffic-selector", "ipsec_policy", "/Common/test-ts-policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetIpsecTrafficSelector_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetIpsecTrafficSelectorDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IPSEC_TRAFFIC_SELECTOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetIpsecTrafficSelectorExists(TEST_IPSEC_TRAFFIC_SELECTOR_NAME, true),
+				),
+				ResourceName:      TEST_IPSEC_TRAFFIC_SELECTOR_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetIpsecTrafficSelectorExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p ipsecTrafficSelector
+		ok, err := getForEntity(client, &p, "net", uriIpsec, uriTrafficSelector, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("IPsec traffic selector %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("IPsec traffic selector %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetIpsecTrafficSelectorDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_ipsec_traffic_selector" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p ipsecTrafficSelector
+		ok, err := getForEntity(client, &p, "net", uriIpsec, uriTrafficSelector, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("IPsec traffic selector %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriNdp = "ndp"
+
+func resourceBigipNetNdp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetNdpCreate,
+		Update: resourceBigipNetNdpUpdate,
+		Read:   resourceBigipNetNdpRead,
+		Delete: resourceBigipNetNdpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the IPv6 neighbor entry",
+				ValidateFunc: validateF5Name,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IPv6 address of the entry",
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "MAC address the IPv6 address resolves to",
+				ValidateFunc:     validateMACAddress,
+				DiffSuppressFunc: suppressEquivalentMAC,
+			},
+		},
+	}
+}
+
+func resourceBigipNetNdpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating IPv6 neighbor entry " + name)
+
+	err := postEntity(client, &netNeighbor{
+		Name:       name,
+		IPAddress:  d.Get("ip_address").(string),
+		MACAddress: d.Get("mac_address").(string),
+	}, "net", uriNdp)
+	if err != nil {
+		return fmt.Errorf("Error creating IPv6 neighbor entry (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetNdpRead)
+}
+
+func resourceBigipNetNdpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, &netNeighbor{MACAddress: d.Get("mac_address").(string)}, "net", uriNdp, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying IPv6 neighbor entry (%s): %s", name, err)
+	}
+	return resourceBigipNetNdpRead(d, meta)
+}
+
+func resourceBigipNetNdpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var ndp netNeighbor
+	ok, err := getForEntity(client, &ndp, "net", uriNdp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve IPv6 neighbor entry (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] IPv6 neighbor entry (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("ip_address", ndp.IPAddress)
+	d.Set("mac_address", ndp.MACAddress)
+	return nil
+}
+
+func resourceBigipNetNdpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting IPv6 neighbor entry " + name)
+
+	err := deleteEntity(client, "net", uriNdp, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IPv6 neighbor entry (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
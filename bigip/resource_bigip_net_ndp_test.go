@@ -0,0 +1,105 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_NDP_NAME = fmt.Sprintf("/%s/test-ndp", TEST_PARTITION)
+
+var TEST_NDP_RESOURCE = `
+resource "bigip_net_ndp" "test-ndp" {
+	name = "` + TEST_NDP_NAME + `"
+	ip_address = "2001:db8::50"
+	mac_address = "00:50:56:8a:12:35"
+}
+`
+
+func TestAccBigipNetNdp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetNdpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_NDP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetNdpExists(TEST_NDP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_ndp.test-ndp", "name", TEST_NDP_NAME),
+					resource.TestCheckResourceAttr("bigip_net_ndp.test-ndp", "ip_address", "2001:db8::50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetNdp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetNdpDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_NDP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetNdpExists(TEST_NDP_NAME, true),
+				),
+				ResourceName:      TEST_NDP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetNdpExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p netNeighbor
+		ok, err := getForEntity(client, &p, "net", uriNdp, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("IPv6 neighbor entry %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("IPv6 neighbor entry %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetNdpDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_ndp" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p netNeighbor
+		ok, err := getForEntity(client, &p, "net", uriNdp, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("IPv6 neighbor entry %s not destroyed.", name)
+		}
+	}
+	return nil
+}
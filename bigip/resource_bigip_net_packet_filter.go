@@ -0,0 +1,182 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriPacketFilter = "packet-filter"
+
+type netPacketFilter struct {
+	Name        string `json:"name,omitempty"`
+	Order       int    `json:"order"`
+	Action      string `json:"action,omitempty"`
+	Vlan        string `json:"vlan,omitempty"`
+	Rule        string `json:"rule"`
+	Logging     string `json:"logging,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func resourceBigipNetPacketFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetPacketFilterCreate,
+		Update: resourceBigipNetPacketFilterUpdate,
+		Read:   resourceBigipNetPacketFilterRead,
+		Delete: resourceBigipNetPacketFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the packet filter rule",
+				ValidateFunc: validateF5Name,
+			},
+			"order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Position of the rule, rules are evaluated from the lowest order up",
+				ValidateFunc: validation.IntBetween(0, 999999),
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "What is done with a packet matching the rule",
+				ValidateFunc: validateStringValue([]string{"accept", "discard", "reject", "continue"}),
+			},
+			"vlan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the VLAN the rule applies to, all VLANs if not set",
+				ValidateFunc: validateF5Name,
+			},
+			"expression": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "tcpdump style filter expression packets are matched against, all packets if not set",
+			},
+			"logging": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether packets matching the rule are logged",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipNetPacketFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating packet filter rule " + name)
+
+	f := getNetPacketFilterConfig(d)
+	if f.Vlan != "" {
+		if err := checkReferenceExists(client, "Packet filter rule "+name, "VLAN", f.Vlan, "net", "vlan", f.Vlan); err != nil {
+			return err
+		}
+	}
+	f.Name = name
+	err := postEntity(client, f, "net", uriPacketFilter)
+	if err != nil {
+		return fmt.Errorf("Error creating packet filter rule (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetPacketFilterRead)
+}
+
+func resourceBigipNetPacketFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	f := getNetPacketFilterConfig(d)
+	if f.Vlan != "" && d.HasChange("vlan") {
+		if err := checkReferenceExists(client, "Packet filter rule "+name, "VLAN", f.Vlan, "net", "vlan", f.Vlan); err != nil {
+			return err
+		}
+	}
+	if f.Vlan == "" {
+		// An empty vlan is omitted, "none" removes the one set
+		f.Vlan = "none"
+	}
+	err := patchEntity(client, f, "net", uriPacketFilter, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying packet filter rule (%s): %s", name, err)
+	}
+	return resourceBigipNetPacketFilterRead(d, meta)
+}
+
+func resourceBigipNetPacketFilterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var f netPacketFilter
+	ok, err := getForEntity(client, &f, "net", uriPacketFilter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve packet filter rule (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Packet filter rule (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	if f.Vlan == "none" {
+		f.Vlan = ""
+	}
+	d.Set("name", name)
+	d.Set("order", f.Order)
+	d.Set("action", f.Action)
+	d.Set("vlan", f.Vlan)
+	d.Set("expression", f.Rule)
+	d.Set("logging", f.Logging)
+	d.Set("description", f.Description)
+	return nil
+}
+
+func resourceBigipNetPacketFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting packet filter rule " + name)
+
+	err := deleteEntity(client, "net", uriPacketFilter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete packet filter rule (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetPacketFilterConfig(d *schema.ResourceData) *netPacketFilter {
+	return &netPacketFilter{
+		Order:       d.Get("order").(int),
+		Action:      d.Get("action").(string),
+		Vlan:        d.Get("vlan").(string),
+		Rule:        d.Get("expression").(string),
+		Logging:     d.Get("logging").(string),
+		Description: d.Get("description").(string),
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_PACKET_FILTER_NAME = fmt.Sprintf("/%s/test-packet-filter", TEST_PARTITION)
+
+var TEST_PACKET_FILTER_RESOURCE = `
+resource "bigip_net_packet_filter" "test-packet-filter" {
+	name = "` + TEST_PACKET_FILTER_NAME + `"
+	order = 10
+	action = "discard"
+	expression = "tcp dst port 23"
+	logging = "enabled"
+	description = "test packet filter"
+}
+`
+
+func TestAccBigipNetPacketFilter_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetPacketFilterDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PACKET_FILTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetPacketFilterExists(TEST_PACKET_FILTER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "name", TEST_PACKET_FILTER_NAME),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "order", "10"),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "action", "discard"),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "expression", "tcp dst port 23"),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "logging", "enabled"),
+					resource.TestCheckResourceAttr("bigip_net_packet_filter.test-packet-filter", "description", "test packet filter"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetPacketFilter_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetPacketFilterDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PACKET_FILTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetPacketFilterExists(TEST_PACKET_FILTER_NAME, true),
+				),
+				ResourceName:      TEST_PACKET_FILTER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetPacketFilterExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p netPacketFilter
+		ok, err := getForEntity(client, &p, "net", uriPacketFilter, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("packet filter rule %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("packet filter rule %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetPacketFilterDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_packet_filter" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p netPacketFilter
+		ok, err := getForEntity(client, &p, "net", uriPacketFilter, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("packet filter rule %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -9,12 +9,20 @@ package bigip
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+type netRoute struct {
+	Name        string `json:"name,omitempty"`
+	Network     string `json:"network,omitempty"`
+	Gateway     string `json:"gw,omitempty"`
+	Pool        string `json:"pool,omitempty"`
+	TmInterface string `json:"tmInterface,omitempty"`
+	MTU         int    `json:"mtu,omitempty"`
+}
+
 func resourceBigipNetRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipNetRouteCreate,
@@ -29,19 +37,43 @@ func resourceBigipNetRoute() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Name of the route",
 			},
 
 			"network": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Destination network",
+				ForceNew:    true,
+				Description: "Destination network, e.g. 10.1.10.0/24 or default, 10.1.10.0%2/24 in route domain 2",
 			},
 
 			"gw": {
-				Type:        schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Gateway address",
+				ConflictsWith: []string{"pool", "interface"},
+			},
+
+			"pool": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Full path of the pool of gateways",
+				ConflictsWith: []string{"gw", "interface"},
+			},
+
+			"interface": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Full path of the VLAN or tunnel the network is reached through",
+				ConflictsWith: []string{"gw", "pool"},
+			},
+
+			"mtu": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Gateway address",
+				Computed:    true,
+				Description: "Maximum transmission unit of the route, 0 to use the one of the VLAN",
 			},
 		},
 	}
@@ -52,23 +84,22 @@ func resourceBigipNetRouteCreate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*bigip.BigIP)
 
 	name := d.Get("name").(string)
-	network := d.Get("network").(string)
-	gw := d.Get("gw").(string)
 
 	log.Println("[INFO] Creating Route")
 
-	err := client.CreateRoute(
-		name,
-		network,
-		gw,
-	)
-
+	r := getNetRouteConfig(d)
+	if err := checkNetRouteNextHop(client, name, r); err != nil {
+		return err
+	}
+	r.Name = name
+	r.Network = d.Get("network").(string)
+	err := postEntity(client, r, "net", "route")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create Route  (%s) (%v)", name, err)
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipNetRouteRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipNetRouteRead)
 }
 
 func resourceBigipNetRouteUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -78,14 +109,14 @@ func resourceBigipNetRouteUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Updating Route " + name)
 
-	r := &bigip.Route{
-		Name:    name,
-		Network: d.Get("network").(string),
+	r := getNetRouteConfig(d)
+	if err := checkNetRouteNextHop(client, name, r); err != nil {
+		return err
 	}
-
-	err := client.ModifyRoute(name, r)
+	// Setting one next hop replaces the previous one, whichever it was
+	err := patchEntity(client, r, "net", "route", name)
 	if err != nil {
-		log.Printf("[ERROR] Unable to Retrieve Route  (%s) (%v)", name, err)
+		log.Printf("[ERROR] Unable to Modify Route  (%s) (%v)", name, err)
 		return err
 	}
 	return resourceBigipNetRouteRead(d, meta)
@@ -94,31 +125,29 @@ func resourceBigipNetRouteUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceBigipNetRouteRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	obj, err := client.GetRoute(name)
+	var obj netRoute
+	ok, err := getForEntity(client, &obj, "net", "route", name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Route  (%s) (%v)", name, err)
 		return err
 	}
-	if obj == nil {
+	if !ok {
 		log.Printf("[WARN] Route (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 	d.Set("name", name)
 
-	regex := regexp.MustCompile(`(default|(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]{1,2})(?:\%\d+)?`)
-	network := regex.FindStringSubmatch(obj.Network)
-
-	regex = regexp.MustCompile(`((?:[0-9]{1,3}\.){3}[0-9]{1,3})(?:\%\d+)?`)
-	gw := regex.FindStringSubmatch(obj.Gateway)
-
-	if err := d.Set("network", network[1]); err != nil {
+	// Addresses in a route domain other than 0 carry its id, e.g. 10.1.10.0%2/24
+	if err := d.Set("network", obj.Network); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Network to state for Route (%s): %s", d.Id(), err)
 	}
-
-	if err := d.Set("gw", gw[1]); err != nil {
+	if err := d.Set("gw", obj.Gateway); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving Gateway to state for Route (%s): %s", d.Id(), err)
 	}
+	d.Set("pool", obj.Pool)
+	d.Set("interface", obj.TmInterface)
+	d.Set("mtu", obj.MTU)
 	return nil
 }
 
@@ -136,3 +165,25 @@ func resourceBigipNetRouteDelete(d *schema.ResourceData, meta interface{}) error
 	d.SetId("")
 	return nil
 }
+
+func getNetRouteConfig(d *schema.ResourceData) *netRoute {
+	return &netRoute{
+		Gateway:     d.Get("gw").(string),
+		Pool:        d.Get("pool").(string),
+		TmInterface: d.Get("interface").(string),
+		MTU:         d.Get("mtu").(int),
+	}
+}
+
+// checkNetRouteNextHop returns an error if a route has no next hop or the pool or interface it names does not exist
+func checkNetRouteNextHop(client *bigip.BigIP, name string, r *netRoute) error {
+	switch {
+	case r.Pool != "":
+		return checkReferenceExists(client, "Route "+name, "Pool", r.Pool, uriLtm, "pool", r.Pool)
+	case r.TmInterface != "":
+		return checkVlanOrTunnelExists(client, "Route "+name, "Interface", r.TmInterface)
+	case r.Gateway == "":
+		return fmt.Errorf("Route %s needs a next hop: one of gw, pool or interface", name)
+	}
+	return nil
+}
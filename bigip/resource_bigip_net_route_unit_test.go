@@ -0,0 +1,106 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipNetRouteNextHop(url, nextHop string) string {
+	return fmt.Sprintf(`
+		resource "bigip_net_route" "test-route" {
+			name = "/Common/test-route"
+			network = "10.1.10.0%%2/24"
+			%s
+			mtu = 1400
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, nextHop, url)
+}
+
+func TestAccBigipNetRouteInterface(t *testing.T) {
+	setup()
+	defer teardown()
+	var created map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/net/vlan/~Common~test-tunnel", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/tunnels/tunnel/~Common~test-tunnel", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-tunnel"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/route", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &created)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/route/~Common~test-route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-route","fullPath":"/Common/test-route","network":"10.1.10.0%%2/24","tmInterface":"/Common/test-tunnel","mtu":1400}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipNetRouteNextHop(server.URL, `interface = "/Common/test-tunnel"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_net_route.test-route", "network", "10.1.10.0%2/24"),
+					resource.TestCheckResourceAttr("bigip_net_route.test-route", "interface", "/Common/test-tunnel"),
+					resource.TestCheckResourceAttr("bigip_net_route.test-route", "mtu", "1400"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, "/Common/test-tunnel", created["tmInterface"])
+	assert.Nil(t, created["gw"])
+}
+
+func TestAccBigipNetRouteMissingPool(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/ltm/pool/~Common~no-pool", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipNetRouteNextHop(server.URL, `pool = "/Common/no-pool"`),
+				ExpectError: regexp.MustCompile("Pool /Common/no-pool of Route /Common/test-route does not exist"),
+			},
+			{
+				Config:      testBigipNetRouteNextHop(server.URL, ""),
+				ExpectError: regexp.MustCompile("Route /Common/test-route needs a next hop"),
+			},
+		},
+	})
+}
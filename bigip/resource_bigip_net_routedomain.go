@@ -0,0 +1,187 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriRouteDomain = "route-domain"
+
+type netRouteDomain struct {
+	Name        string   `json:"name,omitempty"`
+	ID          int      `json:"id,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Strict      string   `json:"strict,omitempty"`
+	Parent      string   `json:"parent,omitempty"`
+	Vlans       []string `json:"vlans"`
+}
+
+func resourceBigipNetRouteDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetRouteDomainCreate,
+		Update: resourceBigipNetRouteDomainUpdate,
+		Read:   resourceBigipNetRouteDomainRead,
+		Delete: resourceBigipNetRouteDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the route domain",
+				ValidateFunc: validateF5Name,
+			},
+			"route_domain_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Id of the route domain, used as %<id> in addresses",
+				ValidateFunc: validation.IntBetween(1, 65534),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"vlans": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Full paths of the VLANs of the route domain",
+			},
+			"strict": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether routes can only cross to the parent route domain, rather than to any route domain",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"parent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the route domain searched when no route matches in this one",
+			},
+		},
+	}
+}
+
+func resourceBigipNetRouteDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating Route Domain " + name)
+
+	rd := getNetRouteDomainConfig(d)
+	if err := checkNetRouteDomainReferences(client, name, rd); err != nil {
+		return err
+	}
+	rd.Name = name
+	rd.ID = d.Get("route_domain_id").(int)
+	err := postEntity(client, rd, "net", uriRouteDomain)
+	if err != nil {
+		return fmt.Errorf("Error creating Route Domain (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetRouteDomainRead)
+}
+
+func resourceBigipNetRouteDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating Route Domain " + name)
+
+	rd := getNetRouteDomainConfig(d)
+	if err := checkNetRouteDomainReferences(client, name, rd); err != nil {
+		return err
+	}
+	err := patchEntity(client, rd, "net", uriRouteDomain, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying Route Domain (%s): %s", name, err)
+	}
+	return resourceBigipNetRouteDomainRead(d, meta)
+}
+
+func resourceBigipNetRouteDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var rd netRouteDomain
+	ok, err := getForEntity(client, &rd, "net", uriRouteDomain, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Route Domain (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Route Domain (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("route_domain_id", rd.ID)
+	d.Set("description", rd.Description)
+	if err := d.Set("vlans", rd.Vlans); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Vlans to state for Route Domain (%s): %s", name, err)
+	}
+	d.Set("strict", rd.Strict)
+	if rd.Parent == "none" {
+		rd.Parent = ""
+	}
+	d.Set("parent", rd.Parent)
+	return nil
+}
+
+func resourceBigipNetRouteDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Route Domain " + name)
+
+	err := deleteEntity(client, "net", uriRouteDomain, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Route Domain (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetRouteDomainConfig(d *schema.ResourceData) *netRouteDomain {
+	rd := &netRouteDomain{
+		Description: d.Get("description").(string),
+		Strict:      d.Get("strict").(string),
+		Parent:      d.Get("parent").(string),
+		Vlans:       setToStringSlice(d.Get("vlans").(*schema.Set)),
+	}
+	if rd.Parent == "" {
+		rd.Parent = "none"
+	}
+	return rd
+}
+
+// checkNetRouteDomainReferences returns an error if a VLAN or the parent of a route domain does not exist
+func checkNetRouteDomainReferences(client *bigip.BigIP, name string, rd *netRouteDomain) error {
+	for _, vlan := range rd.Vlans {
+		if err := checkReferenceExists(client, "Route Domain "+name, "VLAN", vlan, "net", "vlan", vlan); err != nil {
+			return err
+		}
+	}
+	if rd.Parent != "none" {
+		return checkReferenceExists(client, "Route Domain "+name, "Parent route domain", rd.Parent, "net", uriRouteDomain, rd.Parent)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_ROUTEDOMAIN_NAME = fmt.Sprintf("/%s/test-routedomain", TEST_PARTITION)
+
+var TEST_ROUTEDOMAIN_RESOURCE = `
+resource "bigip_net_vlan" "test-rd-vlan" {
+	name = "/Common/test-rd-vlan"
+	tag = 102
+}
+resource "bigip_net_routedomain" "test-routedomain" {
+	name = "` + TEST_ROUTEDOMAIN_NAME + `"
+	route_domain_id = 12
+	description = "test route domain"
+	vlans = ["${bigip_net_vlan.test-rd-vlan.name}"]
+	strict = "enabled"
+}
+`
+
+func TestAccBigipNetRouteDomain_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetRouteDomainDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ROUTEDOMAIN_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetRouteDomainExists(TEST_ROUTEDOMAIN_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_routedomain.test-routedomain", "name", TEST_ROUTEDOMAIN_NAME),
+					resource.TestCheckResourceAttr("bigip_net_routedomain.test-routedomain", "route_domain_id", "12"),
+					resource.TestCheckResourceAttr("bigip_net_routedomain.test-routedomain", "description", "test route domain"),
+					resource.TestCheckResourceAttr("bigip_net_routedomain.test-routedomain", "strict", "enabled"),
+					resource.TestCheckResourceAttr("bigip_net_routedomain.test-routedomain", "vlans.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetRouteDomain_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetRouteDomainDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ROUTEDOMAIN_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetRouteDomainExists(TEST_ROUTEDOMAIN_NAME, true),
+				),
+				ResourceName:      TEST_ROUTEDOMAIN_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetRouteDomainExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p netRouteDomain
+		ok, err := getForEntity(client, &p, "net", uriRouteDomain, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("route domain %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("route domain %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetRouteDomainDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_routedomain" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p netRouteDomain
+		ok, err := getForEntity(client, &p, "net", uriRouteDomain, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("route domain %s not destroyed.", name)
+		}
+	}
+	return nil
+}
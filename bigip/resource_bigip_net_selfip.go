@@ -10,11 +10,22 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+type netSelfIP struct {
+	Name         string      `json:"name,omitempty"`
+	Address      string      `json:"address,omitempty"`
+	Vlan         string      `json:"vlan,omitempty"`
+	TrafficGroup string      `json:"trafficGroup,omitempty"`
+	FullPath     string      `json:"fullPath,omitempty"`
+	AllowService interface{} `json:"allowService,omitempty"`
+}
+
 func resourceBigipNetSelfIP() *schema.Resource {
 
 	return &schema.Resource{
@@ -42,17 +53,26 @@ func resourceBigipNetSelfIP() *schema.Resource {
 			},
 
 			"vlan": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the vlan",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the vlan, or of a tunnel",
+				ValidateFunc: validateF5Name,
 			},
 
 			"traffic_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Name of the traffic group, defaults to traffic-group-local-only if not specified",
+				Description: "Name of the traffic group, defaults to traffic-group-local-only if not specified, a floating SelfIP fails over with its traffic group",
 				Default:     "traffic-group-local-only",
 			},
+
+			"port_lockdown": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validatePortLockdown},
+				Optional:    true,
+				Description: "Services the SelfIP accepts traffic for: all, default or <protocol>:<port> entries, none when empty",
+			},
 		},
 	}
 }
@@ -61,20 +81,28 @@ func resourceBigipNetSelfIPCreate(d *schema.ResourceData, meta interface{}) erro
 	client := meta.(*bigip.BigIP)
 
 	name := d.Get("name").(string)
-	ip := d.Get("ip").(string)
-	vlan := d.Get("vlan").(string)
 
 	log.Printf("[DEBUG] Creating SelfIP %s", name)
 
-	err := client.CreateSelfIP(name, ip, vlan)
-
+	r, err := getNetSelfIPConfig(d)
+	if err != nil {
+		return err
+	}
+	if err := checkVlanOrTunnelExists(client, "SelfIP "+name, "VLAN", r.Vlan); err != nil {
+		return err
+	}
+	if err := checkReferenceExists(client, "SelfIP "+name, "Traffic group", r.TrafficGroup, "cm", uriTrafficGroup, r.TrafficGroup); err != nil {
+		return err
+	}
+	r.Name = name
+	r.Address = d.Get("ip").(string)
+	err = postEntity(client, r, "net", "self")
 	if err != nil {
 		return fmt.Errorf("Error creating SelfIP %s: %v", name, err)
 	}
 
 	d.SetId(name)
-
-	return resourceBigipNetSelfIPUpdate(d, meta)
+	return readAfterCreate(d, meta, resourceBigipNetSelfIPRead)
 }
 
 func resourceBigipNetSelfIPRead(d *schema.ResourceData, meta interface{}) error {
@@ -83,11 +111,12 @@ func resourceBigipNetSelfIPRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Reading SelfIP %s", name)
 
-	selfIP, err := client.SelfIP(name)
+	var selfIP netSelfIP
+	ok, err := getForEntity(client, &selfIP, "net", "self", name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving SelfIP %s: %v", name, err)
 	}
-	if selfIP == nil {
+	if !ok {
 		log.Printf("[DEBUG] SelfIP %s not found, removing from state", name)
 		d.SetId("")
 		return nil
@@ -98,14 +127,32 @@ func resourceBigipNetSelfIPRead(d *schema.ResourceData, meta interface{}) error
 
 	// Extract Self IP address from "(selfip_address)[%route_domain](/mask)" groups 1 + 2
 	regex := regexp.MustCompile(`((?:[0-9]{1,3}\.){3}[0-9]{1,3})(?:\%\d+)?(\/\d+)`)
-	selfipAddress := regex.FindStringSubmatch(selfIP.Address)
-	parsedSelfipAddress := selfipAddress[1] + selfipAddress[2]
-	d.Set("ip", parsedSelfipAddress)
+	if selfipAddress := regex.FindStringSubmatch(selfIP.Address); selfipAddress != nil {
+		d.Set("ip", selfipAddress[1]+selfipAddress[2])
+	} else {
+		d.Set("ip", selfIP.Address)
+	}
 
-	// Extract Traffic Group name from the full path (ignoring /Common/ prefix)
-	regex = regexp.MustCompile(`\/Common\/(.+)`)
-	trafficGroup := regex.FindStringSubmatch(selfIP.TrafficGroup)
-	d.Set("traffic_group", trafficGroup[1])
+	// The traffic group is configured without the /Common/ prefix, or by the full path of a bigip_cm_traffic_group
+	trafficGroup := strings.TrimPrefix(selfIP.TrafficGroup, "/Common/")
+	if configured := d.Get("traffic_group").(string); sameDevice(configured, trafficGroup) {
+		trafficGroup = configured
+	}
+	d.Set("traffic_group", trafficGroup)
+
+	// allowService is absent when no service is allowed, "all" or a list otherwise
+	var services []string
+	switch v := selfIP.AllowService.(type) {
+	case string:
+		services = []string{v}
+	case []interface{}:
+		for _, s := range v {
+			services = append(services, s.(string))
+		}
+	}
+	if err := d.Set("port_lockdown", services); err != nil {
+		return fmt.Errorf("Error updating PortLockdown in state for SelfIP %s: %v", name, err)
+	}
 
 	return nil
 }
@@ -117,14 +164,21 @@ func resourceBigipNetSelfIPUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Updating SelfIP %s", name)
 
-	r := &bigip.SelfIP{
-		Name:         name,
-		Address:      d.Get("ip").(string),
-		Vlan:         d.Get("vlan").(string),
-		TrafficGroup: d.Get("traffic_group").(string),
+	r, err := getNetSelfIPConfig(d)
+	if err != nil {
+		return err
 	}
-
-	err := client.ModifySelfIP(name, r)
+	if d.HasChange("vlan") {
+		if err := checkVlanOrTunnelExists(client, "SelfIP "+name, "VLAN", r.Vlan); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("traffic_group") {
+		if err := checkReferenceExists(client, "SelfIP "+name, "Traffic group", r.TrafficGroup, "cm", uriTrafficGroup, r.TrafficGroup); err != nil {
+			return err
+		}
+	}
+	err = patchEntity(client, r, "net", "self", name)
 	if err != nil {
 		return fmt.Errorf("Error modifying SelfIP %s: %v", name, err)
 	}
@@ -147,3 +201,24 @@ func resourceBigipNetSelfIPDelete(d *schema.ResourceData, meta interface{}) erro
 	d.SetId("")
 	return nil
 }
+
+func getNetSelfIPConfig(d *schema.ResourceData) (*netSelfIP, error) {
+	r := &netSelfIP{
+		Vlan:         d.Get("vlan").(string),
+		TrafficGroup: d.Get("traffic_group").(string),
+		AllowService: "none",
+	}
+	services := setToStringSlice(d.Get("port_lockdown").(*schema.Set))
+	sort.Strings(services)
+	for _, s := range services {
+		if s == "all" && len(services) > 1 {
+			return nil, fmt.Errorf("port_lockdown of SelfIP %s can not combine all with other services", d.Get("name").(string))
+		}
+	}
+	if len(services) == 1 && services[0] == "all" {
+		r.AllowService = "all"
+	} else if len(services) > 0 {
+		r.AllowService = services
+	}
+	return r, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipNetSelfIPCreate(url, vlan string) string {
+	return fmt.Sprintf(`
+		resource "bigip_net_selfip" "test-selfip" {
+			name = "/Common/test-selfip"
+			ip = "11.1.1.1/24"
+			vlan = "%s"
+			traffic_group = "traffic-group-1"
+			port_lockdown = ["tcp:22", "default"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, vlan, url)
+}
+
+func TestAccBigipNetSelfIPCreate(t *testing.T) {
+	setup()
+	defer teardown()
+	var created map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &created)
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/vlan/~Common~test-vlan", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"test-vlan"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/traffic-group/traffic-group-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name":"traffic-group-1"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/net/self/~Common~test-selfip", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = nil
+			return
+		}
+		if created == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-selfip","fullPath":"/Common/test-selfip","address":"11.1.1.1%%2/24",
+			"vlan":"/Common/test-vlan","trafficGroup":"/Common/traffic-group-1","allowService":["default","tcp:22"]}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipNetSelfIPCreate(server.URL, "/Common/test-vlan"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "ip", "11.1.1.1/24"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "traffic_group", "traffic-group-1"),
+					resource.TestCheckResourceAttr("bigip_net_selfip.test-selfip", "port_lockdown.#", "2"),
+					func(*terraform.State) error {
+						assert.Equal(t, "/Common/test-vlan", created["vlan"])
+						assert.Equal(t, []interface{}{"default", "tcp:22"}, created["allowService"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetSelfIPMissingVlan(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `{}`)
+	})
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	}
+	mux.HandleFunc("/mgmt/tm/net/vlan/~Common~no-vlan", notFound)
+	mux.HandleFunc("/mgmt/tm/net/tunnels/tunnel/~Common~no-vlan", notFound)
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipNetSelfIPCreate(server.URL, "/Common/no-vlan"),
+				ExpectError: regexp.MustCompile("VLAN /Common/no-vlan of SelfIP /Common/test-selfip does not exist"),
+			},
+		},
+	})
+}
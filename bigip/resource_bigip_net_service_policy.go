@@ -0,0 +1,133 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriServicePolicy = "service-policy"
+
+type servicePolicy struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	TimerPolicy string `json:"timerPolicy,omitempty"`
+}
+
+// bigip_net_service_policy groups the per-flow policies a virtual server applies through its service_policy,
+// e.g. a bigip_net_timer_policy overriding the idle timeout of its profiles
+func resourceBigipNetServicePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetServicePolicyCreate,
+		Update: resourceBigipNetServicePolicyUpdate,
+		Read:   resourceBigipNetServicePolicyRead,
+		Delete: resourceBigipNetServicePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the service policy",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"timer_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the timer policy setting the idle timeouts of the flows",
+			},
+		},
+	}
+}
+
+func resourceBigipNetServicePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating service policy " + name)
+
+	p := getNetServicePolicyConfig(d)
+	if p.TimerPolicy != "none" {
+		if err := checkReferenceExists(client, "service policy "+name, "timer policy", p.TimerPolicy, "net", uriTimerPolicy, p.TimerPolicy); err != nil {
+			return err
+		}
+	}
+	p.Name = name
+	err := postEntity(client, p, "net", uriServicePolicy)
+	if err != nil {
+		return fmt.Errorf("Error creating service policy (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetServicePolicyRead)
+}
+
+func resourceBigipNetServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getNetServicePolicyConfig(d), "net", uriServicePolicy, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying service policy (%s): %s", name, err)
+	}
+	return resourceBigipNetServicePolicyRead(d, meta)
+}
+
+func resourceBigipNetServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p servicePolicy
+	ok, err := getForEntity(client, &p, "net", uriServicePolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve service policy (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Service policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("timer_policy", noneToEmpty(p.TimerPolicy))
+	return nil
+}
+
+func resourceBigipNetServicePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting service policy " + name)
+
+	err := deleteEntity(client, "net", uriServicePolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete service policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetServicePolicyConfig(d *schema.ResourceData) *servicePolicy {
+	return &servicePolicy{
+		Description: d.Get("description").(string),
+		TimerPolicy: emptyToNone(d.Get("timer_policy").(string)),
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SERVICE_POLICY_NAME = fmt.Sprintf("/%s/test-service-policy", TEST_PARTITION)
+
+var TEST_SERVICE_POLICY_RESOURCE = `
+resource "bigip_net_timer_policy" "test-timer-policy" {
+	name = "/Common/test-sp-timer-policy"
+	rule {
+		name = "ssh"
+		ip_protocol = "tcp"
+		destination_ports = ["22"]
+		idle_timeout = "indefinite"
+	}
+}
+
+resource "bigip_net_service_policy" "test-service-policy" {
+	name = "` + TEST_SERVICE_POLICY_NAME + `"
+	description = "ssh idle timeout"
+	timer_policy = "${bigip_net_timer_policy.test-timer-policy.name}"
+}
+`
+
+func TestAccBigipNetServicePolicy_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetServicePolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SERVICE_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetServicePolicyExists(TEST_SERVICE_POLICY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_service_policy.test-service-policy", "name", TEST_SERVICE_POLICY_NAME),
+					resource.TestCheckResourceAttr("bigip_net_service_policy.test-service-policy", "description", "ssh idle timeout"),
+					resource.TestCheckResourceAttr("bigip_net_service_policy.test-service-policy", "timer_policy", "/Common/test-sp-timer-policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetServicePolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetServicePolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SERVICE_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetServicePolicyExists(TEST_SERVICE_POLICY_NAME, true),
+				),
+				ResourceName:      TEST_SERVICE_POLICY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetServicePolicyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p servicePolicy
+		ok, err := getForEntity(client, &p, "net", uriServicePolicy, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("service policy %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("service policy %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetServicePolicyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_service_policy" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p servicePolicy
+		ok, err := getForEntity(client, &p, "net", uriServicePolicy, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("service policy %s not destroyed.", name)
+		}
+	}
+	return nil
+}
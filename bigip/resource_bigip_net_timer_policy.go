@@ -0,0 +1,205 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriTimerPolicy = "timer-policy"
+
+type timerPolicy struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Rules       []timerPolicyRule `json:"rules"`
+}
+
+type timerPolicyRule struct {
+	Name             string             `json:"name"`
+	IpProtocol       string             `json:"ipProtocol,omitempty"`
+	DestinationPorts []timerPolicyPort  `json:"destinationPorts,omitempty"`
+	Timers           []timerPolicyTimer `json:"timers,omitempty"`
+}
+
+type timerPolicyPort struct {
+	Name string `json:"name"`
+}
+
+type timerPolicyTimer struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// bigip_net_timer_policy manages the idle timeouts of the flows matched by its rules, overriding the idle
+// timeout of the profiles of the virtual servers using it through bigip_net_service_policy
+func resourceBigipNetTimerPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetTimerPolicyCreate,
+		Update: resourceBigipNetTimerPolicyUpdate,
+		Read:   resourceBigipNetTimerPolicyRead,
+		Delete: resourceBigipNetTimerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the timer policy",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the policy, the first rule matching a flow sets its idle timeout",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the rule",
+						},
+						"ip_protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "any",
+							Description: "IP protocol of the flows matched by the rule, e.g. tcp, udp or any",
+						},
+						"destination_ports": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Destination ports or port ranges, e.g. 8000-8080, of the flows matched by the rule, all ports when empty",
+						},
+						"idle_timeout": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Seconds a matched flow may be idle, indefinite, immediate or unspecified to use the timeout of the profile",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipNetTimerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating timer policy " + name)
+
+	p := getNetTimerPolicyConfig(d)
+	p.Name = name
+	err := postEntity(client, p, "net", uriTimerPolicy)
+	if err != nil {
+		return fmt.Errorf("Error creating timer policy (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetTimerPolicyRead)
+}
+
+func resourceBigipNetTimerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	// The rules are replaced, a PATCH would keep the removed ones
+	err := putEntity(client, getNetTimerPolicyConfig(d), "net", uriTimerPolicy, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying timer policy (%s): %s", name, err)
+	}
+	return resourceBigipNetTimerPolicyRead(d, meta)
+}
+
+func resourceBigipNetTimerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p timerPolicy
+	ok, err := getForEntity(client, &p, "net", uriTimerPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve timer policy (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Timer policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+
+	var rules []map[string]interface{}
+	for _, r := range p.Rules {
+		var ports []string
+		for _, port := range r.DestinationPorts {
+			ports = append(ports, port.Name)
+		}
+		rule := map[string]interface{}{
+			"name":              r.Name,
+			"ip_protocol":       r.IpProtocol,
+			"destination_ports": ports,
+		}
+		for _, t := range r.Timers {
+			if t.Name == "flow-idle-timeout" {
+				rule["idle_timeout"] = t.Value
+			}
+		}
+		rules = append(rules, rule)
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Rules to state for timer policy (%s): %s", name, err)
+	}
+	return nil
+}
+
+func resourceBigipNetTimerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting timer policy " + name)
+
+	err := deleteEntity(client, "net", uriTimerPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete timer policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetTimerPolicyConfig(d *schema.ResourceData) *timerPolicy {
+	p := &timerPolicy{
+		Description: d.Get("description").(string),
+		Rules:       []timerPolicyRule{},
+	}
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		tr := timerPolicyRule{
+			Name:       rule["name"].(string),
+			IpProtocol: rule["ip_protocol"].(string),
+			Timers:     []timerPolicyTimer{{Name: "flow-idle-timeout", Value: rule["idle_timeout"].(string)}},
+		}
+		for _, port := range listToStringSlice(rule["destination_ports"].([]interface{})) {
+			tr.DestinationPorts = append(tr.DestinationPorts, timerPolicyPort{Name: port})
+		}
+		p.Rules = append(p.Rules, tr)
+	}
+	return p
+}
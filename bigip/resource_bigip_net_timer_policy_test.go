@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_TIMER_POLICY_NAME = fmt.Sprintf("/%s/test-timer-policy", TEST_PARTITION)
+
+var TEST_TIMER_POLICY_RESOURCE = `
+resource "bigip_net_timer_policy" "test-timer-policy" {
+	name = "` + TEST_TIMER_POLICY_NAME + `"
+	description = "long lived connections"
+	rule {
+		name = "ssh"
+		ip_protocol = "tcp"
+		destination_ports = ["22"]
+		idle_timeout = "indefinite"
+	}
+	rule {
+		name = "db"
+		ip_protocol = "tcp"
+		destination_ports = ["5432", "8000-8080"]
+		idle_timeout = "7200"
+	}
+}
+`
+
+func TestAccBigipNetTimerPolicy_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetTimerPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TIMER_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetTimerPolicyExists(TEST_TIMER_POLICY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "name", TEST_TIMER_POLICY_NAME),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "description", "long lived connections"),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "rule.#", "2"),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "rule.0.name", "ssh"),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "rule.0.idle_timeout", "indefinite"),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "rule.1.destination_ports.1", "8000-8080"),
+					resource.TestCheckResourceAttr("bigip_net_timer_policy.test-timer-policy", "rule.1.idle_timeout", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetTimerPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetTimerPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TIMER_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetTimerPolicyExists(TEST_TIMER_POLICY_NAME, true),
+				),
+				ResourceName:      TEST_TIMER_POLICY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipNetTimerPolicyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p timerPolicy
+		ok, err := getForEntity(client, &p, "net", uriTimerPolicy, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("timer policy %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("timer policy %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetTimerPolicyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_timer_policy" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p timerPolicy
+		ok, err := getForEntity(client, &p, "net", uriTimerPolicy, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("timer policy %s not destroyed.", name)
+		}
+	}
+	return nil
+}
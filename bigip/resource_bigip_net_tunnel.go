@@ -0,0 +1,208 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriTunnel = "tunnel"
+
+type netTunnel struct {
+	Name          string `json:"name,omitempty"`
+	Profile       string `json:"profile,omitempty"`
+	Description   string `json:"description,omitempty"`
+	LocalAddress  string `json:"localAddress,omitempty"`
+	RemoteAddress string `json:"remoteAddress,omitempty"`
+	Key           int    `json:"key"`
+	MTU           int    `json:"mtu,omitempty"`
+	Mode          string `json:"mode,omitempty"`
+	TrafficGroup  string `json:"trafficGroup,omitempty"`
+	UsePmtu       string `json:"usePmtu,omitempty"`
+	Tos           string `json:"tos,omitempty"`
+}
+
+func resourceBigipNetTunnel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetTunnelCreate,
+		Update: resourceBigipNetTunnelUpdate,
+		Read:   resourceBigipNetTunnelRead,
+		Delete: resourceBigipNetTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the tunnel",
+				ValidateFunc: validateF5Name,
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the tunnel profile, e.g. /Common/gre, /Common/ipip or /Common/vxlan",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"local_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Address of the BIG-IP end of the tunnel, usually a self IP",
+			},
+			"remote_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "any",
+				Description: "Address of the other end of the tunnel, any for a multipoint tunnel",
+			},
+			"key": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "GRE key, or VXLAN network identifier (VNI)",
+			},
+			"mtu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum transmission unit of the tunnel, 0 to derive it from the VLAN",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "bidirectional",
+				Description:  "Whether the tunnel carries inbound, outbound or bidirectional traffic",
+				ValidateFunc: validateStringValue([]string{"bidirectional", "inbound", "outbound"}),
+			},
+			"traffic_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Traffic group of the tunnel, for a floating local address",
+			},
+			"use_pmtu": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether path MTU discovery is used through the tunnel",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"tos": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of service of the encapsulating header, preserve to copy the one of the packet",
+			},
+		},
+	}
+}
+
+func resourceBigipNetTunnelCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating tunnel " + name)
+
+	t := getNetTunnelConfig(d)
+	t.Name = name
+	t.Profile = d.Get("profile").(string)
+	err := postEntity(client, t, "net", uriTunnels, uriTunnel)
+	if err != nil {
+		return fmt.Errorf("Error creating tunnel (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetTunnelRead)
+}
+
+func resourceBigipNetTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getNetTunnelConfig(d), "net", uriTunnels, uriTunnel, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying tunnel (%s): %s", name, err)
+	}
+	return resourceBigipNetTunnelRead(d, meta)
+}
+
+func resourceBigipNetTunnelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var t netTunnel
+	ok, err := getForEntity(client, &t, "net", uriTunnels, uriTunnel, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve tunnel (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Tunnel (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("profile", t.Profile)
+	d.Set("description", t.Description)
+	d.Set("local_address", t.LocalAddress)
+	d.Set("remote_address", t.RemoteAddress)
+	d.Set("key", t.Key)
+	d.Set("mtu", t.MTU)
+	d.Set("mode", t.Mode)
+	d.Set("traffic_group", t.TrafficGroup)
+	d.Set("use_pmtu", t.UsePmtu)
+	d.Set("tos", t.Tos)
+	return nil
+}
+
+func resourceBigipNetTunnelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting tunnel " + name)
+
+	err := deleteEntity(client, "net", uriTunnels, uriTunnel, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete tunnel (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetTunnelConfig(d *schema.ResourceData) *netTunnel {
+	return &netTunnel{
+		Description:   d.Get("description").(string),
+		LocalAddress:  d.Get("local_address").(string),
+		RemoteAddress: d.Get("remote_address").(string),
+		Key:           d.Get("key").(int),
+		MTU:           d.Get("mtu").(int),
+		Mode:          d.Get("mode").(string),
+		TrafficGroup:  d.Get("traffic_group").(string),
+		UsePmtu:       d.Get("use_pmtu").(string),
+		Tos:           d.Get("tos").(string),
+	}
+}
+
+// checkVlanOrTunnelExists returns an error if name is neither a VLAN nor a tunnel, which can be used in place of a VLAN
+func checkVlanOrTunnelExists(client *bigip.BigIP, owner, kind, name string) error {
+	if checkReferenceExists(client, owner, kind, name, "net", "vlan", name) == nil {
+		return nil
+	}
+	return checkReferenceExists(client, owner, kind, name, "net", uriTunnels, uriTunnel, name)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriTunnels = "tunnels"
+
+type tunnelProfile struct {
+	Name              string `json:"name,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	Description       string `json:"description,omitempty"`
+	Port              int    `json:"port,omitempty"`
+	FloodingType      string `json:"floodingType,omitempty"`
+	EncapsulationType string `json:"encapsulationType,omitempty"`
+	Encapsulation     string `json:"encapsulation,omitempty"`
+	TrafficSelector   string `json:"trafficSelector,omitempty"`
+}
+
+func resourceBigipNetTunnelProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetTunnelProfileCreate,
+		Update: resourceBigipNetTunnelProfileUpdate,
+		Read:   resourceBigipNetTunnelProfileRead,
+		Delete: resourceBigipNetTunnelProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipNetTunnelProfileImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the tunnel profile",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Kind of tunnel: gre, ipip, vxlan or ipsec",
+				ValidateFunc: validateStringValue([]string{"gre", "ipip", "vxlan", "ipsec"}),
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Parent profile, /Common/<type> by default",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "UDP port VXLAN packets are sent to, 4789 by default",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"flooding_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How VXLAN broadcast and unknown destination traffic is sent: multicast, multipoint, replicator or none",
+				ValidateFunc: validateStringValue([]string{"multicast", "multipoint", "replicator", "none"}),
+			},
+			"encapsulation_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "VXLAN header: vxlan or vxlan-gpe",
+				ValidateFunc: validateStringValue([]string{"vxlan", "vxlan-gpe"}),
+			},
+			"encapsulation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "GRE header: standard or nvgre",
+				ValidateFunc: validateStringValue([]string{"standard", "nvgre"}),
+			},
+			"traffic_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the IPsec traffic selector of an interface mode IPsec tunnel",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipNetTunnelProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	profileType := d.Get("type").(string)
+	log.Printf("[INFO] Creating tunnel profile %s (%s)", name, profileType)
+
+	if err := checkTunnelProfileSettings(d); err != nil {
+		return err
+	}
+	p := getTunnelProfileConfig(d)
+	p.Name = name
+	p.DefaultsFrom = d.Get("defaults_from").(string)
+	err := postEntity(client, p, "net", uriTunnels, profileType)
+	if err != nil {
+		return fmt.Errorf("Error creating tunnel profile (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipNetTunnelProfileRead)
+}
+
+func resourceBigipNetTunnelProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	if err := checkTunnelProfileSettings(d); err != nil {
+		return err
+	}
+	err := patchEntity(client, getTunnelProfileConfig(d), "net", uriTunnels, d.Get("type").(string), name)
+	if err != nil {
+		return fmt.Errorf("Error modifying tunnel profile (%s): %s", name, err)
+	}
+	return resourceBigipNetTunnelProfileRead(d, meta)
+}
+
+func resourceBigipNetTunnelProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p tunnelProfile
+	ok, err := getForEntity(client, &p, "net", uriTunnels, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve tunnel profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Tunnel profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("description", p.Description)
+	d.Set("port", p.Port)
+	d.Set("flooding_type", p.FloodingType)
+	d.Set("encapsulation_type", p.EncapsulationType)
+	d.Set("encapsulation", p.Encapsulation)
+	d.Set("traffic_selector", p.TrafficSelector)
+	return nil
+}
+
+func resourceBigipNetTunnelProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting tunnel profile " + name)
+
+	err := deleteEntity(client, "net", uriTunnels, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete tunnel profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipNetTunnelProfileImport takes an id of the form <type>:<full path>, e.g. vxlan:/Common/my-vxlan
+func resourceBigipNetTunnelProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Expected an id of the form <type>:<full path>, got %s", d.Id())
+	}
+	d.Set("type", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkTunnelProfileSettings returns an error for settings of another kind of tunnel than the profile's
+func checkTunnelProfileSettings(d *schema.ResourceData) error {
+	settings := map[string]string{
+		"port":               "vxlan",
+		"flooding_type":      "vxlan",
+		"encapsulation_type": "vxlan",
+		"encapsulation":      "gre",
+		"traffic_selector":   "ipsec",
+	}
+	profileType := d.Get("type").(string)
+	for k, t := range settings {
+		if _, ok := d.GetOk(k); ok && d.HasChange(k) && t != profileType {
+			return fmt.Errorf("%s only applies to %s tunnel profiles, not to %s ones", k, t, profileType)
+		}
+	}
+	return nil
+}
+
+func getTunnelProfileConfig(d *schema.ResourceData) *tunnelProfile {
+	return &tunnelProfile{
+		Description:       d.Get("description").(string),
+		Port:              d.Get("port").(int),
+		FloodingType:      d.Get("flooding_type").(string),
+		EncapsulationType: d.Get("encapsulation_type").(string),
+		Encapsulation:     d.Get("encapsulation").(string),
+		TrafficSelector:   d.Get("traffic_selector").(string),
+	}
+}
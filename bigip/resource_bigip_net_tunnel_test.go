@@ -0,0 +1,108 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_TUNNEL_PROFILE_NAME = fmt.Sprintf("/%s/test-vxlan", TEST_PARTITION)
+var TEST_TUNNEL_NAME = fmt.Sprintf("/%s/test-tunnel", TEST_PARTITION)
+
+var TEST_TUNNEL_RESOURCE = `
+resource "bigip_net_tunnel_profile" "test-vxlan" {
+	name = "` + TEST_TUNNEL_PROFILE_NAME + `"
+	type = "vxlan"
+	port = 4789
+	flooding_type = "multipoint"
+}
+resource "bigip_net_tunnel" "test-tunnel" {
+	name = "` + TEST_TUNNEL_NAME + `"
+	profile = "${bigip_net_tunnel_profile.test-vxlan.name}"
+	local_address = "10.10.10.10"
+	key = 5000
+	mtu = 1450
+	description = "test tunnel"
+}
+`
+
+func TestAccBigipNetTunnel_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipNetTunnelDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TUNNEL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipNetTunnelExists(uriTunnel, TEST_TUNNEL_NAME, true),
+					testCheckBigipNetTunnelExists("vxlan", TEST_TUNNEL_PROFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_tunnel_profile.test-vxlan", "defaults_from", "/Common/vxlan"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel_profile.test-vxlan", "port", "4789"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel_profile.test-vxlan", "flooding_type", "multipoint"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "profile", TEST_TUNNEL_PROFILE_NAME),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "local_address", "10.10.10.10"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "remote_address", "any"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "key", "5000"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "mtu", "1450"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "mode", "bidirectional"),
+					resource.TestCheckResourceAttr("bigip_net_tunnel.test-tunnel", "description", "test tunnel"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipNetTunnelExists(kind, name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var t netTunnel
+		ok, err := getForEntity(client, &t, "net", uriTunnels, kind, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("%s %s was not created.", kind, name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("%s %s still exists.", kind, name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipNetTunnelDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		kind := uriTunnel
+		switch rs.Type {
+		case "bigip_net_tunnel":
+		case "bigip_net_tunnel_profile":
+			kind = rs.Primary.Attributes["type"]
+		default:
+			continue
+		}
+
+		name := rs.Primary.ID
+		var t netTunnel
+		ok, err := getForEntity(client, &t, "net", uriTunnels, kind, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("%s %s not destroyed.", kind, name)
+		}
+	}
+	return nil
+}
@@ -14,6 +14,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+type netVlan struct {
+	Name            string                `json:"name,omitempty"`
+	Tag             int                   `json:"tag,omitempty"`
+	MTU             int                   `json:"mtu,omitempty"`
+	CMPHash         string                `json:"cmpHash,omitempty"`
+	Failsafe        string                `json:"failsafe,omitempty"`
+	FailsafeAction  string                `json:"failsafeAction,omitempty"`
+	FailsafeTimeout int                   `json:"failsafeTimeout,omitempty"`
+	Interfaces      []bigip.VlanInterface `json:"interfaces"`
+}
+
 func resourceBigipNetVlan() *schema.Resource {
 
 	return &schema.Resource{
@@ -39,6 +50,44 @@ func resourceBigipNetVlan() *schema.Resource {
 				Description: "VLAN ID (tag)",
 			},
 
+			"mtu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum transmission unit of the VLAN",
+			},
+
+			"cmp_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Traffic distribution across TMM instances: default, src-ip or dst-ip",
+				ValidateFunc: validateStringValue([]string{"default", "src-ip", "dst-ip"}),
+			},
+
+			"failsafe": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable taking failsafe_action when no traffic is received on the VLAN",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"failsafe_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Action taken when failsafe triggers: failover, failover-restart-tm, reboot, restart-all or restart-tm",
+				ValidateFunc: validateStringValue([]string{"failover", "failover-restart-tm", "reboot", "restart-all", "restart-tm"}),
+			},
+
+			"failsafe_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds without traffic before failsafe triggers",
+			},
+
 			"interfaces": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -68,41 +117,18 @@ func resourceBigipNetVlanCreate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*bigip.BigIP)
 
 	name := d.Get("name").(string)
-	tag := d.Get("tag").(int)
 
 	log.Printf("[DEBUG] Creating VLAN %s", name)
 
-	d.Partial(true)
-
-	err := client.CreateVlan(
-		name,
-		tag,
-	)
-
+	v := getNetVlanConfig(d)
+	v.Name = name
+	err := postEntity(client, v, "net", "vlan")
 	if err != nil {
 		return fmt.Errorf("Error creating VLAN %s: %v", name, err)
 	}
 
 	d.SetId(name)
-	d.SetPartial("name")
-	d.SetPartial("tag")
-
-	ifaceCount := d.Get("interfaces.#").(int)
-	for i := 0; i < ifaceCount; i++ {
-		prefix := fmt.Sprintf("interfaces.%d", i)
-		iface := d.Get(prefix + ".vlanport").(string)
-		tagged := d.Get(prefix + ".tagged").(bool)
-
-		err = client.AddInterfaceToVlan(name, iface, tagged)
-		if err != nil {
-			return fmt.Errorf("Error adding Interface %s to VLAN %s: %v", iface, name, err)
-		}
-	}
-	d.SetPartial("interfaces")
-
-	d.Partial(false)
-
-	return resourceBigipNetVlanRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipNetVlanRead)
 }
 
 func resourceBigipNetVlanRead(d *schema.ResourceData, meta interface{}) error {
@@ -124,6 +150,11 @@ func resourceBigipNetVlanRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", vlan.FullPath)
 	d.Set("tag", vlan.Tag)
+	d.Set("mtu", vlan.MTU)
+	d.Set("cmp_hash", vlan.CMPHash)
+	d.Set("failsafe", vlan.Failsafe)
+	d.Set("failsafe_action", vlan.FailsafeAction)
+	d.Set("failsafe_timeout", vlan.FailsafeTimeout)
 
 	log.Printf("[DEBUG] Reading VLAN %s Interfaces", name)
 
@@ -164,12 +195,8 @@ func resourceBigipNetVlanUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Updating VLAN %s", name)
 
-	r := &bigip.Vlan{
-		Name: name,
-		Tag:  d.Get("tag").(int),
-	}
-
-	err := client.ModifyVlan(name, r)
+	// The interfaces sent replace the ones of the VLAN, so interfaces added out of band are removed
+	err := patchEntity(client, getNetVlanConfig(d), "net", "vlan", name)
 	if err != nil {
 		return fmt.Errorf("Error modifying VLAN %s: %v", name, err)
 	}
@@ -192,3 +219,25 @@ func resourceBigipNetVlanDelete(d *schema.ResourceData, meta interface{}) error
 	d.SetId("")
 	return nil
 }
+
+func getNetVlanConfig(d *schema.ResourceData) *netVlan {
+	v := &netVlan{
+		Tag:             d.Get("tag").(int),
+		MTU:             d.Get("mtu").(int),
+		CMPHash:         d.Get("cmp_hash").(string),
+		Failsafe:        d.Get("failsafe").(string),
+		FailsafeAction:  d.Get("failsafe_action").(string),
+		FailsafeTimeout: d.Get("failsafe_timeout").(int),
+		Interfaces:      []bigip.VlanInterface{},
+	}
+	for _, i := range d.Get("interfaces").([]interface{}) {
+		iface := i.(map[string]interface{})
+		tagged := iface["tagged"].(bool)
+		v.Interfaces = append(v.Interfaces, bigip.VlanInterface{
+			Name:     iface["vlanport"].(string),
+			Tagged:   tagged,
+			Untagged: !tagged,
+		})
+	}
+	return v
+}
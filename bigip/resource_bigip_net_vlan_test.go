@@ -22,6 +22,11 @@ var TEST_VLAN_RESOURCE = `
 resource "bigip_net_vlan" "test-vlan" {
 	name = "/Common/test-vlan"
 	tag = 101
+	mtu = 9000
+	cmp_hash = "src-ip"
+	failsafe = "enabled"
+	failsafe_action = "failover"
+	failsafe_timeout = 30
 	interfaces {
 		vlanport = 1.1
 		tagged = false
@@ -43,6 +48,11 @@ func TestAccBigipNetvlan_create(t *testing.T) {
 					testCheckvlanExists(TEST_VLAN_NAME, true),
 					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "name", "/Common/test-vlan"),
 					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "tag", "101"),
+					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "mtu", "9000"),
+					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "cmp_hash", "src-ip"),
+					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "failsafe", "enabled"),
+					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "failsafe_action", "failover"),
+					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "failsafe_timeout", "30"),
 					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "interfaces.0.vlanport", "1.1"),
 					resource.TestCheckResourceAttr("bigip_net_vlan.test-vlan", "interfaces.0.tagged", "false"),
 				),
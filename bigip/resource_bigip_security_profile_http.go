@@ -0,0 +1,363 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriSecurity = "security"
+
+type httpSecurityProfile struct {
+	Name              string                 `json:"name,omitempty"`
+	DefaultsFrom      string                 `json:"defaultsFrom,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	HttpRfc           *httpSecurityRfc       `json:"httpRfc,omitempty"`
+	EvasionTechniques *httpSecurityViolation `json:"evasionTechniques,omitempty"`
+	Method            *httpSecurityMethod    `json:"method,omitempty"`
+	FileType          *httpSecurityFileType  `json:"fileType,omitempty"`
+}
+
+// httpSecurityViolation is whether a violation is logged (alarm) and the request blocked
+type httpSecurityViolation struct {
+	Alarm string `json:"alarm,omitempty"`
+	Block string `json:"block,omitempty"`
+}
+
+type httpSecurityRfc struct {
+	Alarm                    string `json:"alarm,omitempty"`
+	Block                    string `json:"block,omitempty"`
+	BadHostHeader            string `json:"badHostHeader,omitempty"`
+	BadVersion               string `json:"badVersion,omitempty"`
+	BodyInGetHead            string `json:"bodyInGetHead,omitempty"`
+	ChunkedWithContentLength string `json:"chunkedWithContentLength,omitempty"`
+	ContentLengthIsPositive  string `json:"contentLengthIsPositive,omitempty"`
+	HeaderNameWithoutValue   string `json:"headerNameWithoutValue,omitempty"`
+	HighAsciiInHeaders       string `json:"highAsciiInHeaders,omitempty"`
+	HostHeaderIsIp           string `json:"hostHeaderIsIp,omitempty"`
+	MaximumHeaders           string `json:"maximumHeaders,omitempty"`
+	NullInBody               string `json:"nullInBody,omitempty"`
+	NullInHeaders            string `json:"nullInHeaders,omitempty"`
+	PostWithZeroLength       string `json:"postWithZeroLength,omitempty"`
+	SeveralContentLength     string `json:"severalContentLength,omitempty"`
+	UnparsableContent        string `json:"unparsableContent,omitempty"`
+}
+
+type httpSecurityMethod struct {
+	Alarm          string   `json:"alarm,omitempty"`
+	Block          string   `json:"block,omitempty"`
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+}
+
+type httpSecurityFileType struct {
+	Alarm  string   `json:"alarm,omitempty"`
+	Block  string   `json:"block,omitempty"`
+	Type   string   `json:"type,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+// httpRfcChecks maps the schema key of each RFC compliance check to its field of httpSecurityRfc
+var httpRfcChecks = map[string]func(*httpSecurityRfc) *string{
+	"bad_host_header":             func(r *httpSecurityRfc) *string { return &r.BadHostHeader },
+	"bad_version":                 func(r *httpSecurityRfc) *string { return &r.BadVersion },
+	"body_in_get_head":            func(r *httpSecurityRfc) *string { return &r.BodyInGetHead },
+	"chunked_with_content_length": func(r *httpSecurityRfc) *string { return &r.ChunkedWithContentLength },
+	"content_length_is_positive":  func(r *httpSecurityRfc) *string { return &r.ContentLengthIsPositive },
+	"header_name_without_value":   func(r *httpSecurityRfc) *string { return &r.HeaderNameWithoutValue },
+	"high_ascii_in_headers":       func(r *httpSecurityRfc) *string { return &r.HighAsciiInHeaders },
+	"host_header_is_ip":           func(r *httpSecurityRfc) *string { return &r.HostHeaderIsIp },
+	"null_in_body":                func(r *httpSecurityRfc) *string { return &r.NullInBody },
+	"null_in_headers":             func(r *httpSecurityRfc) *string { return &r.NullInHeaders },
+	"post_with_zero_length":       func(r *httpSecurityRfc) *string { return &r.PostWithZeroLength },
+	"several_content_length":      func(r *httpSecurityRfc) *string { return &r.SeveralContentLength },
+	"unparsable_content":          func(r *httpSecurityRfc) *string { return &r.UnparsableContent },
+}
+
+// httpSecurityViolationSchema returns the alarm and block settings of a violation, along with the given ones
+func httpSecurityViolationSchema(settings map[string]*schema.Schema) map[string]*schema.Schema {
+	settings["alarm"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Whether the violation is logged",
+		ValidateFunc: validateEnabledDisabled,
+	}
+	settings["block"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Whether a request with the violation is blocked",
+		ValidateFunc: validateEnabledDisabled,
+	}
+	return settings
+}
+
+func resourceBigipSecurityProfileHttp() *schema.Resource {
+	rfcChecks := map[string]*schema.Schema{
+		"maximum_headers": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Most headers a request can have, or disabled",
+		},
+	}
+	for k := range httpRfcChecks {
+		rfcChecks[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateEnabledDisabled,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceBigipSecurityProfileHttpCreate,
+		Update: resourceBigipSecurityProfileHttpUpdate,
+		Read:   resourceBigipSecurityProfileHttpRead,
+		Delete: resourceBigipSecurityProfileHttpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the HTTP security profile",
+				ValidateFunc: validateF5Name,
+			},
+			"defaults_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/Common/http_security",
+				ForceNew:    true,
+				Description: "Use the parent HTTP security profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"http_rfc": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Checks of the compliance of requests with the HTTP RFCs",
+				Elem:        &schema.Resource{Schema: httpSecurityViolationSchema(rfcChecks)},
+			},
+			"evasion_techniques": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Detection of evasion techniques, e.g. directory traversal or multiple decoding",
+				Elem:        &schema.Resource{Schema: httpSecurityViolationSchema(map[string]*schema.Schema{})},
+			},
+			"method": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Enforcement of the HTTP methods of requests",
+				Elem: &schema.Resource{Schema: httpSecurityViolationSchema(map[string]*schema.Schema{
+					"allowed_methods": {
+						Type:        schema.TypeSet,
+						Set:         schema.HashString,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Computed:    true,
+						Description: "HTTP methods allowed, e.g. GET or POST",
+					},
+				})},
+			},
+			"file_type": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Enforcement of the file types, extensions, of the URLs requested",
+				Elem: &schema.Resource{Schema: httpSecurityViolationSchema(map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						Description:  "Whether values lists the allowed or the disallowed file types",
+						ValidateFunc: validateStringValue([]string{"allowed", "disallowed"}),
+					},
+					"values": {
+						Type:        schema.TypeSet,
+						Set:         schema.HashString,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Computed:    true,
+						Description: "File types, e.g. php or exe",
+					},
+				})},
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityProfileHttpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating HTTP security profile " + name)
+
+	p := getHttpSecurityProfileConfig(d)
+	p.Name = name
+	p.DefaultsFrom = d.Get("defaults_from").(string)
+	err := postEntity(client, p, uriSecurity, "http", uriProfile)
+	if err != nil {
+		return fmt.Errorf("Error creating HTTP security profile (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSecurityProfileHttpRead)
+}
+
+func resourceBigipSecurityProfileHttpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getHttpSecurityProfileConfig(d), uriSecurity, "http", uriProfile, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying HTTP security profile (%s): %s", name, err)
+	}
+	return resourceBigipSecurityProfileHttpRead(d, meta)
+}
+
+func resourceBigipSecurityProfileHttpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p httpSecurityProfile
+	ok, err := getForEntity(client, &p, uriSecurity, "http", uriProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve HTTP security profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] HTTP security profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("defaults_from", p.DefaultsFrom)
+	d.Set("description", p.Description)
+
+	// Blocks the BIG-IP does not return are saved empty, so that they are not left unknown
+	var rfc, evasion, method, fileType []interface{}
+	if p.HttpRfc != nil {
+		r := map[string]interface{}{
+			"alarm":           p.HttpRfc.Alarm,
+			"block":           p.HttpRfc.Block,
+			"maximum_headers": p.HttpRfc.MaximumHeaders,
+		}
+		for k, field := range httpRfcChecks {
+			r[k] = *field(p.HttpRfc)
+		}
+		rfc = append(rfc, r)
+	}
+	if p.EvasionTechniques != nil {
+		evasion = append(evasion, map[string]interface{}{
+			"alarm": p.EvasionTechniques.Alarm,
+			"block": p.EvasionTechniques.Block,
+		})
+	}
+	if p.Method != nil {
+		method = append(method, map[string]interface{}{
+			"alarm":           p.Method.Alarm,
+			"block":           p.Method.Block,
+			"allowed_methods": p.Method.AllowedMethods,
+		})
+	}
+	if p.FileType != nil {
+		fileType = append(fileType, map[string]interface{}{
+			"alarm":  p.FileType.Alarm,
+			"block":  p.FileType.Block,
+			"type":   p.FileType.Type,
+			"values": p.FileType.Values,
+		})
+	}
+	if err := d.Set("http_rfc", rfc); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving HttpRfc to state for HTTP security profile (%s): %s", name, err)
+	}
+	if err := d.Set("evasion_techniques", evasion); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving EvasionTechniques to state for HTTP security profile (%s): %s", name, err)
+	}
+	if err := d.Set("method", method); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Method to state for HTTP security profile (%s): %s", name, err)
+	}
+	if err := d.Set("file_type", fileType); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving FileType to state for HTTP security profile (%s): %s", name, err)
+	}
+	return nil
+}
+
+func resourceBigipSecurityProfileHttpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting HTTP security profile " + name)
+
+	err := deleteEntity(client, uriSecurity, "http", uriProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete HTTP security profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getHttpSecurityProfileConfig(d *schema.ResourceData) *httpSecurityProfile {
+	p := &httpSecurityProfile{
+		Description: d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("http_rfc.0"); ok {
+		rfc := v.(map[string]interface{})
+		p.HttpRfc = &httpSecurityRfc{
+			Alarm:          rfc["alarm"].(string),
+			Block:          rfc["block"].(string),
+			MaximumHeaders: rfc["maximum_headers"].(string),
+		}
+		for k, field := range httpRfcChecks {
+			*field(p.HttpRfc) = rfc[k].(string)
+		}
+	}
+	if v, ok := d.GetOk("evasion_techniques.0"); ok {
+		e := v.(map[string]interface{})
+		p.EvasionTechniques = &httpSecurityViolation{
+			Alarm: e["alarm"].(string),
+			Block: e["block"].(string),
+		}
+	}
+	if v, ok := d.GetOk("method.0"); ok {
+		m := v.(map[string]interface{})
+		p.Method = &httpSecurityMethod{
+			Alarm:          m["alarm"].(string),
+			Block:          m["block"].(string),
+			AllowedMethods: setToStringSlice(m["allowed_methods"].(*schema.Set)),
+		}
+	}
+	if v, ok := d.GetOk("file_type.0"); ok {
+		f := v.(map[string]interface{})
+		p.FileType = &httpSecurityFileType{
+			Alarm:  f["alarm"].(string),
+			Block:  f["block"].(string),
+			Type:   f["type"].(string),
+			Values: setToStringSlice(f["values"].(*schema.Set)),
+		}
+	}
+	return p
+}
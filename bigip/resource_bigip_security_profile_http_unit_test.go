@@ -0,0 +1,85 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSecurityProfileHttp(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_security_profile_http" "test-http-security" {
+			name = "/Common/test-http-security"
+			http_rfc {
+				block = "enabled"
+				null_in_headers = "enabled"
+			}
+			method {
+				allowed_methods = ["GET", "POST"]
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSecurityProfileHttp(t *testing.T) {
+	setup()
+	defer teardown()
+	created := false
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/security/http/profile", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"/Common/test-http-security","defaultsFrom":"/Common/http_security",
+			"httpRfc":{"block":"enabled","nullInHeaders":"enabled"},"method":{"allowedMethods":["GET","POST"]}}`, string(b))
+		created = true
+	})
+	mux.HandleFunc("/mgmt/tm/security/http/profile/~Common~test-http-security", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = false
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-http-security","defaultsFrom":"/Common/http_security",
+			"httpRfc":{"alarm":"enabled","block":"enabled","nullInHeaders":"enabled","maximumHeaders":"disabled","badVersion":"disabled"},
+			"evasionTechniques":{"alarm":"enabled","block":"disabled"},
+			"method":{"alarm":"enabled","block":"disabled","allowedMethods":["GET","POST"]}}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSecurityProfileHttp(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_security_profile_http.test-http-security", "http_rfc.0.alarm", "enabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_http.test-http-security", "http_rfc.0.null_in_headers", "enabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_http.test-http-security", "http_rfc.0.bad_version", "disabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_http.test-http-security", "evasion_techniques.0.block", "disabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_http.test-http-security", "method.0.allowed_methods.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type securityLogProfile struct {
+	Name                    string                      `json:"name,omitempty"`
+	Description             string                      `json:"description,omitempty"`
+	Application             []securityLogApplication    `json:"application"`
+	ApplicationReference    *securityLogApplications    `json:"applicationReference,omitempty"`
+	Network                 []securityLogNetwork        `json:"network"`
+	NetworkReference        *securityLogNetworks        `json:"networkReference,omitempty"`
+	DosApplication          []securityLogDosApplication `json:"dosApplication"`
+	DosApplicationReference *securityLogDosApplications `json:"dosApplicationReference,omitempty"`
+	DosNetworkPublisher     string                      `json:"dosNetworkPublisher"`
+	ProtocolDnsDosPublisher string                      `json:"protocolDnsDosPublisher"`
+	ProtocolSipDosPublisher string                      `json:"protocolSipDosPublisher"`
+}
+
+// The subcollections of a security log profile, as they are read back with expandSubcollections=true
+type securityLogApplications struct {
+	Items []securityLogApplication `json:"items"`
+}
+
+type securityLogNetworks struct {
+	Items []securityLogNetwork `json:"items"`
+}
+
+type securityLogDosApplications struct {
+	Items []securityLogDosApplication `json:"items"`
+}
+
+type securityLogApplication struct {
+	Name          string                         `json:"name"`
+	LocalStorage  string                         `json:"localStorage,omitempty"`
+	RemoteStorage string                         `json:"remoteStorage,omitempty"`
+	Protocol      string                         `json:"protocol,omitempty"`
+	Servers       []logPublisherDestination      `json:"servers"`
+	Filter        []securityLogApplicationFilter `json:"filter,omitempty"`
+}
+
+type securityLogApplicationFilter struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type securityLogNetwork struct {
+	Name      string                   `json:"name"`
+	Publisher string                   `json:"publisher,omitempty"`
+	Filter    securityLogNetworkFilter `json:"filter"`
+}
+
+type securityLogNetworkFilter struct {
+	LogAclMatchAccept string `json:"logAclMatchAccept,omitempty"`
+	LogAclMatchDrop   string `json:"logAclMatchDrop,omitempty"`
+	LogAclMatchReject string `json:"logAclMatchReject,omitempty"`
+	LogIpErrors       string `json:"logIpErrors,omitempty"`
+	LogTcpErrors      string `json:"logTcpErrors,omitempty"`
+	LogTcpEvents      string `json:"logTcpEvents,omitempty"`
+}
+
+type securityLogDosApplication struct {
+	Name            string `json:"name"`
+	LocalPublisher  string `json:"localPublisher,omitempty"`
+	RemotePublisher string `json:"remotePublisher,omitempty"`
+}
+
+// bigip_security_profile_log is the end of the log-config chain, it sends the events of ASM, AFM and DoS
+// protection of the virtual servers it is attached to, to log publishers
+func resourceBigipSecurityProfileLog() *schema.Resource {
+	filter := func(description string, value string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      value,
+			Description:  description,
+			ValidateFunc: validateEnabledDisabled,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceBigipSecurityProfileLogCreate,
+		Update: resourceBigipSecurityProfileLogUpdate,
+		Read:   resourceBigipSecurityProfileLogRead,
+		Delete: resourceBigipSecurityProfileLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the security log profile",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"application": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Logging of the requests of the ASM (web application security)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_storage": filter("To enable _ disable storing the requests on the BIG-IP", "enabled"),
+						"remote_storage": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "none",
+							Description:  "Format of the requests sent to the servers, none, remote, splunk, arcsight or bigiq",
+							ValidateFunc: validateStringValue([]string{"none", "remote", "splunk", "arcsight", "bigiq"}),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "tcp",
+							Description:  "Protocol the requests are sent to the servers with, tcp, udp or tcp-rfc3195",
+							ValidateFunc: validateStringValue([]string{"tcp", "udp", "tcp-rfc3195"}),
+						},
+						"servers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Servers the requests are sent to, e.g. 10.1.1.1:514",
+						},
+						"request_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "illegal-including-staged-signatures",
+							Description:  "Requests that are logged, all, illegal or illegal-including-staged-signatures",
+							ValidateFunc: validateStringValue([]string{"all", "illegal", "illegal-including-staged-signatures"}),
+						},
+					},
+				},
+			},
+			"network": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Logging of the events of the AFM (network firewall)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publisher": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the log publisher of the events",
+							ValidateFunc: validateF5Name,
+						},
+						"log_acl_match_accept": filter("To enable _ disable logging the packets accepted by a rule", "disabled"),
+						"log_acl_match_drop":   filter("To enable _ disable logging the packets dropped by a rule", "enabled"),
+						"log_acl_match_reject": filter("To enable _ disable logging the packets rejected by a rule", "enabled"),
+						"log_ip_errors":        filter("To enable _ disable logging the IP errors", "disabled"),
+						"log_tcp_errors":       filter("To enable _ disable logging the TCP errors", "disabled"),
+						"log_tcp_events":       filter("To enable _ disable logging the opening and closing of TCP connections", "disabled"),
+					},
+				},
+			},
+			"dos_application_publisher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the log publisher of the application (L7) DoS attacks",
+				ValidateFunc: validateF5Name,
+			},
+			"dos_network_publisher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the log publisher of the network DoS attacks",
+				ValidateFunc: validateF5Name,
+			},
+			"protocol_dns_dos_publisher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the log publisher of the DNS DoS attacks",
+				ValidateFunc: validateF5Name,
+			},
+			"protocol_sip_dos_publisher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Full path of the log publisher of the SIP DoS attacks",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityProfileLogCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating security log profile " + name)
+
+	p, err := getSecurityLogProfileConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	p.Name = name
+	err = postEntity(client, p, uriSecurity, "log", uriProfile)
+	if err != nil {
+		return fmt.Errorf("Error creating security log profile (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSecurityProfileLogRead)
+}
+
+func resourceBigipSecurityProfileLogUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p, err := getSecurityLogProfileConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	err = putEntity(client, p, uriSecurity, "log", uriProfile, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying security log profile (%s): %s", name, err)
+	}
+	return resourceBigipSecurityProfileLogRead(d, meta)
+}
+
+func resourceBigipSecurityProfileLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p securityLogProfile
+	ok, err := getForEntity(client, &p, uriSecurity, "log", uriProfile, name+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve security log profile (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Security log profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", p.Description)
+
+	application := []interface{}{}
+	if p.ApplicationReference != nil {
+		for _, a := range p.ApplicationReference.Items {
+			var servers []string
+			for _, s := range a.Servers {
+				servers = append(servers, s.Name)
+			}
+			requestType := ""
+			for _, f := range a.Filter {
+				if f.Name == "request-type" && len(f.Values) > 0 {
+					requestType = f.Values[0]
+				}
+			}
+			application = append(application, map[string]interface{}{
+				"local_storage":  a.LocalStorage,
+				"remote_storage": a.RemoteStorage,
+				"protocol":       a.Protocol,
+				"servers":        servers,
+				"request_type":   requestType,
+			})
+		}
+	}
+	if err := d.Set("application", application); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Application to state for security log profile (%s): %s", name, err)
+	}
+
+	network := []interface{}{}
+	if p.NetworkReference != nil {
+		for _, n := range p.NetworkReference.Items {
+			network = append(network, map[string]interface{}{
+				"publisher":            n.Publisher,
+				"log_acl_match_accept": n.Filter.LogAclMatchAccept,
+				"log_acl_match_drop":   n.Filter.LogAclMatchDrop,
+				"log_acl_match_reject": n.Filter.LogAclMatchReject,
+				"log_ip_errors":        n.Filter.LogIpErrors,
+				"log_tcp_errors":       n.Filter.LogTcpErrors,
+				"log_tcp_events":       n.Filter.LogTcpEvents,
+			})
+		}
+	}
+	if err := d.Set("network", network); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Network to state for security log profile (%s): %s", name, err)
+	}
+
+	dosApplicationPublisher := ""
+	if p.DosApplicationReference != nil {
+		for _, a := range p.DosApplicationReference.Items {
+			dosApplicationPublisher = a.RemotePublisher
+		}
+	}
+	d.Set("dos_application_publisher", noneToEmpty(dosApplicationPublisher))
+	d.Set("dos_network_publisher", noneToEmpty(p.DosNetworkPublisher))
+	d.Set("protocol_dns_dos_publisher", noneToEmpty(p.ProtocolDnsDosPublisher))
+	d.Set("protocol_sip_dos_publisher", noneToEmpty(p.ProtocolSipDosPublisher))
+	return nil
+}
+
+func resourceBigipSecurityProfileLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting security log profile " + name)
+
+	err := deleteEntity(client, uriSecurity, "log", uriProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete security log profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSecurityLogProfileConfig(d *schema.ResourceData, meta interface{}) (*securityLogProfile, error) {
+	client := meta.(*bigip.BigIP)
+
+	// A publisher that is not set is none, so that it is removed from the profile on update
+	publisher := func(key string) string {
+		if p := d.Get(key).(string); p != "" {
+			return p
+		}
+		return "none"
+	}
+	name := d.Get("name").(string)
+	p := &securityLogProfile{
+		Description:             d.Get("description").(string),
+		Application:             []securityLogApplication{},
+		Network:                 []securityLogNetwork{},
+		DosApplication:          []securityLogDosApplication{},
+		DosNetworkPublisher:     publisher("dos_network_publisher"),
+		ProtocolDnsDosPublisher: publisher("protocol_dns_dos_publisher"),
+		ProtocolSipDosPublisher: publisher("protocol_sip_dos_publisher"),
+	}
+
+	// The subcollections have one item each, named after the profile
+	_, itemName := parseF5Identifier(name)
+	for _, a := range d.Get("application").([]interface{}) {
+		application := a.(map[string]interface{})
+		item := securityLogApplication{
+			Name:          itemName,
+			LocalStorage:  application["local_storage"].(string),
+			RemoteStorage: application["remote_storage"].(string),
+			Protocol:      application["protocol"].(string),
+			Servers:       []logPublisherDestination{},
+			Filter: []securityLogApplicationFilter{
+				{Name: "request-type", Values: []string{application["request_type"].(string)}},
+			},
+		}
+		for _, s := range listToStringSlice(application["servers"].([]interface{})) {
+			item.Servers = append(item.Servers, logPublisherDestination{Name: s})
+		}
+		p.Application = append(p.Application, item)
+	}
+	for _, n := range d.Get("network").([]interface{}) {
+		network := n.(map[string]interface{})
+		p.Network = append(p.Network, securityLogNetwork{
+			Name:      itemName,
+			Publisher: network["publisher"].(string),
+			Filter: securityLogNetworkFilter{
+				LogAclMatchAccept: network["log_acl_match_accept"].(string),
+				LogAclMatchDrop:   network["log_acl_match_drop"].(string),
+				LogAclMatchReject: network["log_acl_match_reject"].(string),
+				LogIpErrors:       network["log_ip_errors"].(string),
+				LogTcpErrors:      network["log_tcp_errors"].(string),
+				LogTcpEvents:      network["log_tcp_events"].(string),
+			},
+		})
+	}
+	if dosApplication := publisher("dos_application_publisher"); dosApplication != "none" {
+		p.DosApplication = append(p.DosApplication, securityLogDosApplication{Name: itemName, RemotePublisher: dosApplication})
+	}
+
+	publishers := []string{p.DosNetworkPublisher, p.ProtocolDnsDosPublisher, p.ProtocolSipDosPublisher, publisher("dos_application_publisher")}
+	for _, n := range p.Network {
+		publishers = append(publishers, n.Publisher)
+	}
+	for _, publisher := range publishers {
+		if publisher == "none" {
+			continue
+		}
+		err := checkReferenceExists(client, "Security log profile "+name, "Log publisher", publisher, "sys", uriLogConfig, uriLogPublisher, publisher)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// objectsHandler answers the requests on the objects under /mgmt/tm/ like a device, keeping them in objects by
+// path. With expandSubcollections=true the lists of objects are read back as subcollection references.
+func objectsHandler(objects map[string]map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		path := r.URL.Path
+		switch r.Method {
+		case "POST":
+			path += "/" + strings.Replace(body["name"].(string), "/", "~", -1)
+			objects[path] = body
+		case "PUT":
+			if _, ok := objects[path]; ok {
+				objects[path] = body
+			}
+		case "PATCH":
+			if o, ok := objects[path]; ok {
+				patched := map[string]interface{}{}
+				for k, v := range o {
+					patched[k] = v
+				}
+				for k, v := range body {
+					patched[k] = v
+				}
+				objects[path] = patched
+			}
+		case "DELETE":
+			if _, ok := objects[path]; ok {
+				delete(objects, path)
+				return
+			}
+		}
+		o, ok := objects[path]
+		if !ok {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		if r.URL.Query().Get("expandSubcollections") == "true" {
+			expanded := map[string]interface{}{}
+			for k, v := range o {
+				if items, ok := v.([]interface{}); ok && len(items) > 0 {
+					if _, ok := items[0].(map[string]interface{}); ok {
+						expanded[k+"Reference"] = map[string]interface{}{"items": items}
+						continue
+					}
+				}
+				expanded[k] = v
+			}
+			o = expanded
+		}
+		json.NewEncoder(w).Encode(o)
+	}
+}
+
+func testBigipSecurityProfileLogChain(url, dosPublisher string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_log_destination_hsl" "siem" {
+			name = "/Common/siem-hsl"
+			pool = "/Common/siem-pool"
+			protocol = "tcp"
+		}
+		resource "bigip_sys_log_destination_arcsight" "siem" {
+			name = "/Common/siem-arcsight"
+			remote_hsl = "${bigip_sys_log_destination_hsl.siem.name}"
+		}
+		resource "bigip_sys_log_publisher" "siem" {
+			name = "/Common/siem-publisher"
+			destinations = ["${bigip_sys_log_destination_arcsight.siem.name}"]
+		}
+		resource "bigip_security_profile_log" "siem" {
+			name = "/Common/siem-log"
+			application {
+				remote_storage = "arcsight"
+				servers = ["10.1.1.1:514"]
+				request_type = "all"
+			}
+			network {
+				publisher = "${bigip_sys_log_publisher.siem.name}"
+				log_acl_match_accept = "enabled"
+			}
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, dosPublisher, url)
+}
+
+func TestAccBigipSecurityProfileLogChain(t *testing.T) {
+	const profilePath = "/mgmt/tm/security/log/profile/~Common~siem-log"
+	objects := map[string]map[string]interface{}{
+		"/mgmt/tm/ltm/pool/~Common~siem-pool": {"name": "siem-pool"},
+	}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Len(t, objects, 1, "only the pool is left")
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSecurityProfileLogChain(server.URL, `dos_network_publisher = "${bigip_sys_log_publisher.siem.name}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_log_destination_arcsight.siem", "remote_hsl", "/Common/siem-hsl"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "application.0.remote_storage", "arcsight"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "application.0.servers.0", "10.1.1.1:514"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "application.0.request_type", "all"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "network.0.publisher", "/Common/siem-publisher"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "network.0.log_acl_match_accept", "enabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "network.0.log_acl_match_drop", "enabled"),
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "dos_network_publisher", "/Common/siem-publisher"),
+					func(s *terraform.State) error {
+						assert.Equal(t, "/Common/siem-hsl", objects["/mgmt/tm/sys/log-config/destination/arcsight/~Common~siem-arcsight"]["forwardTo"])
+						return nil
+					},
+				),
+			},
+			{
+				Config: testBigipSecurityProfileLogChain(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_security_profile_log.siem", "dos_network_publisher", ""),
+					func(s *terraform.State) error {
+						assert.Equal(t, "none", objects[profilePath]["dosNetworkPublisher"], "the publisher is removed from the profile")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSecurityProfileLogMissingPublisher(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(map[string]map[string]interface{}{}))
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_security_profile_log" "dos" {
+						name = "/Common/dos-log"
+						dos_network_publisher = "/Common/missing-publisher"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				ExpectError: regexp.MustCompile("Log publisher /Common/missing-publisher of Security log profile /Common/dos-log does not exist"),
+			},
+		},
+	})
+}
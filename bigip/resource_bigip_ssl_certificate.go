@@ -6,8 +6,16 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"log"
 	"strings"
+	"time"
 )
 
+// sslCertValidation holds the issuer of a certificate and the validators requesting its OCSP response, stapled by the
+// client SSL profiles presenting it
+type sslCertValidation struct {
+	IssuerCert     string   `json:"issuerCert,omitempty"`
+	CertValidators []string `json:"certValidators"`
+}
+
 func resourceBigipSslCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSslCertificateCreate,
@@ -16,7 +24,7 @@ func resourceBigipSslCertificate() *schema.Resource {
 		Delete: resourceBigipSslCertificateDelete,
 		Exists: resourceBigipSslCertificateExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importSslFile,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,8 +44,47 @@ func resourceBigipSslCertificate() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "Common",
+				ForceNew:    true,
 				Description: "Partition of ssl certificate",
 			},
+
+			"issuer_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Certificate of the issuer, the OCSP responses of the certificate are verified with",
+			},
+
+			"cert_validators": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "OCSP certificate validators requesting the status of the certificate, for OCSP stapling",
+			},
+
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate, in RFC 3339 format",
+			},
+
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial number of the certificate",
+			},
+
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate",
+			},
+
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the certificate reported by the device, SHA1:<size>:<sha1>",
+			},
 		},
 	}
 }
@@ -49,14 +96,35 @@ func resourceBigipSslCertificateCreate(d *schema.ResourceData, meta interface{})
 	if !strings.HasSuffix(name, ".crt") {
 		name = name + ".crt"
 	}
-	certpath := d.Get("content").(string)
+	content := d.Get("content").(string)
 	partition := d.Get("partition").(string)
-	err := client.UploadCertificate(name, certpath, partition)
+	err := installSslFile(client, "ssl-cert", name, partition, content, false)
 	if err != nil {
 		return fmt.Errorf("Error in Importing certificate (%s): %s", name, err)
 	}
 	d.SetId(name)
-	return resourceBigipSslCertificateRead(d, meta)
+	if err := setSslCertValidation(d, client, "/"+partition+"/"+name); err != nil {
+		return err
+	}
+	return readAfterCreate(d, meta, resourceBigipSslCertificateRead)
+}
+
+// setSslCertValidation sets the issuer and validators of a certificate, when they are configured or changed
+func setSslCertValidation(d *schema.ResourceData, client *bigip.BigIP, fullPath string) error {
+	validation := &sslCertValidation{
+		IssuerCert:     d.Get("issuer_cert").(string),
+		CertValidators: setToStringSlice(d.Get("cert_validators").(*schema.Set)),
+	}
+	if validation.IssuerCert == "" && len(validation.CertValidators) == 0 && !d.HasChange("issuer_cert") && !d.HasChange("cert_validators") {
+		return nil
+	}
+	if validation.IssuerCert == "" {
+		validation.IssuerCert = "none"
+	}
+	if err := patchEntity(client, validation, "sys", "file", "ssl-cert", fullPath); err != nil {
+		return fmt.Errorf("Error modifying the issuer and validators of certificate (%s): %s", fullPath, err)
+	}
+	return nil
 }
 
 func resourceBigipSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
@@ -69,12 +137,38 @@ func resourceBigipSslCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	name = "~" + partition + "~" + name
 	certificate, err := client.GetCertificate(name)
+	if err != nil {
+		return err
+	}
+	if certificate == nil {
+		log.Printf("[WARN] Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	log.Printf("[INFO] Certificate content:%+v", certificate)
 	d.Set("name", certificate.Name)
 	d.Set("partition", certificate.Partition)
-	if err != nil {
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("subject", certificate.Subject)
+	// The BIG-IP reports the expiration as seconds since the epoch
+	expirationDate := ""
+	if certificate.ExpirationDate != 0 {
+		expirationDate = time.Unix(int64(certificate.ExpirationDate), 0).UTC().Format(time.RFC3339)
+	}
+	d.Set("expiration_date", expirationDate)
+	d.Set("checksum", certificate.Checksum)
+	checkSslFileContent(d, certificate.Checksum)
+
+	var validation sslCertValidation
+	if _, err := getForEntity(client, &validation, "sys", "file", "ssl-cert", name); err != nil {
+		log.Printf("[ERROR] Unable to retrieve the issuer and validators of certificate (%s) (%v)", name, err)
 		return err
 	}
+	if validation.IssuerCert == "none" {
+		validation.IssuerCert = ""
+	}
+	d.Set("issuer_cert", validation.IssuerCert)
+	d.Set("cert_validators", validation.CertValidators)
 	return nil
 }
 
@@ -105,16 +199,20 @@ func resourceBigipSslCertificateUpdate(d *schema.ResourceData, meta interface{})
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
 	log.Println("[INFO] Certificate Name " + name)
-	certpath := d.Get("content").(string)
+	content := d.Get("content").(string)
 	partition := d.Get("partition").(string)
 	if !strings.HasSuffix(name, ".crt") {
 		name = name + ".crt"
 	}
-	err := client.UpdateCertificate(name, certpath, partition)
-	if err != nil {
-		return fmt.Errorf("Error in Importing certificate (%s): %s", name, err)
+	if d.HasChange("content") {
+		err := installSslFile(client, "ssl-cert", name, partition, content, true)
+		if err != nil {
+			return fmt.Errorf("Error in Importing certificate (%s): %s", name, err)
+		}
+	}
+	if err := setSslCertValidation(d, client, "/"+partition+"/"+name); err != nil {
+		return err
 	}
-
 	return resourceBigipSslCertificateRead(d, meta)
 }
 
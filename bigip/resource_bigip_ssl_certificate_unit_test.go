@@ -0,0 +1,176 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSslCertificateAttributes(t *testing.T) {
+	created := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/test-cert.crt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert", func(w http.ResponseWriter, r *http.Request) {
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert/~Common~test-cert.crt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = false
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-cert.crt","partition":"Common","expirationDate":1893456000,
+			"expirationString":"Jan  1 00:00:00 2030 GMT","serialNumber":"0a:1b:2c:3d","subject":"CN=www.example.com,O=Example,C=US"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_ssl_certificate" "test-cert" {
+						name = "test-cert.crt"
+						content = "-----BEGIN CERTIFICATE-----"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "expiration_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "serial_number", "0a:1b:2c:3d"),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "subject", "CN=www.example.com,O=Example,C=US"),
+				),
+			},
+		},
+	})
+}
+
+func testBigipSslCertificateValidation(url, validation string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ssl_certificate" "test-cert" {
+			name = "test-cert.crt"
+			content = "-----BEGIN CERTIFICATE-----"
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, validation, url)
+}
+
+func TestAccBigipSslCertificateValidation(t *testing.T) {
+	var patches []sslCertValidation
+	validation := sslCertValidation{IssuerCert: "none"}
+	created := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/test-cert.crt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert", func(w http.ResponseWriter, r *http.Request) {
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert/~Common~test-cert.crt", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			created = false
+			return
+		case "PATCH":
+			var body sslCertValidation
+			json.NewDecoder(r.Body).Decode(&body)
+			patches = append(patches, body)
+			validation = body
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": "test-cert.crt", "partition": "Common",
+			"issuerCert": validation.IssuerCert, "certValidators": validation.CertValidators,
+		})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSslCertificateValidation(server.URL, `
+					issuer_cert = "/Common/ca.crt"
+					cert_validators = ["/Common/ocsp"]
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "issuer_cert", "/Common/ca.crt"),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "cert_validators.#", "1"),
+				),
+			},
+			{
+				// The issuer is cleared with none, and the validators with an empty list
+				Config: testBigipSslCertificateValidation(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "issuer_cert", ""),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "cert_validators.#", "0"),
+					func(*terraform.State) error {
+						assert.Equal(t, []sslCertValidation{
+							{IssuerCert: "/Common/ca.crt", CertValidators: []string{"/Common/ocsp"}},
+							{IssuerCert: "none", CertValidators: []string{}},
+						}, patches)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestBigipSslCertificateImportPartition(t *testing.T) {
+	r := resourceBigipSslCertificate()
+	for id, expected := range map[string][2]string{
+		"/Tenant/www.example.com.crt": {"Tenant", "www.example.com.crt"},
+		"www.example.com.crt":         {"Common", "www.example.com.crt"},
+	} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		imported, err := r.Importer.State(d, nil)
+		if assert.Nil(t, err) && assert.Len(t, imported, 1) {
+			assert.Equal(t, expected[0], imported[0].Get("partition"), id)
+			assert.Equal(t, expected[1], imported[0].Id(), id)
+		}
+	}
+}
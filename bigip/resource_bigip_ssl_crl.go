@@ -0,0 +1,130 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type sslCrl struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition"`
+	Checksum  string `json:"checksum"`
+}
+
+// bigip_ssl_crl installs a certificate revocation list, the crl_file of client SSL profiles. A new content, e.g.
+// the CRL published by the CA once it is renewed, replaces the installed one in place.
+func resourceBigipSslCrl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSslCrlCreate,
+		Read:   resourceBigipSslCrlRead,
+		Update: resourceBigipSslCrlUpdate,
+		Delete: resourceBigipSslCrlDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigipSslCrlImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the CRL, e.g. client-ca.crl",
+				ValidateFunc: validateNamedName,
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				ForceNew:    true,
+				Description: "Partition the CRL is installed in",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM content of the CRL",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the CRL reported by the device, SHA1:<size>:<sha1>",
+			},
+		},
+	}
+}
+
+func resourceBigipSslCrlCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	log.Printf("[INFO] Installing CRL /%s/%s", partition, name)
+	if err := installSslFile(client, "ssl-crl", name, partition, d.Get("content").(string), false); err != nil {
+		return fmt.Errorf("Error installing CRL (/%s/%s): %s", partition, name, err)
+	}
+	d.SetId("/" + partition + "/" + name)
+	return readAfterCreate(d, meta, resourceBigipSslCrlRead)
+}
+
+func resourceBigipSslCrlRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	fullPath := d.Id()
+	log.Println("[INFO] Reading CRL " + fullPath)
+	var crl sslCrl
+	ok, err := getForEntity(client, &crl, "sys", "file", "ssl-crl", fullPath)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve CRL (%s) (%v)", fullPath, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] CRL (%s) not found, removing from state", fullPath)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", crl.Name)
+	d.Set("partition", crl.Partition)
+	d.Set("checksum", crl.Checksum)
+	checkSslFileContent(d, crl.Checksum)
+	return nil
+}
+
+func resourceBigipSslCrlUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	fullPath := d.Id()
+	log.Println("[INFO] Replacing CRL " + fullPath)
+	err := installSslFile(client, "ssl-crl", d.Get("name").(string), d.Get("partition").(string), d.Get("content").(string), true)
+	if err != nil {
+		return fmt.Errorf("Error replacing CRL (%s): %s", fullPath, err)
+	}
+	return resourceBigipSslCrlRead(d, meta)
+}
+
+func resourceBigipSslCrlDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	fullPath := d.Id()
+	log.Println("[INFO] Deleting CRL " + fullPath)
+	if err := deleteEntity(client, "sys", "file", "ssl-crl", fullPath); err != nil {
+		log.Printf("[ERROR] Unable to Delete CRL (%s) (%v)", fullPath, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipSslCrlImport imports a CRL by full path, its content is not known until it is configured
+func resourceBigipSslCrlImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, errs := validateF5Name(d.Id(), "id"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return []*schema.ResourceData{d}, nil
+}
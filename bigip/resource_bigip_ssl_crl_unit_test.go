@@ -0,0 +1,117 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSslCrl(url, content string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ssl_crl" "client-ca" {
+			name = "client-ca.crl"
+			partition = "Tenant"
+			content = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, content, url)
+}
+
+func TestAccBigipSslCrl(t *testing.T) {
+	var requests []string
+	installed, checksum := false, ""
+	var uploaded []byte
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/client-ca.crl", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, _ = ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-crl", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"client-ca.crl","partition":"Tenant","sourcePath":"file:///var/config/rest/downloads/client-ca.crl"}`, string(b))
+		requests = append(requests, r.Method)
+		installed, checksum = true, sslFileChecksum(string(uploaded))
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-crl/~Tenant~client-ca.crl", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			installed = false
+			return
+		case "PATCH":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]string{"sourcePath": "file:///var/config/rest/downloads/client-ca.crl"}, body)
+			requests = append(requests, r.Method)
+			checksum = sslFileChecksum(string(uploaded))
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !installed {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"client-ca.crl","partition":"Tenant","checksum":"%s"}`, checksum)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if installed {
+				return fmt.Errorf("CRL /Tenant/client-ca.crl was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSslCrl(server.URL, "crl-v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_crl.client-ca", "id", "/Tenant/client-ca.crl"),
+					resource.TestCheckResourceAttr("bigip_ssl_crl.client-ca", "checksum", sslFileChecksum("crl-v1")),
+				),
+			},
+			{
+				// A new CRL replaces the installed one
+				Config: testBigipSslCrl(server.URL, "crl-v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_crl.client-ca", "checksum", sslFileChecksum("crl-v2")),
+					func(*terraform.State) error {
+						assert.Equal(t, []string{"POST", "PATCH"}, requests)
+						return nil
+					},
+				),
+			},
+			{
+				// The CRL replaced on the device is installed again
+				PreConfig: func() {
+					checksum = sslFileChecksum("other")
+				},
+				Config:             testBigipSslCrl(server.URL, "crl-v2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
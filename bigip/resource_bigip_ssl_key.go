@@ -16,7 +16,7 @@ func resourceBigipSslKey() *schema.Resource {
 		Delete: resourceBigipSslKeyDelete,
 		Exists: resourceBigipSslKeyExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importSslFile,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -29,6 +29,7 @@ func resourceBigipSslKey() *schema.Resource {
 			"content": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
 				Description: "Content of SSL certificate key present on local Disk",
 			},
 
@@ -36,8 +37,15 @@ func resourceBigipSslKey() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "Common",
+				ForceNew:    true,
 				Description: "Partition of ssl certificate key",
 			},
+
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the key reported by the device, SHA1:<size>:<sha1>",
+			},
 		},
 	}
 }
@@ -46,18 +54,18 @@ func resourceBigipSslKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Get("name").(string)
 	log.Println("[INFO] Certificate Key Name " + name)
-	certpath := d.Get("content").(string)
+	content := d.Get("content").(string)
 	partition := d.Get("partition").(string)
 	if !strings.HasSuffix(name, ".key") {
 		name = name + ".key"
 	}
-	err := client.UploadKey(name, certpath, partition)
+	err := installSslFile(client, "ssl-key", name, partition, content, false)
 	if err != nil {
 		return fmt.Errorf("Error in Importing certificate key (%s): %s", name, err)
 	}
 
 	d.SetId(name)
-	return resourceBigipSslKeyRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSslKeyRead)
 }
 
 func resourceBigipSslKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -70,10 +78,17 @@ func resourceBigipSslKeyRead(d *schema.ResourceData, meta interface{}) error {
 	partition := d.Get("partition").(string)
 	name = "~" + partition + "~" + name
 	certkey, err := client.GetKey(name)
-	log.Printf("[INFO] SSL key content:%+v", certkey)
 	if err != nil {
 		return err
 	}
+	if certkey == nil {
+		log.Printf("[WARN] Certificate key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set("partition", certkey.Partition)
+	d.Set("checksum", certkey.Checksum)
+	checkSslFileContent(d, certkey.Checksum)
 	return nil
 }
 
@@ -104,12 +119,12 @@ func resourceBigipSslKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
 	log.Println("[INFO] Certificate key Name " + name)
-	certpath := d.Get("content").(string)
+	content := d.Get("content").(string)
 	if !strings.HasSuffix(name, ".key") {
 		name = name + ".key"
 	}
 	partition := d.Get("partition").(string)
-	err := client.UpdateKey(name, certpath, partition)
+	err := installSslFile(client, "ssl-key", name, partition, content, true)
 	if err != nil {
 		return fmt.Errorf("Error in Importing certificate (%s): %s", name, err)
 	}
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSslKey(url, content string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ssl_key" "test-key" {
+			name = "test-key.key"
+			partition = "Tenant"
+			content = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, content, url)
+}
+
+func TestAccBigipSslKeyRotation(t *testing.T) {
+	var requests []string
+	installed, checksum := false, ""
+	var uploaded []byte
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/test-key.key", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, _ = ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-key", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"test-key.key","partition":"Tenant","sourcePath":"file:///var/config/rest/downloads/test-key.key"}`, string(b))
+		requests = append(requests, r.Method)
+		installed, checksum = true, sslFileChecksum(string(uploaded))
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-key/~Tenant~test-key.key", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			installed = false
+			return
+		case "PATCH":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]string{"sourcePath": "file:///var/config/rest/downloads/test-key.key"}, body)
+			requests = append(requests, r.Method)
+			checksum = sslFileChecksum(string(uploaded))
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !installed {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-key.key","partition":"Tenant","checksum":"%s"}`, checksum)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if installed {
+				return fmt.Errorf("Key /Tenant/test-key.key was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSslKey(server.URL, "key-v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_key.test-key", "partition", "Tenant"),
+					resource.TestCheckResourceAttr("bigip_ssl_key.test-key", "checksum", fmt.Sprintf("SHA1:6:%x", sha1.Sum([]byte("key-v1")))),
+				),
+			},
+			{
+				// A rotated key replaces the installed one
+				Config: testBigipSslKey(server.URL, "key-v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_key.test-key", "checksum", sslFileChecksum("key-v2")),
+					func(*terraform.State) error {
+						assert.Equal(t, []string{"POST", "PATCH"}, requests)
+						return nil
+					},
+				),
+			},
+			{
+				// The key replaced on the device is installed again
+				PreConfig: func() {
+					checksum = sslFileChecksum("other")
+				},
+				Config:             testBigipSslKey(server.URL, "key-v2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
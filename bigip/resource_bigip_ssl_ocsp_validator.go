@@ -0,0 +1,256 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type ocspValidator struct {
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description"`
+	ResponderUrl        string `json:"responderUrl"`
+	DnsResolver         string `json:"dnsResolver,omitempty"`
+	RouteDomain         string `json:"routeDomain,omitempty"`
+	ProxyServerPool     string `json:"proxyServerPool,omitempty"`
+	TrustedResponders   string `json:"trustedResponders,omitempty"`
+	StrictRespCertCheck string `json:"strictRespCertCheck,omitempty"`
+	SignHash            string `json:"signHash,omitempty"`
+	SignerCert          string `json:"signerCert,omitempty"`
+	SignerKey           string `json:"signerKey,omitempty"`
+	ConnectionTimeout   int    `json:"connectionTimeout,omitempty"`
+	Timeout             int    `json:"timeout,omitempty"`
+	StatusAge           int    `json:"statusAge,omitempty"`
+	ClockSkew           int    `json:"clockSkew,omitempty"`
+	CacheTimeout        string `json:"cacheTimeout,omitempty"`
+	CacheErrorTimeout   int    `json:"cacheErrorTimeout,omitempty"`
+}
+
+// bigip_ssl_ocsp_validator manages an OCSP certificate validator, which requests the status of certificates from
+// their OCSP responder. It checks the client certificates of the client SSL profiles referencing it by ocsp, and
+// requests the responses stapled to the handshake for the certificates referencing it by cert_validators.
+func resourceBigipSslOcspValidator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSslOcspValidatorCreate,
+		Read:   resourceBigipSslOcspValidatorRead,
+		Update: resourceBigipSslOcspValidatorUpdate,
+		Delete: resourceBigipSslOcspValidatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the validator, e.g. /Common/ocsp",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the validator",
+			},
+			"responder_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the OCSP responder, the one of the authority information access of the certificates when not set",
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS resolver resolving the name of the responder",
+			},
+			"route_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Route domain the responder is reached through",
+			},
+			"proxy_server_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Pool of the HTTP proxies the responder is reached through",
+			},
+			"trusted_responders": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Certificates of the responders trusted to sign the responses",
+			},
+			"strict_resp_cert_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the certificate signing the responses must be issued for OCSP signing (enabled / disabled)",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled"}),
+			},
+			"sign_hash": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Hash the requests are signed with, sha1 or sha256",
+				ValidateFunc: validateStringValue([]string{"sha1", "sha256"}),
+			},
+			"signer_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Certificate the requests are signed with, they are not signed when not set",
+			},
+			"signer_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of signer_cert",
+			},
+			"connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds to wait for the connection to the responder",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds to wait for the response of the responder",
+			},
+			"status_age": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum age of the responses in seconds, 0 not to check it",
+			},
+			"clock_skew": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds the clock of the responder may differ from the one of the BIG-IP",
+			},
+			"cache_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds the responses are cached, or indefinite to cache them until they expire",
+			},
+			"cache_error_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds the errors of the responder are cached",
+			},
+		},
+	}
+}
+
+// dataToOcspValidator returns the validator configured by d, its references that are not set are none
+func dataToOcspValidator(d *schema.ResourceData) *ocspValidator {
+	v := &ocspValidator{
+		Description:         d.Get("description").(string),
+		ResponderUrl:        d.Get("responder_url").(string),
+		DnsResolver:         d.Get("dns_resolver").(string),
+		RouteDomain:         d.Get("route_domain").(string),
+		ProxyServerPool:     d.Get("proxy_server_pool").(string),
+		TrustedResponders:   d.Get("trusted_responders").(string),
+		StrictRespCertCheck: d.Get("strict_resp_cert_check").(string),
+		SignHash:            d.Get("sign_hash").(string),
+		SignerCert:          d.Get("signer_cert").(string),
+		SignerKey:           d.Get("signer_key").(string),
+		ConnectionTimeout:   d.Get("connection_timeout").(int),
+		Timeout:             d.Get("timeout").(int),
+		StatusAge:           d.Get("status_age").(int),
+		ClockSkew:           d.Get("clock_skew").(int),
+		CacheTimeout:        d.Get("cache_timeout").(string),
+		CacheErrorTimeout:   d.Get("cache_error_timeout").(int),
+	}
+	for _, ref := range []*string{&v.DnsResolver, &v.RouteDomain, &v.ProxyServerPool, &v.TrustedResponders, &v.SignerCert, &v.SignerKey} {
+		if *ref == "" {
+			*ref = "none"
+		}
+	}
+	return v
+}
+
+func resourceBigipSslOcspValidatorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating OCSP validator " + name)
+	v := dataToOcspValidator(d)
+	v.Name = name
+	if err := postEntity(client, v, "sys", "crypto", "cert-validator", "ocsp"); err != nil {
+		return fmt.Errorf("Error creating OCSP validator (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSslOcspValidatorRead)
+}
+
+func resourceBigipSslOcspValidatorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading OCSP validator " + name)
+	var v ocspValidator
+	ok, err := getForEntity(client, &v, "sys", "crypto", "cert-validator", "ocsp", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve OCSP validator (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] OCSP validator (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	for _, ref := range []*string{&v.DnsResolver, &v.RouteDomain, &v.ProxyServerPool, &v.TrustedResponders, &v.SignerCert, &v.SignerKey} {
+		if *ref == "none" {
+			*ref = ""
+		}
+	}
+	d.Set("name", name)
+	d.Set("description", v.Description)
+	d.Set("responder_url", v.ResponderUrl)
+	d.Set("dns_resolver", v.DnsResolver)
+	d.Set("route_domain", v.RouteDomain)
+	d.Set("proxy_server_pool", v.ProxyServerPool)
+	d.Set("trusted_responders", v.TrustedResponders)
+	d.Set("strict_resp_cert_check", v.StrictRespCertCheck)
+	d.Set("sign_hash", v.SignHash)
+	d.Set("signer_cert", v.SignerCert)
+	d.Set("signer_key", v.SignerKey)
+	d.Set("connection_timeout", v.ConnectionTimeout)
+	d.Set("timeout", v.Timeout)
+	d.Set("status_age", v.StatusAge)
+	d.Set("clock_skew", v.ClockSkew)
+	d.Set("cache_timeout", v.CacheTimeout)
+	d.Set("cache_error_timeout", v.CacheErrorTimeout)
+	return nil
+}
+
+func resourceBigipSslOcspValidatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Modifying OCSP validator " + name)
+	if err := patchEntity(client, dataToOcspValidator(d), "sys", "crypto", "cert-validator", "ocsp", name); err != nil {
+		return fmt.Errorf("Error modifying OCSP validator (%s): %s", name, err)
+	}
+	return resourceBigipSslOcspValidatorRead(d, meta)
+}
+
+func resourceBigipSslOcspValidatorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting OCSP validator " + name)
+	if err := deleteEntity(client, "sys", "crypto", "cert-validator", "ocsp", name); err != nil {
+		log.Printf("[ERROR] Unable to Delete OCSP validator (%s) (%v)", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSslOcspValidator(url, signer string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ssl_ocsp_validator" "ocsp" {
+			name = "/Common/ocsp"
+			responder_url = "http://ocsp.example.com"
+			dns_resolver = "/Common/resolver"
+			timeout = 10
+			status_age = 86400
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, signer, url)
+}
+
+func TestAccBigipSslOcspValidator(t *testing.T) {
+	const path = "/mgmt/tm/sys/crypto/cert-validator/ocsp/~Common~ocsp"
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/crypto/cert-validator/ocsp", objectsHandler(objects))
+	mux.HandleFunc("/mgmt/tm/sys/crypto/cert-validator/ocsp/", objectsHandler(objects))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if _, ok := objects[path]; ok {
+				return fmt.Errorf("OCSP validator /Common/ocsp was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSslOcspValidator(server.URL, `
+					signer_cert = "/Common/signer.crt"
+					signer_key = "/Common/signer.key"
+					sign_hash = "sha256"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "id", "/Common/ocsp"),
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "responder_url", "http://ocsp.example.com"),
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "signer_cert", "/Common/signer.crt"),
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "proxy_server_pool", ""),
+					func(*terraform.State) error {
+						o := objects[path]
+						assert.Equal(t, "/Common/resolver", o["dnsResolver"])
+						assert.Equal(t, "none", o["proxyServerPool"])
+						assert.Equal(t, "sha256", o["signHash"])
+						assert.Equal(t, float64(10), o["timeout"])
+						assert.Equal(t, float64(86400), o["statusAge"])
+						return nil
+					},
+				),
+			},
+			{
+				// The requests are no longer signed once the signer is removed
+				Config: testBigipSslOcspValidator(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "signer_cert", ""),
+					resource.TestCheckResourceAttr("bigip_ssl_ocsp_validator.ocsp", "sign_hash", "sha256"),
+					func(*terraform.State) error {
+						assert.Equal(t, "none", objects[path]["signerCert"])
+						assert.Equal(t, "none", objects[path]["signerKey"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_ssl_pkcs12 imports a PKCS#12 bundle, a .pfx or .p12 file. The bundle is uploaded and installed by the
+// device, which splits it into a key and a certificate of the same name. The uploaded bundle is removed once
+// installed.
+func resourceBigipSslPkcs12() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSslPkcs12Create,
+		Read:   resourceBigipSslPkcs12Read,
+		Delete: resourceBigipSslPkcs12Delete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the key and certificate installed from the bundle",
+				ValidateFunc: validateNamedName,
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				ForceNew:    true,
+				Description: "Partition the key and certificate are installed in",
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "Content of the bundle, base64 encoded, e.g. filebase64(\"www.example.com.pfx\")",
+				ValidateFunc: validateBase64,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Passphrase the bundle is encrypted with",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the certificate installed, e.g. /Common/www.example.com",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the key installed, e.g. /Common/www.example.com",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate, in RFC 3339 format",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate",
+			},
+		},
+	}
+}
+
+func resourceBigipSslPkcs12Create(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	path := "/" + partition + "/" + name
+	log.Println("[INFO] Importing PKCS#12 bundle " + path)
+	content, err := base64.StdEncoding.DecodeString(d.Get("content_base64").(string))
+	if err != nil {
+		return fmt.Errorf("Error decoding PKCS#12 bundle (%s): %s", path, err)
+	}
+
+	file := partition + "_" + name + ".p12"
+	upload := append(uriFileTransferUploads, file)
+	skip, err := reportDryRun(client, "POST", "/"+strings.Join(upload, "/"), map[string]string{"checksum": sslFileChecksum(string(content))})
+	if err != nil {
+		return err
+	}
+	if !skip {
+		if _, err := client.UploadBytes(content, file); err != nil {
+			return fmt.Errorf("Error uploading PKCS#12 bundle (%s): %s", path, err)
+		}
+	}
+
+	options := []map[string]string{{"from-local-file": bigip.REST_DOWNLOAD_PATH + "/" + file}}
+	if passphrase := d.Get("passphrase").(string); passphrase != "" {
+		options = append(options, map[string]string{"passphrase": passphrase})
+	}
+	install := map[string]interface{}{
+		"command": "install",
+		"name":    path,
+		"options": options,
+	}
+	err = postEntity(client, install, "sys", "crypto", "pkcs12")
+	if !skip {
+		// The bundle holds the private key, it is not kept on the device
+		if _, err := runBash(client, "rm -f "+bigip.REST_DOWNLOAD_PATH+"/"+file); err != nil {
+			log.Printf("[WARN] Unable to remove the uploaded PKCS#12 bundle (%s) (%v)", path, err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error installing PKCS#12 bundle (%s): %s", path, err)
+	}
+	d.SetId(path)
+	return readAfterCreate(d, meta, resourceBigipSslPkcs12Read)
+}
+
+func resourceBigipSslPkcs12Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	path := d.Id()
+	log.Println("[INFO] Reading PKCS#12 bundle " + path)
+	name := strings.Replace(path, "/", "~", -1)
+	certificate, err := client.GetCertificate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve certificate (%s) (%v)", path, err)
+		return err
+	}
+	key, err := client.GetKey(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve key (%s) (%v)", path, err)
+		return err
+	}
+	if certificate == nil || key == nil {
+		log.Printf("[WARN] Certificate or key of PKCS#12 bundle (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	d.Set("certificate", path)
+	d.Set("key", path)
+	d.Set("subject", certificate.Subject)
+	expirationDate := ""
+	if certificate.ExpirationDate != 0 {
+		expirationDate = time.Unix(int64(certificate.ExpirationDate), 0).UTC().Format(time.RFC3339)
+	}
+	d.Set("expiration_date", expirationDate)
+	return nil
+}
+
+func resourceBigipSslPkcs12Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	path := d.Id()
+	log.Println("[INFO] Deleting PKCS#12 bundle " + path)
+	name := strings.Replace(path, "/", "~", -1)
+	if err := client.DeleteCertificate(name); err != nil {
+		log.Printf("[ERROR] Unable to Delete certificate (%s) (%v)", path, err)
+		return err
+	}
+	if err := client.DeleteKey(name); err != nil {
+		log.Printf("[ERROR] Unable to Delete key (%s) (%v)", path, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSslPkcs12(t *testing.T) {
+	var uploaded []byte
+	var removed []string
+	installed := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/Tenant_www.example.com.p12", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, _ = ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/crypto/pkcs12", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"command":"install","name":"/Tenant/www.example.com",
+			"options":[{"from-local-file":"/var/config/rest/downloads/Tenant_www.example.com.p12"},{"passphrase":"secret"}]}`, string(b))
+		installed = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		removed = append(removed, body["utilCmdArgs"])
+		fmt.Fprintf(w, `{"commandResult":""}`)
+	})
+	deleted := map[string]bool{}
+	fileHandler := func(kind, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "DELETE" {
+				deleted[kind] = true
+				return
+			}
+			if !installed || deleted[kind] {
+				w.WriteHeader(404)
+				fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+				return
+			}
+			fmt.Fprint(w, body)
+		}
+	}
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert/~Tenant~www.example.com", fileHandler("ssl-cert",
+		`{"name":"www.example.com","partition":"Tenant","expirationDate":1893456000,"subject":"CN=www.example.com"}`))
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-key/~Tenant~www.example.com", fileHandler("ssl-key",
+		`{"name":"www.example.com","partition":"Tenant"}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if !deleted["ssl-cert"] || !deleted["ssl-key"] {
+				return fmt.Errorf("Certificate and key of /Tenant/www.example.com were not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_ssl_pkcs12" "www" {
+						name = "www.example.com"
+						partition = "Tenant"
+						content_base64 = "UEtDUzEy"
+						passphrase = "secret"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ssl_pkcs12.www", "certificate", "/Tenant/www.example.com"),
+					resource.TestCheckResourceAttr("bigip_ssl_pkcs12.www", "key", "/Tenant/www.example.com"),
+					resource.TestCheckResourceAttr("bigip_ssl_pkcs12.www", "expiration_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("bigip_ssl_pkcs12.www", "subject", "CN=www.example.com"),
+					func(*terraform.State) error {
+						assert.Equal(t, "PKCS12", string(uploaded))
+						assert.Equal(t, []string{"-c 'rm -f /var/config/rest/downloads/Tenant_www.example.com.p12'"}, removed)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
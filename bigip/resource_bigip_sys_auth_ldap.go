@@ -0,0 +1,203 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type authLdap struct {
+	Name           string   `json:"name,omitempty"`
+	Servers        []string `json:"servers,omitempty"`
+	Port           int      `json:"port,omitempty"`
+	BindDn         string   `json:"bindDn,omitempty"`
+	BindPw         string   `json:"bindPw,omitempty"`
+	SearchBaseDn   string   `json:"searchBaseDn,omitempty"`
+	SearchScope    string   `json:"searchScope,omitempty"`
+	LoginAttribute string   `json:"loginAttribute,omitempty"`
+	UserTemplate   string   `json:"userTemplate,omitempty"`
+	Ssl            string   `json:"ssl,omitempty"`
+	SslCaCertFile  string   `json:"sslCaCertFile,omitempty"`
+	SslCheckPeer   string   `json:"sslCheckPeer,omitempty"`
+}
+
+// bigip_sys_auth_ldap manages the LDAP servers users of the BIG-IP are authenticated against when
+// the auth source is ldap or active-directory
+func resourceBigipSysAuthLdap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthLdapCreate,
+		Update: resourceBigipSysAuthLdapUpdate,
+		Read:   resourceBigipSysAuthLdapRead,
+		Delete: resourceBigipSysAuthLdapDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Addresses or host names of the LDAP servers, in the order they are tried",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      389,
+				Description:  "Port of the LDAP servers",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"bind_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name used to search the users, anonymous bind when not set",
+			},
+			"bind_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of bind_dn. It is only written, changes made on the BIG-IP are not detected",
+			},
+			"search_base_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name the users are searched under, e.g. ou=people,dc=example,dc=com",
+			},
+			"search_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sub",
+				Description:  "Depth of the search, sub, one or base",
+				ValidateFunc: validateStringValue([]string{"sub", "one", "base"}),
+			},
+			"login_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute holding the login name of the users, e.g. uid or samaccountname",
+			},
+			"user_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name of the users, with %s for the login name, binding as the user instead of searching",
+			},
+			"ssl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether LDAPS, enabled, or StartTLS, start-tls, is used",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled", "start-tls"}),
+			},
+			"ssl_ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full path of the certificate authority the server certificates are verified with",
+			},
+			"ssl_check_peer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether the certificates of the servers are verified",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthLdapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating LDAP system auth")
+
+	r := getSysAuthLdapConfig(d)
+	r.Name = uriSystemAuth
+	r.BindPw = d.Get("bind_password").(string)
+	err := postEntity(client, r, "auth", "ldap")
+	if err != nil {
+		return fmt.Errorf("Error creating LDAP system auth: %s", err)
+	}
+	d.SetId(uriSystemAuth)
+	return readAfterCreate(d, meta, resourceBigipSysAuthLdapRead)
+}
+
+func resourceBigipSysAuthLdapUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	r := getSysAuthLdapConfig(d)
+	if d.HasChange("bind_password") {
+		log.Println("[INFO] Changing the bind password of LDAP system auth")
+		r.BindPw = d.Get("bind_password").(string)
+	}
+	err := patchEntity(client, r, "auth", "ldap", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error modifying LDAP system auth: %s", err)
+	}
+	return resourceBigipSysAuthLdapRead(d, meta)
+}
+
+func resourceBigipSysAuthLdapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var r authLdap
+	ok, err := getForEntity(client, &r, "auth", "ldap", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve LDAP system auth (%v)", err)
+		return err
+	}
+	if !ok {
+		log.Println("[WARN] LDAP system auth not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("servers", r.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for LDAP system auth: %s", err)
+	}
+	d.Set("port", r.Port)
+	d.Set("bind_dn", r.BindDn)
+	d.Set("search_base_dn", r.SearchBaseDn)
+	d.Set("search_scope", r.SearchScope)
+	d.Set("login_attribute", r.LoginAttribute)
+	d.Set("user_template", r.UserTemplate)
+	d.Set("ssl", r.Ssl)
+	d.Set("ssl_ca_cert_file", r.SslCaCertFile)
+	d.Set("ssl_check_peer", r.SslCheckPeer)
+	// The bind password is not returned, the one of the configuration is kept
+	return nil
+}
+
+func resourceBigipSysAuthLdapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting LDAP system auth")
+
+	err := deleteEntity(client, "auth", "ldap", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete LDAP system auth (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthLdapConfig(d *schema.ResourceData) *authLdap {
+	return &authLdap{
+		Servers:        listToStringSlice(d.Get("servers").([]interface{})),
+		Port:           d.Get("port").(int),
+		BindDn:         d.Get("bind_dn").(string),
+		SearchBaseDn:   d.Get("search_base_dn").(string),
+		SearchScope:    d.Get("search_scope").(string),
+		LoginAttribute: d.Get("login_attribute").(string),
+		UserTemplate:   d.Get("user_template").(string),
+		Ssl:            d.Get("ssl").(string),
+		SslCaCertFile:  d.Get("ssl_ca_cert_file").(string),
+		SslCheckPeer:   d.Get("ssl_check_peer").(string),
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type authRadius struct {
+	Name          string   `json:"name,omitempty"`
+	Servers       []string `json:"servers,omitempty"`
+	ServiceType   string   `json:"serviceType,omitempty"`
+	AccountingBug string   `json:"accountingBug,omitempty"`
+}
+
+// bigip_sys_auth_radius manages the RADIUS servers, bigip_sys_auth_radius_server, users of the BIG-IP
+// are authenticated against when the auth source is radius
+func resourceBigipSysAuthRadius() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthRadiusCreate,
+		Update: resourceBigipSysAuthRadiusUpdate,
+		Read:   resourceBigipSysAuthRadiusRead,
+		Delete: resourceBigipSysAuthRadiusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MaxItems:    2,
+				Description: "Full paths of the primary and secondary RADIUS servers",
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "authenticate-only",
+				Description: "Service-Type of the access requests, e.g. authenticate-only or login",
+			},
+			"accounting_bug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether the accounting bug of some RADIUS servers is worked around",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthRadiusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating RADIUS system auth")
+
+	r := getSysAuthRadiusConfig(d)
+	for _, server := range r.Servers {
+		if err := checkReferenceExists(client, "RADIUS system auth", "RADIUS server", server, "auth", uriRadiusServer, server); err != nil {
+			return err
+		}
+	}
+	r.Name = uriSystemAuth
+	err := postEntity(client, r, "auth", "radius")
+	if err != nil {
+		return fmt.Errorf("Error creating RADIUS system auth: %s", err)
+	}
+	d.SetId(uriSystemAuth)
+	return readAfterCreate(d, meta, resourceBigipSysAuthRadiusRead)
+}
+
+func resourceBigipSysAuthRadiusUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	err := patchEntity(client, getSysAuthRadiusConfig(d), "auth", "radius", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error modifying RADIUS system auth: %s", err)
+	}
+	return resourceBigipSysAuthRadiusRead(d, meta)
+}
+
+func resourceBigipSysAuthRadiusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var r authRadius
+	ok, err := getForEntity(client, &r, "auth", "radius", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve RADIUS system auth (%v)", err)
+		return err
+	}
+	if !ok {
+		log.Println("[WARN] RADIUS system auth not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("servers", r.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for RADIUS system auth: %s", err)
+	}
+	d.Set("service_type", r.ServiceType)
+	d.Set("accounting_bug", r.AccountingBug)
+	return nil
+}
+
+func resourceBigipSysAuthRadiusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting RADIUS system auth")
+
+	err := deleteEntity(client, "auth", "radius", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS system auth (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthRadiusConfig(d *schema.ResourceData) *authRadius {
+	return &authRadius{
+		Servers:       listToStringSlice(d.Get("servers").([]interface{})),
+		ServiceType:   d.Get("service_type").(string),
+		AccountingBug: d.Get("accounting_bug").(string),
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriRadiusServer = "radius-server"
+
+type authRadiusServer struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Server      string `json:"server,omitempty"`
+	Port        int    `json:"port,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+	Timeout     int    `json:"timeout,omitempty"`
+}
+
+func resourceBigipSysAuthRadiusServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthRadiusServerCreate,
+		Update: resourceBigipSysAuthRadiusServerUpdate,
+		Read:   resourceBigipSysAuthRadiusServerRead,
+		Delete: resourceBigipSysAuthRadiusServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the RADIUS server",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Address or host name of the RADIUS server",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1812,
+				Description:  "Authentication port of the RADIUS server",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret shared with the server. It is only written, changes made on the BIG-IP are not detected",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Seconds to wait for an answer of the server",
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthRadiusServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating RADIUS server " + name)
+
+	r := getSysAuthRadiusServerConfig(d)
+	r.Name = name
+	r.Secret = d.Get("secret").(string)
+	err := postEntity(client, r, "auth", uriRadiusServer)
+	if err != nil {
+		return fmt.Errorf("Error creating RADIUS server (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysAuthRadiusServerRead)
+}
+
+func resourceBigipSysAuthRadiusServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	r := getSysAuthRadiusServerConfig(d)
+	if d.HasChange("secret") {
+		log.Println("[INFO] Changing the secret of RADIUS server " + name)
+		r.Secret = d.Get("secret").(string)
+	}
+	err := patchEntity(client, r, "auth", uriRadiusServer, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying RADIUS server (%s): %s", name, err)
+	}
+	return resourceBigipSysAuthRadiusServerRead(d, meta)
+}
+
+func resourceBigipSysAuthRadiusServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r authRadiusServer
+	ok, err := getForEntity(client, &r, "auth", uriRadiusServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve RADIUS server (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] RADIUS server (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("server", r.Server)
+	d.Set("port", r.Port)
+	d.Set("timeout", r.Timeout)
+	// The secret is only returned encrypted, the one of the configuration is kept
+	return nil
+}
+
+func resourceBigipSysAuthRadiusServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting RADIUS server " + name)
+
+	err := deleteEntity(client, "auth", uriRadiusServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthRadiusServerConfig(d *schema.ResourceData) *authRadiusServer {
+	return &authRadiusServer{
+		Description: d.Get("description").(string),
+		Server:      d.Get("server").(string),
+		Port:        d.Get("port").(int),
+		Timeout:     d.Get("timeout").(int),
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_RADIUS_SERVER_NAME = fmt.Sprintf("/%s/test-radius-server", TEST_PARTITION)
+
+var TEST_RADIUS_SERVER_RESOURCE = `
+resource "bigip_sys_auth_radius_server" "test-radius-server" {
+	name = "` + TEST_RADIUS_SERVER_NAME + `"
+	server = "10.10.10.30"
+	port = 1812
+	secret = "s3cret"
+	timeout = 5
+}
+`
+
+func TestAccBigipSysAuthRadiusServer_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthRadiusServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RADIUS_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthRadiusServerExists(TEST_RADIUS_SERVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test-radius-server", "name", TEST_RADIUS_SERVER_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test-radius-server", "server", "10.10.10.30"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test-radius-server", "port", "1812"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test-radius-server", "secret", "s3cret"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test-radius-server", "timeout", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysAuthRadiusServer_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthRadiusServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_RADIUS_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthRadiusServerExists(TEST_RADIUS_SERVER_NAME, true),
+				),
+				ResourceName:      TEST_RADIUS_SERVER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthRadiusServerExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p authRadiusServer
+		ok, err := getForEntity(client, &p, "auth", uriRadiusServer, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("RADIUS server %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("RADIUS server %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysAuthRadiusServerDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_auth_radius_server" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p authRadiusServer
+		ok, err := getForEntity(client, &p, "auth", uriRadiusServer, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("RADIUS server %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriRoleInfo = "role-info"
+
+type remoteRoleInfo struct {
+	Name          string `json:"name,omitempty"`
+	LineOrder     int    `json:"lineOrder,omitempty"`
+	Attribute     string `json:"attribute,omitempty"`
+	Role          string `json:"role,omitempty"`
+	UserPartition string `json:"userPartition,omitempty"`
+	Console       string `json:"console,omitempty"`
+	Deny          string `json:"deny,omitempty"`
+}
+
+// bigip_sys_auth_remote_role_group maps the members of a remote group, e.g. an LDAP group, to a role. The
+// groups are identified by their line order, the first one a user matches applies.
+func resourceBigipSysAuthRemoteRoleGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthRemoteRoleGroupCreate,
+		Update: resourceBigipSysAuthRemoteRoleGroupUpdate,
+		Read:   resourceBigipSysAuthRemoteRoleGroupRead,
+		Delete: resourceBigipSysAuthRemoteRoleGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"line_order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Order in which the groups are matched, lowest first",
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+			"attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Attribute the remote users must have, e.g. memberOf=cn=bigip-admins,ou=groups,dc=example,dc=com",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "no-access",
+				Description:  "Role of the members of the group",
+				ValidateFunc: validateStringValue(userRoles),
+			},
+			"user_partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "all",
+				Description: "Partition the role applies to, or all",
+			},
+			"console": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Shell of the members of the group, tmsh or disabled",
+				ValidateFunc: validateStringValue([]string{"tmsh", "disabled"}),
+			},
+			"deny": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether the members of the group are denied access",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthRemoteRoleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := strconv.Itoa(d.Get("line_order").(int))
+	log.Println("[INFO] Creating remote role group " + name)
+
+	r := getSysAuthRemoteRoleGroupConfig(d)
+	r.Name = name
+	r.LineOrder = d.Get("line_order").(int)
+	err := postEntity(client, r, "auth", "remote-role", uriRoleInfo)
+	if err != nil {
+		return fmt.Errorf("Error creating remote role group (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysAuthRemoteRoleGroupRead)
+}
+
+func resourceBigipSysAuthRemoteRoleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getSysAuthRemoteRoleGroupConfig(d), "auth", "remote-role", uriRoleInfo, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying remote role group (%s): %s", name, err)
+	}
+	return resourceBigipSysAuthRemoteRoleGroupRead(d, meta)
+}
+
+func resourceBigipSysAuthRemoteRoleGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r remoteRoleInfo
+	ok, err := getForEntity(client, &r, "auth", "remote-role", uriRoleInfo, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve remote role group (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Remote role group (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("line_order", r.LineOrder)
+	d.Set("attribute", r.Attribute)
+	d.Set("role", r.Role)
+	d.Set("user_partition", r.UserPartition)
+	d.Set("console", r.Console)
+	d.Set("deny", r.Deny)
+	return nil
+}
+
+func resourceBigipSysAuthRemoteRoleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting remote role group " + name)
+
+	err := deleteEntity(client, "auth", "remote-role", uriRoleInfo, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete remote role group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthRemoteRoleGroupConfig(d *schema.ResourceData) *remoteRoleInfo {
+	return &remoteRoleInfo{
+		Attribute:     d.Get("attribute").(string),
+		Role:          d.Get("role").(string),
+		UserPartition: d.Get("user_partition").(string),
+		Console:       d.Get("console").(string),
+		Deny:          d.Get("deny").(string),
+	}
+}
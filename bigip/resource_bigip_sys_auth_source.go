@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// uriSystemAuth is the name of the LDAP, RADIUS and TACACS+ configuration used to authenticate the users of
+// the BIG-IP itself, it is the only one sys auth source uses
+const uriSystemAuth = "system-auth"
+
+type authSource struct {
+	Type     string `json:"type,omitempty"`
+	Fallback string `json:"fallback,omitempty"`
+}
+
+// bigip_sys_auth_source manages the one auth source of the BIG-IP. There is no Create or Delete API for it:
+// create sets the type, delete sets it back to local.
+func resourceBigipSysAuthSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthSourceCreate,
+		Update: resourceBigipSysAuthSourceUpdate,
+		Read:   resourceBigipSysAuthSourceRead,
+		Delete: resourceBigipSysAuthSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Source users of the BIG-IP are authenticated against, local, ldap, active-directory, radius or tacacs",
+				ValidateFunc: validateStringValue([]string{"local", "ldap", "active-directory", "clientcert-ldap", "radius", "tacacs"}),
+			},
+			"fallback": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "false",
+				Description:  "Whether local accounts are used when the remote servers are unreachable",
+				ValidateFunc: validateStringValue([]string{"true", "false"}),
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring auth source " + d.Get("type").(string))
+
+	if err := setSysAuthSource(d, meta); err != nil {
+		return err
+	}
+	d.SetId("source")
+	return resourceBigipSysAuthSourceRead(d, meta)
+}
+
+func resourceBigipSysAuthSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating auth source")
+
+	if err := setSysAuthSource(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysAuthSourceRead(d, meta)
+}
+
+func resourceBigipSysAuthSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var s authSource
+	_, err := getForEntity(client, &s, "auth", "source")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve auth source (%v)", err)
+		return err
+	}
+	d.Set("type", s.Type)
+	d.Set("fallback", s.Fallback)
+	return nil
+}
+
+func resourceBigipSysAuthSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Resetting auth source to local")
+
+	err := patchEntity(client, &authSource{Type: "local", Fallback: "false"}, "auth", "source")
+	if err != nil {
+		log.Printf("[ERROR] Unable to reset auth source (%v)", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func setSysAuthSource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	s := &authSource{
+		Type:     d.Get("type").(string),
+		Fallback: d.Get("fallback").(string),
+	}
+	err := patchEntity(client, s, "auth", "source")
+	if err != nil {
+		return fmt.Errorf("Error configuring auth source %s: %s", s.Type, err)
+	}
+	return nil
+}
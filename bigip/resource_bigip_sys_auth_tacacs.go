@@ -0,0 +1,168 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type authTacacs struct {
+	Name           string   `json:"name,omitempty"`
+	Servers        []string `json:"servers,omitempty"`
+	Secret         string   `json:"secret,omitempty"`
+	Service        string   `json:"service,omitempty"`
+	Protocol       string   `json:"protocol,omitempty"`
+	Authentication string   `json:"authentication,omitempty"`
+	Accounting     string   `json:"accounting,omitempty"`
+	Encryption     string   `json:"encryption,omitempty"`
+}
+
+// bigip_sys_auth_tacacs manages the TACACS+ servers users of the BIG-IP are authenticated against when
+// the auth source is tacacs
+func resourceBigipSysAuthTacacs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysAuthTacacsCreate,
+		Update: resourceBigipSysAuthTacacsUpdate,
+		Read:   resourceBigipSysAuthTacacsRead,
+		Delete: resourceBigipSysAuthTacacsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Addresses or host names of the TACACS+ servers",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret shared with the servers. It is only written, changes made on the BIG-IP are not detected",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the service the users are authorized for, e.g. ppp",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Protocol of the service, e.g. ip",
+			},
+			"authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "use-first-server",
+				Description:  "Whether only the first server, use-first-server, or every server, use-all-servers, is tried",
+				ValidateFunc: validateStringValue([]string{"use-first-server", "use-all-servers"}),
+			},
+			"accounting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "send-to-first-server",
+				Description:  "Whether accounting is sent to the first server, send-to-first-server, or every server, send-to-all-servers",
+				ValidateFunc: validateStringValue([]string{"send-to-first-server", "send-to-all-servers"}),
+			},
+			"encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether the traffic with the servers is encrypted",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthTacacsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating TACACS+ system auth")
+
+	r := getSysAuthTacacsConfig(d)
+	r.Name = uriSystemAuth
+	r.Secret = d.Get("secret").(string)
+	err := postEntity(client, r, "auth", "tacacs")
+	if err != nil {
+		return fmt.Errorf("Error creating TACACS+ system auth: %s", err)
+	}
+	d.SetId(uriSystemAuth)
+	return readAfterCreate(d, meta, resourceBigipSysAuthTacacsRead)
+}
+
+func resourceBigipSysAuthTacacsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	r := getSysAuthTacacsConfig(d)
+	if d.HasChange("secret") {
+		log.Println("[INFO] Changing the secret of TACACS+ system auth")
+		r.Secret = d.Get("secret").(string)
+	}
+	err := patchEntity(client, r, "auth", "tacacs", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error modifying TACACS+ system auth: %s", err)
+	}
+	return resourceBigipSysAuthTacacsRead(d, meta)
+}
+
+func resourceBigipSysAuthTacacsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var r authTacacs
+	ok, err := getForEntity(client, &r, "auth", "tacacs", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve TACACS+ system auth (%v)", err)
+		return err
+	}
+	if !ok {
+		log.Println("[WARN] TACACS+ system auth not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("servers", r.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for TACACS+ system auth: %s", err)
+	}
+	d.Set("service", r.Service)
+	d.Set("protocol", r.Protocol)
+	d.Set("authentication", r.Authentication)
+	d.Set("accounting", r.Accounting)
+	d.Set("encryption", r.Encryption)
+	// The secret is only returned encrypted, the one of the configuration is kept
+	return nil
+}
+
+func resourceBigipSysAuthTacacsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting TACACS+ system auth")
+
+	err := deleteEntity(client, "auth", "tacacs", d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete TACACS+ system auth (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthTacacsConfig(d *schema.ResourceData) *authTacacs {
+	return &authTacacs{
+		Servers:        listToStringSlice(d.Get("servers").([]interface{})),
+		Service:        d.Get("service").(string),
+		Protocol:       d.Get("protocol").(string),
+		Authentication: d.Get("authentication").(string),
+		Accounting:     d.Get("accounting").(string),
+		Encryption:     d.Get("encryption").(string),
+	}
+}
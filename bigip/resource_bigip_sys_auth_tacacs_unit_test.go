@@ -0,0 +1,109 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysAuthTacacs(url, authentication string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_auth_tacacs" "test-tacacs" {
+			servers = ["10.10.10.40", "10.10.10.41"]
+			secret = "s3cret"
+			service = "ppp"
+			protocol = "ip"
+			authentication = "%s"
+		}
+		resource "bigip_sys_auth_source" "test-source" {
+			type = "tacacs"
+			fallback = "true"
+			depends_on = ["bigip_sys_auth_tacacs.test-tacacs"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, authentication, url)
+}
+
+func TestAccBigipSysAuthTacacsCreate(t *testing.T) {
+	var bodies []string
+	created := false
+	authentication := ""
+	source := `{"type":"local","fallback":"false"}`
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/auth/tacacs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		created = true
+		authentication = "use-first-server"
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/auth/tacacs/system-auth", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			created = false
+			return
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			authentication = "use-all-servers"
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"system-auth","servers":["10.10.10.40","10.10.10.41"],"secret":"$M$xxxx","service":"ppp",
+			"protocol":"ip","authentication":"%s","accounting":"send-to-first-server","encryption":"enabled"}`, authentication)
+	})
+	mux.HandleFunc("/mgmt/tm/auth/source", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			source = string(b)
+		}
+		fmt.Fprint(w, source)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysAuthTacacs(server.URL, "use-first-server"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test-tacacs", "id", "system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test-tacacs", "servers.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_source.test-source", "type", "tacacs"),
+				),
+			},
+			{
+				Config: testBigipSysAuthTacacs(server.URL, "use-all-servers"),
+			},
+		},
+	})
+	assert.False(t, created, "TACACS+ system auth was not deleted")
+	assert.JSONEq(t, `{"type":"local","fallback":"false"}`, source, "Auth source was not reset to local")
+	if assert.Len(t, bodies, 2) {
+		assert.JSONEq(t, `{"name":"system-auth","servers":["10.10.10.40","10.10.10.41"],"secret":"s3cret","service":"ppp",
+			"protocol":"ip","authentication":"use-first-server","accounting":"send-to-first-server","encryption":"enabled"}`, bodies[0])
+		assert.JSONEq(t, `{"servers":["10.10.10.40","10.10.10.41"],"service":"ppp","protocol":"ip","authentication":"use-all-servers",
+			"accounting":"send-to-first-server","encryption":"enabled"}`, bodies[1], "An unchanged secret is not sent again")
+	}
+}
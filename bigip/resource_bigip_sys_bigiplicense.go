@@ -19,6 +19,8 @@ func resourceBigipSysBigiplicense() *schema.Resource {
 		Update: resourceBigipSysBigiplicenseUpdate,
 		Read:   resourceBigipSysBigiplicenseRead,
 		Delete: resourceBigipSysBigiplicenseDelete,
+
+		DeprecationMessage: "use bigip_sys_license, which waits for the license to be installed and re-licenses when the key changes",
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -57,7 +59,7 @@ func resourceBigipSysBigiplicenseCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	d.SetId(registration_key)
-	return resourceBigipSysBigiplicenseRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSysBigiplicenseRead)
 }
 
 func resourceBigipSysBigiplicenseUpdate(d *schema.ResourceData, meta interface{}) error {
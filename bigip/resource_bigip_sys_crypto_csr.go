@@ -0,0 +1,302 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var csrPemRegexp = regexp.MustCompile(`(?s)-----BEGIN CERTIFICATE REQUEST-----.*?-----END CERTIFICATE REQUEST-----`)
+
+type cryptoKey struct {
+	Name         string `json:"name,omitempty"`
+	Partition    string `json:"partition,omitempty"`
+	KeyType      string `json:"keyType,omitempty"`
+	KeySize      int    `json:"keySize,omitempty"`
+	CurveName    string `json:"curveName,omitempty"`
+	SecurityType string `json:"securityType,omitempty"`
+}
+
+type cryptoCsr struct {
+	Name                   string `json:"name,omitempty"`
+	Partition              string `json:"partition,omitempty"`
+	Key                    string `json:"key,omitempty"`
+	CommonName             string `json:"commonName,omitempty"`
+	Organization           string `json:"organization,omitempty"`
+	Ou                     string `json:"ou,omitempty"`
+	City                   string `json:"city,omitempty"`
+	State                  string `json:"state,omitempty"`
+	Country                string `json:"country,omitempty"`
+	EmailAddress           string `json:"emailAddress,omitempty"`
+	SubjectAlternativeName string `json:"subjectAlternativeName,omitempty"`
+}
+
+func resourceBigipSysCryptoCsr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysCryptoCsrCreate,
+		Read:   resourceBigipSysCryptoCsrRead,
+		Update: resourceBigipSysCryptoCsrUpdate,
+		Delete: resourceBigipSysCryptoCsrDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the CSR, the key and the certificate are named after it",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				ForceNew:    true,
+				Description: "Partition of the key, CSR and certificate",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rsa-private",
+				ForceNew:     true,
+				Description:  "Type of the generated key: rsa-private or ec-private",
+				ValidateFunc: validateStringValue([]string{"rsa-private", "ec-private"}),
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2048,
+				ForceNew:    true,
+				Description: "Size in bits of an RSA key",
+			},
+			"curve_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "prime256v1",
+				ForceNew:    true,
+				Description: "Curve of an EC key: prime256v1 or secp384r1",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Common name of the subject",
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"organizational_unit": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"city": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Two letter country code",
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"subject_alternative_names": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Subject alternative names, e.g. DNS:www.example.com or IP:10.0.0.1",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM of the certificate issued for the CSR, installed next to the key",
+			},
+			"csr_pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM of the generated CSR",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the generated key",
+			},
+			"certificate_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the installed certificate",
+			},
+		},
+	}
+}
+
+func resourceBigipSysCryptoCsrCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	keyName := fmt.Sprintf("/%s/%s.key", partition, name)
+
+	log.Println("[INFO] Generating key and CSR " + name)
+
+	key := &cryptoKey{
+		Name:         name + ".key",
+		Partition:    partition,
+		KeyType:      d.Get("key_type").(string),
+		SecurityType: "normal",
+	}
+	if key.KeyType == "ec-private" {
+		key.CurveName = d.Get("curve_name").(string)
+	} else {
+		key.KeySize = d.Get("key_size").(int)
+	}
+	err := postEntity(client, key, "sys", "crypto", "key")
+	if err != nil {
+		return fmt.Errorf("Error generating key (%s): %s", keyName, err)
+	}
+
+	csr := &cryptoCsr{
+		Name:                   name,
+		Partition:              partition,
+		Key:                    keyName,
+		CommonName:             d.Get("common_name").(string),
+		Organization:           d.Get("organization").(string),
+		Ou:                     d.Get("organizational_unit").(string),
+		City:                   d.Get("city").(string),
+		State:                  d.Get("state").(string),
+		Country:                d.Get("country").(string),
+		EmailAddress:           d.Get("email_address").(string),
+		SubjectAlternativeName: strings.Join(listToStringSlice(d.Get("subject_alternative_names").([]interface{})), ", "),
+	}
+	err = postEntity(client, csr, "sys", "crypto", "csr")
+	if err != nil {
+		return fmt.Errorf("Error generating CSR (%s): %s", name, err)
+	}
+	d.SetId(fmt.Sprintf("/%s/%s", partition, name))
+
+	if cert := d.Get("certificate").(string); cert != "" {
+		err = client.UploadCertificate(name+".crt", cert, partition)
+		if err != nil {
+			return fmt.Errorf("Error installing certificate (%s.crt): %s", name, err)
+		}
+	}
+	return readAfterCreate(d, meta, resourceBigipSysCryptoCsrRead)
+}
+
+func resourceBigipSysCryptoCsrRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	keyName := name + ".key"
+
+	var key cryptoKey
+	ok, err := getForEntity(client, &key, "sys", "crypto", "key", keyName)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve key (%s) (%v)", keyName, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Key (%s) not found, removing from state", keyName)
+		d.SetId("")
+		return nil
+	}
+
+	out, err := runBash(client, "tmsh list sys crypto csr "+name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve CSR (%s) (%v)", name, err)
+		return err
+	}
+	pem := csrPemRegexp.FindString(out)
+	if pem == "" {
+		log.Printf("[WARN] CSR (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("csr_pem", pem+"\n")
+	d.Set("key_name", keyName)
+
+	cert, err := client.GetCertificate(strings.Replace(name+".crt", "/", "~", -1))
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve certificate (%s.crt) (%v)", name, err)
+		return err
+	}
+	if cert != nil {
+		d.Set("certificate_name", cert.FullPath)
+	} else {
+		d.Set("certificate_name", "")
+	}
+
+	return nil
+}
+
+func resourceBigipSysCryptoCsrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+
+	if d.HasChange("certificate") {
+		cert := d.Get("certificate").(string)
+		if cert == "" {
+			err := client.DeleteCertificate(fmt.Sprintf("~%s~%s.crt", partition, name))
+			if err != nil {
+				return fmt.Errorf("Error removing certificate (%s.crt): %s", name, err)
+			}
+		} else {
+			log.Printf("[INFO] Installing certificate %s.crt", name)
+			err := client.UploadCertificate(name+".crt", cert, partition)
+			if err != nil {
+				return fmt.Errorf("Error installing certificate (%s.crt): %s", name, err)
+			}
+		}
+	}
+	return resourceBigipSysCryptoCsrRead(d, meta)
+}
+
+func resourceBigipSysCryptoCsrDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting key and CSR " + name)
+
+	if d.Get("certificate_name").(string) != "" {
+		err := client.DeleteCertificate(strings.Replace(name+".crt", "/", "~", -1))
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete certificate (%s.crt) (%v) ", name, err)
+			return err
+		}
+	}
+	err := deleteEntity(client, "sys", "crypto", "csr", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete CSR (%s) (%v) ", name, err)
+		return err
+	}
+	err = deleteEntity(client, "sys", "crypto", "key", name+".key")
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete key (%s.key) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCsrPem = `-----BEGIN CERTIFICATE REQUEST-----
+MIIBWjCCAQACAQAwHjEcMBoGA1UEAwwTd3d3LmV4YW1wbGUuY29tMFkwEwYHKoZI
+-----END CERTIFICATE REQUEST-----`
+
+func testBigipSysCryptoCsr(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_crypto_csr" "test-csr" {
+			name = "www.example.com"
+			common_name = "www.example.com"
+			organization = "Example"
+			country = "US"
+			subject_alternative_names = ["DNS:www.example.com", "DNS:example.com"]
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysCryptoCsrCreate(t *testing.T) {
+	var keyCreated, csrCreated bool
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/crypto/key", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"www.example.com.key","partition":"Common","keyType":"rsa-private","keySize":2048,"securityType":"normal"}`, string(b))
+		keyCreated = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/crypto/key/~Common~www.example.com.key", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			keyCreated = false
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		if !keyCreated {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"www.example.com.key","partition":"Common","keyType":"rsa-private","keySize":2048}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/crypto/csr", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"www.example.com","partition":"Common","key":"/Common/www.example.com.key",
+			"commonName":"www.example.com","organization":"Example","country":"US",
+			"subjectAlternativeName":"DNS:www.example.com, DNS:example.com"}`, string(b))
+		csrCreated = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/crypto/csr/~Common~www.example.com", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		csrCreated = false
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		assert.Equal(t, "-c 'tmsh list sys crypto csr /Common/www.example.com'", body["utilCmdArgs"])
+		result, _ := json.Marshal(map[string]string{"command": "run", "commandResult": "sys crypto csr /Common/www.example.com {\n" + testCsrPem + "\n}\n"})
+		w.Write(result)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/file/ssl-cert/~Common~www.example.com.crt", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysCryptoCsr(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_crypto_csr.test-csr", "csr_pem", testCsrPem+"\n"),
+					resource.TestCheckResourceAttr("bigip_sys_crypto_csr.test-csr", "key_name", "/Common/www.example.com.key"),
+					resource.TestCheckResourceAttr("bigip_sys_crypto_csr.test-csr", "certificate_name", ""),
+				),
+			},
+		},
+	})
+	assert.False(t, keyCreated, "key was not deleted")
+	assert.False(t, csrCreated, "CSR was not deleted")
+}
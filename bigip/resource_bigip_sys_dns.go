@@ -13,6 +13,14 @@ import (
 	"log"
 )
 
+// sysDns is bigip.DNS without omitempty on the lists, which would keep the last entries from being removed
+type sysDns struct {
+	Description  string   `json:"description,omitempty"`
+	NameServers  []string `json:"nameServers"`
+	NumberOfDots int      `json:"numberOfDots,omitempty"`
+	Search       []string `json:"search"`
+}
+
 func resourceBigipSysDns() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSysDnsCreate,
@@ -42,6 +50,7 @@ func resourceBigipSysDns() *schema.Resource {
 			"number_of_dots": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				Description: "how many DNS Servers",
 			},
 
@@ -80,7 +89,7 @@ func resourceBigipSysDnsCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(description)
 
-	return resourceBigipSysDnsRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSysDnsRead)
 }
 
 func resourceBigipSysDnsUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -90,14 +99,14 @@ func resourceBigipSysDnsUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Println("[INFO] Updating DNS " + description)
 
-	r := &bigip.DNS{
+	r := &sysDns{
 		Description:  description,
 		NameServers:  setToStringSlice(d.Get("name_servers").(*schema.Set)),
 		NumberOfDots: d.Get("number_of_dots").(int),
 		Search:       setToStringSlice(d.Get("search").(*schema.Set)),
 	}
 
-	err := client.ModifyDNS(r)
+	err := putEntity(client, r, "sys", "dns")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Modify DNS (%s) (%v) ", description, err)
 		return err
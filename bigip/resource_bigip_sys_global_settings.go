@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriGlobalSettings = "global-settings"
+
+type sysGlobalSettings struct {
+	Hostname                 string `json:"hostname,omitempty"`
+	GuiSetup                 string `json:"guiSetup,omitempty"`
+	MgmtDhcp                 string `json:"mgmtDhcp,omitempty"`
+	GuiSecurityBanner        string `json:"guiSecurityBanner,omitempty"`
+	GuiSecurityBannerText    string `json:"guiSecurityBannerText,omitempty"`
+	ConsoleInactivityTimeout int    `json:"consoleInactivityTimeout"`
+}
+
+// bigip_sys_global_settings manages the global settings of the BIG-IP. There is only one, the id of the
+// resource is global-settings. Delete leaves the settings unchanged.
+func resourceBigipSysGlobalSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysGlobalSettingsCreate,
+		Update: resourceBigipSysGlobalSettingsUpdate,
+		Read:   resourceBigipSysGlobalSettingsRead,
+		Delete: resourceBigipSysGlobalSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fully qualified host name of the BIG-IP",
+			},
+			"gui_setup": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the setup utility is run at the next GUI login, disabled once the BIG-IP is configured",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"mgmt_dhcp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the management interface is configured with DHCP, enabled, disabled, dhcpv4 or dhcpv6",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled", "dhcpv4", "dhcpv6"}),
+			},
+			"gui_security_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether gui_security_banner_text is shown on the GUI login page",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"gui_security_banner_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the GUI login page banner",
+			},
+			"console_inactivity_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds before an idle console session is logged out, 0 to never log them out",
+			},
+		},
+	}
+}
+
+func resourceBigipSysGlobalSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring global settings")
+
+	if err := setSysGlobalSettings(d, meta); err != nil {
+		return err
+	}
+	d.SetId(uriGlobalSettings)
+	return resourceBigipSysGlobalSettingsRead(d, meta)
+}
+
+func resourceBigipSysGlobalSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating global settings")
+
+	if err := setSysGlobalSettings(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysGlobalSettingsRead(d, meta)
+}
+
+func resourceBigipSysGlobalSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var g sysGlobalSettings
+	_, err := getForEntity(client, &g, "sys", uriGlobalSettings)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve global settings (%v)", err)
+		return err
+	}
+	d.Set("hostname", g.Hostname)
+	d.Set("gui_setup", g.GuiSetup)
+	d.Set("mgmt_dhcp", g.MgmtDhcp)
+	d.Set("gui_security_banner", g.GuiSecurityBanner)
+	d.Set("gui_security_banner_text", g.GuiSecurityBannerText)
+	d.Set("console_inactivity_timeout", g.ConsoleInactivityTimeout)
+	return nil
+}
+
+func resourceBigipSysGlobalSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// There are no defaults to go back to for e.g. the host name, the settings are left as they are
+	log.Println("[INFO] Leaving global settings unchanged")
+	d.SetId("")
+	return nil
+}
+
+func setSysGlobalSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	g := &sysGlobalSettings{
+		Hostname:                 d.Get("hostname").(string),
+		GuiSetup:                 d.Get("gui_setup").(string),
+		MgmtDhcp:                 d.Get("mgmt_dhcp").(string),
+		GuiSecurityBanner:        d.Get("gui_security_banner").(string),
+		GuiSecurityBannerText:    d.Get("gui_security_banner_text").(string),
+		ConsoleInactivityTimeout: d.Get("console_inactivity_timeout").(int),
+	}
+	err := patchEntity(client, g, "sys", uriGlobalSettings)
+	if err != nil {
+		return fmt.Errorf("Error configuring global settings: %s", err)
+	}
+	return nil
+}
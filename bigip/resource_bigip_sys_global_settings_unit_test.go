@@ -0,0 +1,66 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSysGlobalSettingsCreate(t *testing.T) {
+	settings := map[string]interface{}{"hostname": "bigip1", "guiSetup": "enabled", "mgmtDhcp": "enabled",
+		"guiSecurityBanner": "enabled", "guiSecurityBannerText": "Welcome to the BIG-IP Configuration Utility.", "consoleInactivityTimeout": 0}
+	var patches []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/global-settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			patches = append(patches, string(b))
+			json.Unmarshal(b, &settings)
+		}
+		json.NewEncoder(w).Encode(settings)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_sys_global_settings" "settings" {
+						hostname = "bigip1.example.com"
+						gui_setup = "disabled"
+						mgmt_dhcp = "disabled"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.settings", "id", "global-settings"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.settings", "hostname", "bigip1.example.com"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.settings", "gui_security_banner", "enabled"),
+				),
+			},
+		},
+	})
+	if assert.Len(t, patches, 1, "The global settings are left unchanged on destroy") {
+		assert.JSONEq(t, `{"hostname":"bigip1.example.com","guiSetup":"disabled","mgmtDhcp":"disabled","consoleInactivityTimeout":0}`,
+			patches[0], "Settings which are not set are left as they are")
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type sysHttpd struct {
+	Allow                   []string `json:"allow,omitempty"`
+	AuthPamIdleTimeout      int      `json:"authPamIdleTimeout,omitempty"`
+	AuthPamDashboardTimeout string   `json:"authPamDashboardTimeout,omitempty"`
+	MaxClients              int      `json:"maxClients,omitempty"`
+	SslCiphersuite          string   `json:"sslCiphersuite,omitempty"`
+	SslProtocol             string   `json:"sslProtocol,omitempty"`
+}
+
+// bigip_sys_httpd manages the web server of the management interface, serving the GUI and the REST API.
+// There is only one, the id of the resource is httpd. Delete leaves the settings unchanged.
+func resourceBigipSysHttpd() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysHttpdCreate,
+		Update: resourceBigipSysHttpdUpdate,
+		Read:   resourceBigipSysHttpdRead,
+		Delete: resourceBigipSysHttpdDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "Addresses or networks allowed to connect, e.g. 10.0.0.0/255.0.0.0, or All",
+			},
+			"auth_pam_idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Seconds before an idle GUI session is logged out",
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+			"auth_pam_dashboard_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the dashboard, which refreshes itself, is logged out too, on or off",
+				ValidateFunc: validateStringValue([]string{"on", "off"}),
+			},
+			"max_clients": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of concurrent connections",
+			},
+			"ssl_ciphersuite": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OpenSSL cipher string of the ciphers accepted, e.g. ECDHE-RSA-AES256-GCM-SHA384:ECDHE-RSA-AES128-GCM-SHA256",
+			},
+			"ssl_protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Apache SSLProtocol of the protocols accepted, e.g. all -SSLv2 -SSLv3 -TLSv1 -TLSv1.1",
+			},
+		},
+	}
+}
+
+func resourceBigipSysHttpdCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring httpd")
+
+	if err := setSysHttpd(d, meta); err != nil {
+		return err
+	}
+	d.SetId("httpd")
+	return resourceBigipSysHttpdRead(d, meta)
+}
+
+func resourceBigipSysHttpdUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating httpd")
+
+	if err := setSysHttpd(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysHttpdRead(d, meta)
+}
+
+func resourceBigipSysHttpdRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var h sysHttpd
+	_, err := getForEntity(client, &h, "sys", "httpd")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve httpd (%v)", err)
+		return err
+	}
+	if err := d.Set("allow", h.Allow); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Allow to state for httpd: %s", err)
+	}
+	d.Set("auth_pam_idle_timeout", h.AuthPamIdleTimeout)
+	d.Set("auth_pam_dashboard_timeout", h.AuthPamDashboardTimeout)
+	d.Set("max_clients", h.MaxClients)
+	d.Set("ssl_ciphersuite", h.SslCiphersuite)
+	d.Set("ssl_protocol", h.SslProtocol)
+	return nil
+}
+
+func resourceBigipSysHttpdDelete(d *schema.ResourceData, meta interface{}) error {
+	// Resetting httpd could open it to addresses or protocols it was closed to, its settings are left as they are
+	log.Println("[INFO] Leaving httpd settings unchanged")
+	d.SetId("")
+	return nil
+}
+
+func setSysHttpd(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	h := &sysHttpd{
+		Allow:                   setToStringSlice(d.Get("allow").(*schema.Set)),
+		AuthPamIdleTimeout:      d.Get("auth_pam_idle_timeout").(int),
+		AuthPamDashboardTimeout: d.Get("auth_pam_dashboard_timeout").(string),
+		MaxClients:              d.Get("max_clients").(int),
+		SslCiphersuite:          d.Get("ssl_ciphersuite").(string),
+		SslProtocol:             d.Get("ssl_protocol").(string),
+	}
+	err := patchEntity(client, h, "sys", "httpd")
+	if err != nil {
+		return fmt.Errorf("Error configuring httpd: %s", err)
+	}
+	return nil
+}
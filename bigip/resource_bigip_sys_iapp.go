@@ -14,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// bigip_sys_iapp deploys an application service from an iApp template. Its definition, the template with its
+// variables, tables and lists, is set by the arguments, or by jsonfile for the definitions exported from a device,
+// and is read back so that changes made outside of Terraform show in the plan.
 func resourceBigipSysIapp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSysIappCreate,
@@ -27,102 +30,119 @@ func resourceBigipSysIapp() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 
 			"jsonfile": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Definition of the application service in JSON, as read from the REST API, the arguments set override its properties",
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSON,
 			},
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the application service, the name of jsonfile by default",
 			},
 
 			"partition": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "Common",
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Description: "Partition of the application service, it has to match the partition of jsonfile if set there",
 			},
 
 			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
-				//Default:     "This is iApp template for application objects",
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the application service",
 			},
 
 			"devicegroup": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "none",
-				Description: "BIG-IP password",
+				Description: "Device group of the application service, set when inherited_devicegroup is false",
 			},
 			"execute_action": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Description: "Template action run when the application service is updated, definition by default",
 			},
 			"inherited_devicegroup": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "true",
-				Description: "BIG-IP password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "true",
+				Description:  "Whether the application service has the device group of its partition",
+				ValidateFunc: validateStringValue([]string{"true", "false"}),
 			},
 
 			"inherited_traffic_group": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "true",
-				Description: "BIG-IP password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "true",
+				Description:  "Whether the application service has the traffic group of its partition",
+				ValidateFunc: validateStringValue([]string{"true", "false"}),
 			},
 			"strict_updates": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "enabled",
-				Description: "BIG-IP password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether the objects of the application service can only be changed through the application service",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled"}),
 			},
 
 			"template": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Computed:    true,
+				Description: "iApp template of the application service, e.g. /Common/f5.http",
 			},
 
 			"template_modified": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "no",
-				Description: "BIG-IP password",
+				Description: "Whether the template was modified since the application service was deployed",
 			},
 			"template_prerequisite_errors": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Description: "Prerequisites of the template missing on the device",
 			},
 
 			"traffic_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "/Common/traffic-group-1",
-				Description: "BIG-IP password",
+				Description: "Traffic group of the application service, set when inherited_traffic_group is false",
 			},
 			"lists": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the list variable of the template",
+						},
 						"encrypted": {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "no",
-							Description: "Name of origin",
-							//ValidateFunc: validateF5Name,
+							Description: "Whether the values are encrypted",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Values of the list",
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
-							//ValidateFunc: validateF5Name,
+							Description: "Single value of the list",
+							Deprecated:  "Use values",
 						},
 					},
 				},
@@ -133,16 +153,21 @@ func resourceBigipSysIapp() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the metadata",
+						},
 						"persists": {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "true",
-							Description: "Name of origin",
+							Description: "Whether the metadata is kept when the application service is updated",
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Value of the metadata",
 						},
 					},
 				},
@@ -156,7 +181,7 @@ func resourceBigipSysIapp() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Name of the table variable of the template",
 						},
 						"column_names": {
 							Type:     schema.TypeList,
@@ -167,7 +192,7 @@ func resourceBigipSysIapp() *schema.Resource {
 						"encrypted_columns": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Encrypted columns of the table",
 						},
 
 						"rows": {
@@ -197,19 +222,19 @@ func resourceBigipSysIapp() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Name of the variable of the template",
 						},
 
 						"encrypted": {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "no",
-							Description: "Name of origin",
+							Description: "Whether the value is encrypted, it is not read back then",
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Value of the variable",
 						},
 					},
 				},
@@ -217,31 +242,40 @@ func resourceBigipSysIapp() *schema.Resource {
 		},
 	}
 }
+
 func resourceBigipSysIappCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-
-	log.Println("[INFO] Creating Iapp       " + name)
-	p := dataToIapp(name, d)
-	d.SetId(name)
-	d.SetId(description)
-	err := client.CreateIapp(&p)
 
+	p, err := dataToIapp(d)
 	if err != nil {
-		log.Printf("[ERROR] Unable to Create Iapp  (%s) (%v) ", name, err)
 		return err
 	}
-	d.SetId(name)
-	return resourceBigipSysIappRead(d, meta)
+	if p.Name == "" {
+		return fmt.Errorf("Either name or the name of jsonfile has to be set")
+	}
+	log.Println("[INFO] Creating Iapp " + p.Name)
+	if err := postEntity(client, p, "sys", "application", "service"); err != nil {
+		log.Printf("[ERROR] Unable to Create Iapp  (%s) (%v) ", p.Name, err)
+		return err
+	}
+	d.SetId(p.Name)
+	return readAfterCreate(d, meta, resourceBigipSysIappRead)
 }
 
 func resourceBigipSysIappUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
 	log.Println("[INFO] Updating Iapp " + name)
-	p := dataToIapp(name, d)
-	err := client.UpdateIapp(name, &p)
+	p, err := dataToIapp(d)
+	if err != nil {
+		return err
+	}
+	// The template only deploys the changed definition when its definition action is run
+	p.ExecuteAction = d.Get("execute_action").(string)
+	if p.ExecuteAction == "" {
+		p.ExecuteAction = "definition"
+	}
+	err = patchEntity(client, p, "sys", "application", "service", iappPath(d))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Iapp  (%s) ", err)
 		return err
@@ -256,25 +290,26 @@ func resourceBigipSysIappRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
 
 	log.Println("[INFO] Reading Iapp " + name)
-	// Create a slice and append three strings to it.
 
-	p, err := client.Iapp(name)
+	var p iappService
+	ok, err := getForEntity(client, &p, "sys", "application", "service", iappPath(d))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Iapp  (%s) (%v)", name, err)
 		return err
 	}
-	if p == nil {
+	if !ok {
 		log.Printf("[WARN] IApp (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 	d.Set("name", name)
 	d.Set("partition", p.Partition)
-	if err := d.Set("devicegroup", p.DeviceGroup); err != nil {
-		return fmt.Errorf("[DEBUG] Error Saving DeviceGroup  to state for Devicegroup  (%s): %s", d.Id(), err)
-	}
-	if err := d.Set("execute_action", p.ExecuteAction); err != nil {
-		return fmt.Errorf("[DEBUG] Error Saving ExecuteAction  to state for ExecuteAction  (%s): %s", d.Id(), err)
+	d.Set("template", p.Template)
+	// The groups are left out when they are inherited from a partition without one
+	if p.DeviceGroup != "" {
+		if err := d.Set("devicegroup", p.DeviceGroup); err != nil {
+			return fmt.Errorf("[DEBUG] Error Saving DeviceGroup  to state for Devicegroup  (%s): %s", d.Id(), err)
+		}
 	}
 	if err := d.Set("inherited_devicegroup", p.InheritedDevicegroup); err != nil {
 		return fmt.Errorf("[DEBUG] Error Saving InheritedDevicegroup  to state for InheritedDevicegroup  (%s): %s", d.Id(), err)
@@ -289,20 +324,36 @@ func resourceBigipSysIappRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] Error Saving TemplateModified  to state for TemplateModified  (%s): %s", d.Id(), err)
 	}
 	d.Set("template_prerequisite_errors", p.TemplatePrerequisiteErrors)
-	if err := d.Set("traffic_group", p.TrafficGroup); err != nil {
-		return fmt.Errorf("[DEBUG] Error Saving TrafficGroup to state for Iapp  (%s): %s", d.Id(), err)
+	if p.TrafficGroup != "" {
+		if err := d.Set("traffic_group", p.TrafficGroup); err != nil {
+			return fmt.Errorf("[DEBUG] Error Saving TrafficGroup to state for Iapp  (%s): %s", d.Id(), err)
+		}
+	}
+
+	// The definition set by jsonfile isn't read back, only the one set by the arguments
+	if d.Get("jsonfile").(string) != "" {
+		return nil
+	}
+	d.Set("description", p.Description)
+	if err := d.Set("variables", flattenIappVariables(p.Variables, d.Get("variables").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Variables to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tables", flattenIappTables(p.Tables, d.Get("tables").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Tables to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("lists", flattenIappLists(p.Lists, d.Get("lists").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Lists to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("metadata", flattenIappMetadata(p.Metadata, d.Get("metadata").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Metadata to state for Iapp  (%s): %s", d.Id(), err)
 	}
-	d.Set("tables", p.Tables)
-	d.Set("lists", p.Lists)
-	d.Set("variables", p.Variables)
-	d.Set("metadata", p.Metadata)
 	return nil
 }
 
 func resourceBigipSysIappDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
-	err := client.DeleteIapp(name)
+	err := deleteEntity(client, "sys", "application", "service", iappPath(d))
 	if err != nil {
 		log.Printf("[ERROR] Unable to Delete Iapp  (%s) (%v)", name, err)
 		return err
@@ -311,19 +362,270 @@ func resourceBigipSysIappDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-// This function "IappToData...." helps to unmarshal json to Go struct
-func IappToData(p *bigip.Iapp, d *schema.ResourceData) error {
+// iappService is the definition of an application service. The client's Iapp has no names for metadata, and
+// leaves out empty values of lists and variables.
+type iappService struct {
+	Name                       string         `json:"name,omitempty"`
+	Partition                  string         `json:"partition,omitempty"`
+	Description                string         `json:"description,omitempty"`
+	DeviceGroup                string         `json:"deviceGroup,omitempty"`
+	ExecuteAction              string         `json:"execute-action,omitempty"`
+	InheritedDevicegroup       string         `json:"inheritedDevicegroup,omitempty"`
+	InheritedTrafficGroup      string         `json:"inheritedTrafficGroup,omitempty"`
+	StrictUpdates              string         `json:"strictUpdates,omitempty"`
+	Template                   string         `json:"template,omitempty"`
+	TemplateModified           string         `json:"templateModified,omitempty"`
+	TemplatePrerequisiteErrors string         `json:"templatePrerequisiteErrors,omitempty"`
+	TrafficGroup               string         `json:"trafficGroup,omitempty"`
+	Tables                     []iappTable    `json:"tables,omitempty"`
+	Lists                      []iappList     `json:"lists,omitempty"`
+	Variables                  []iappVariable `json:"variables,omitempty"`
+	Metadata                   []iappMetadata `json:"metadata,omitempty"`
+}
 
-	return nil
+type iappTable struct {
+	Name        string    `json:"name"`
+	ColumnNames []string  `json:"columnNames,omitempty"`
+	Rows        []iappRow `json:"rows,omitempty"`
 }
 
-func dataToIapp(name string, d *schema.ResourceData) bigip.Iapp {
-	var p bigip.Iapp
+type iappRow struct {
+	Row []string `json:"row"`
+}
 
-	jsonblob := []byte(d.Get("jsonfile").(string))
-	err := json.Unmarshal(jsonblob, &p)
-	if err != nil {
-		fmt.Println("error", err)
+type iappList struct {
+	Name      string   `json:"name"`
+	Encrypted string   `json:"encrypted,omitempty"`
+	Value     []string `json:"value"`
+}
+
+type iappVariable struct {
+	Name      string `json:"name"`
+	Encrypted string `json:"encrypted,omitempty"`
+	Value     string `json:"value"`
+}
+
+type iappMetadata struct {
+	Name    string `json:"name"`
+	Persist string `json:"persist,omitempty"`
+	Value   string `json:"value"`
+}
+
+// dataToIapp returns the definition of the application service: the one of jsonfile, with the arguments set in
+// place of its properties. The variables, tables, lists and metadata set replace those of jsonfile, the arguments
+// left to their default only set the properties missing in jsonfile.
+func dataToIapp(d *schema.ResourceData) (iappService, error) {
+	var p iappService
+
+	if jsonfile := d.Get("jsonfile").(string); jsonfile != "" {
+		if err := json.Unmarshal([]byte(jsonfile), &p); err != nil {
+			return p, fmt.Errorf("Error reading jsonfile: %s", err)
+		}
+	}
+	if name := d.Get("name").(string); name != "" {
+		p.Name = name
+	}
+	if p.Partition == "" {
+		p.Partition = d.Get("partition").(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		p.Description = v.(string)
+	}
+	if v, ok := d.GetOk("template"); ok {
+		p.Template = v.(string)
+	}
+	if p.StrictUpdates == "" {
+		p.StrictUpdates = d.Get("strict_updates").(string)
+	}
+	if p.InheritedDevicegroup == "" {
+		p.InheritedDevicegroup = d.Get("inherited_devicegroup").(string)
+	}
+	if p.InheritedDevicegroup == "false" && p.DeviceGroup == "" {
+		p.DeviceGroup = d.Get("devicegroup").(string)
 	}
-	return p
+	if p.InheritedTrafficGroup == "" {
+		p.InheritedTrafficGroup = d.Get("inherited_traffic_group").(string)
+	}
+	if p.InheritedTrafficGroup == "false" && p.TrafficGroup == "" {
+		p.TrafficGroup = d.Get("traffic_group").(string)
+	}
+	// Read-only, set by the device
+	p.TemplateModified = ""
+	p.TemplatePrerequisiteErrors = ""
+
+	if v, ok := d.GetOk("variables"); ok {
+		p.Variables = nil
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			p.Variables = append(p.Variables, iappVariable{
+				Name:      m["name"].(string),
+				Encrypted: m["encrypted"].(string),
+				Value:     m["value"].(string),
+			})
+		}
+	}
+	if v, ok := d.GetOk("tables"); ok {
+		p.Tables = nil
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			t := iappTable{
+				Name:        m["name"].(string),
+				ColumnNames: listToStringSlice(m["column_names"].([]interface{})),
+			}
+			for _, r := range m["rows"].([]interface{}) {
+				var row []interface{}
+				if r != nil {
+					row = r.(map[string]interface{})["row"].([]interface{})
+				}
+				t.Rows = append(t.Rows, iappRow{Row: listToStringSlice(row)})
+			}
+			p.Tables = append(p.Tables, t)
+		}
+	}
+	if v, ok := d.GetOk("lists"); ok {
+		p.Lists = nil
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			l := iappList{
+				Name:      m["name"].(string),
+				Encrypted: m["encrypted"].(string),
+				Value:     listToStringSlice(m["values"].([]interface{})),
+			}
+			if value := m["value"].(string); value != "" && len(l.Value) == 0 {
+				l.Value = []string{value}
+			}
+			p.Lists = append(p.Lists, l)
+		}
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		p.Metadata = nil
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			p.Metadata = append(p.Metadata, iappMetadata{
+				Name:    m["name"].(string),
+				Persist: m["persists"].(string),
+				Value:   m["value"].(string),
+			})
+		}
+	}
+	return p, nil
+}
+
+// configuredIappItems returns the items of a variables, tables, lists or metadata argument by name
+func configuredIappItems(configured []interface{}) map[string]map[string]interface{} {
+	items := make(map[string]map[string]interface{})
+	for _, item := range configured {
+		if m, ok := item.(map[string]interface{}); ok {
+			items[m["name"].(string)] = m
+		}
+	}
+	return items
+}
+
+// orderIappItems returns the names of the items read from the device in the order they are configured, leaving out
+// those which aren't: the template adds the variables and tables it has defaults for. All of them are returned
+// when none is configured, e.g. on import.
+func orderIappItems(device []string, configured []interface{}) []string {
+	if len(configured) == 0 {
+		return device
+	}
+	found := make(map[string]bool)
+	for _, name := range device {
+		found[name] = true
+	}
+	var names []string
+	for _, item := range configured {
+		if m, ok := item.(map[string]interface{}); ok && found[m["name"].(string)] {
+			names = append(names, m["name"].(string))
+		}
+	}
+	return names
+}
+
+func flattenIappVariables(variables []iappVariable, configured []interface{}) []interface{} {
+	byName := make(map[string]iappVariable)
+	var names []string
+	for _, v := range variables {
+		byName[v.Name] = v
+		names = append(names, v.Name)
+	}
+	items := configuredIappItems(configured)
+	var result []interface{}
+	for _, name := range orderIappItems(names, configured) {
+		v := byName[name]
+		value := v.Value
+		if v.Encrypted == "yes" && items[name] != nil {
+			// The device doesn't return encrypted values
+			value = items[name]["value"].(string)
+		}
+		result = append(result, map[string]interface{}{"name": v.Name, "encrypted": v.Encrypted, "value": value})
+	}
+	return result
+}
+
+func flattenIappTables(tables []iappTable, configured []interface{}) []interface{} {
+	byName := make(map[string]iappTable)
+	var names []string
+	for _, t := range tables {
+		byName[t.Name] = t
+		names = append(names, t.Name)
+	}
+	items := configuredIappItems(configured)
+	var result []interface{}
+	for _, name := range orderIappItems(names, configured) {
+		t := byName[name]
+		var rows []interface{}
+		for _, r := range t.Rows {
+			rows = append(rows, map[string]interface{}{"row": r.Row})
+		}
+		table := map[string]interface{}{"name": t.Name, "column_names": t.ColumnNames, "rows": rows}
+		if items[name] != nil {
+			table["encrypted_columns"] = items[name]["encrypted_columns"]
+		}
+		result = append(result, table)
+	}
+	return result
+}
+
+func flattenIappLists(lists []iappList, configured []interface{}) []interface{} {
+	byName := make(map[string]iappList)
+	var names []string
+	for _, l := range lists {
+		byName[l.Name] = l
+		names = append(names, l.Name)
+	}
+	items := configuredIappItems(configured)
+	var result []interface{}
+	for _, name := range orderIappItems(names, configured) {
+		l := byName[name]
+		list := map[string]interface{}{"name": l.Name, "encrypted": l.Encrypted, "values": l.Value}
+		if m := items[name]; m != nil && m["value"].(string) != "" && len(m["values"].([]interface{})) == 0 && len(l.Value) == 1 {
+			// Set by the deprecated value
+			list["value"], list["values"] = l.Value[0], nil
+		}
+		result = append(result, list)
+	}
+	return result
+}
+
+func flattenIappMetadata(metadata []iappMetadata, configured []interface{}) []interface{} {
+	byName := make(map[string]iappMetadata)
+	var names []string
+	for _, m := range metadata {
+		byName[m.Name] = m
+		names = append(names, m.Name)
+	}
+	var result []interface{}
+	for _, name := range orderIappItems(names, configured) {
+		m := byName[name]
+		result = append(result, map[string]interface{}{"name": m.Name, "persists": m.Persist, "value": m.Value})
+	}
+	return result
+}
+
+// iappPath returns the path of an application service, /<partition>/<name>.app/<name>. The client functions
+// only know the application services of Common.
+func iappPath(d *schema.ResourceData) string {
+	name := d.Id()
+	return fmt.Sprintf("/%s/%s.app/%s", d.Get("partition").(string), name, name)
 }
@@ -0,0 +1,232 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Templates are uploaded to the directory of the file transfer uploads
+const iappTemplateUploadDir = "/var/config/rest/downloads/"
+
+type iappTemplate struct {
+	Name         string `json:"name"`
+	FullPath     string `json:"fullPath"`
+	TmplChecksum string `json:"tmplChecksum"`
+}
+
+// bigip_sys_iapp_template installs an iApp template from its TCL definition, a .tmpl file. The file is uploaded,
+// its checksum verified on the device, and loaded by merging it in the configuration, which replaces the template
+// when it is already installed. The application services of the template are then redeployed, so that they are
+// configured by its new definition.
+func resourceBigipSysIappTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysIappTemplateCreate,
+		Read:   resourceBigipSysIappTemplateRead,
+		Update: resourceBigipSysIappTemplateUpdate,
+		Delete: resourceBigipSysIappTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the template, as defined in content, e.g. /Common/my_http",
+				ValidateFunc: validateF5Name,
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Definition of the template, the content of its .tmpl file",
+			},
+			"redeploy_services": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Redeploy the application services of the template when its definition changes",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the definition, verified on the device once uploaded",
+			},
+			"tmpl_checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the template computed by the device",
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Application services deployed from the template",
+			},
+		},
+	}
+}
+
+func resourceBigipSysIappTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Installing iApp template " + name)
+	checksum, err := installIappTemplate(client, name, d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(name)
+	d.Set("checksum", checksum)
+	return readAfterCreate(d, meta, resourceBigipSysIappTemplateRead)
+}
+
+func resourceBigipSysIappTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading iApp template " + name)
+	var t iappTemplate
+	ok, err := getForEntity(client, &t, "sys", "application", "template", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve iApp template (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] iApp template (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	if old := d.Get("tmpl_checksum").(string); old != "" && t.TmplChecksum != old {
+		// The template was changed on the device, its definition is installed again
+		log.Printf("[WARN] iApp template (%s) was modified on the device", name)
+		d.Set("content", "")
+	}
+	services, err := iappTemplateServices(client, name)
+	if err != nil {
+		return err
+	}
+	d.Set("name", name)
+	d.Set("tmpl_checksum", t.TmplChecksum)
+	d.Set("services", services)
+	return nil
+}
+
+func resourceBigipSysIappTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	if d.HasChange("content") {
+		log.Println("[INFO] Installing iApp template again " + name)
+		checksum, err := installIappTemplate(client, name, d.Get("content").(string))
+		if err != nil {
+			return err
+		}
+		d.Set("checksum", checksum)
+		// The checksum of the device changed with the definition
+		d.Set("tmpl_checksum", "")
+
+		if d.Get("redeploy_services").(bool) {
+			services, err := iappTemplateServices(client, name)
+			if err != nil {
+				return err
+			}
+			for _, service := range services {
+				log.Printf("[INFO] Redeploying application service %s of iApp template %s", service, name)
+				err := patchEntity(client, map[string]string{"execute-action": "definition"}, "sys", "application", "service", service)
+				if err != nil {
+					return fmt.Errorf("Error redeploying application service %s of iApp template %s: %s", service, name, err)
+				}
+			}
+		}
+	}
+	return resourceBigipSysIappTemplateRead(d, meta)
+}
+
+func resourceBigipSysIappTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting iApp template " + name)
+	if err := deleteEntity(client, "sys", "application", "template", name); err != nil {
+		log.Printf("[ERROR] Unable to Delete iApp template (%s) (%v)", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// installIappTemplate uploads the definition of a template, verifies its checksum on the device, and loads it. The
+// checksum is returned.
+func installIappTemplate(client *bigip.BigIP, name, content string) (string, error) {
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	file := strings.Replace(strings.TrimPrefix(name, "/"), "/", "_", -1) + ".tmpl"
+
+	path := append(uriFileTransferUploads, file)
+	skip, err := reportDryRun(client, "POST", "/"+strings.Join(path, "/"), map[string]string{"checksum": checksum})
+	if err != nil {
+		return "", err
+	}
+	if !skip {
+		if _, err := client.UploadBytes([]byte(content), file); err != nil {
+			return "", fmt.Errorf("Error uploading iApp template (%s): %s", name, err)
+		}
+		out, err := runBash(client, "sha256sum "+iappTemplateUploadDir+file)
+		if err != nil {
+			return "", fmt.Errorf("Error verifying the checksum of iApp template (%s): %s", name, err)
+		}
+		if fields := strings.Fields(out); len(fields) == 0 || fields[0] != checksum {
+			return "", fmt.Errorf("Checksum of iApp template (%s) uploaded is %s, expected %s", name, strings.TrimSpace(out), checksum)
+		}
+	}
+
+	load := map[string]interface{}{
+		"command": "load",
+		"name":    "merge",
+		"options": []map[string]string{{"file": iappTemplateUploadDir + file}},
+	}
+	if err := postEntity(client, load, "sys", "config"); err != nil {
+		return "", fmt.Errorf("Error loading iApp template (%s): %s", name, err)
+	}
+	if _, ok := dryRunClients.Load(client); ok {
+		return checksum, nil
+	}
+	ok, err := getForEntity(client, &iappTemplate{}, "sys", "application", "template", name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving iApp template (%s): %s", name, err)
+	}
+	if !ok {
+		return "", fmt.Errorf("iApp template (%s) is not defined by its content", name)
+	}
+	return checksum, nil
+}
+
+// iappTemplateServices returns the full paths of the application services deployed from a template
+func iappTemplateServices(client *bigip.BigIP, name string) ([]string, error) {
+	var items []struct {
+		FullPath string `json:"fullPath"`
+		Template string `json:"template"`
+	}
+	if _, err := getCollection(client, &items, selectQuery("fullPath", "template"), "sys", "application", "service"); err != nil {
+		return nil, fmt.Errorf("Error retrieving the application services of iApp template (%s): %s", name, err)
+	}
+	services := []string{}
+	for _, s := range items {
+		if s.Template == name {
+			services = append(services, s.FullPath)
+		}
+	}
+	return services, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysIappTemplate(url, version string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_iapp_template" "my_http" {
+			name = "/Common/my_http"
+			content = "sys application template /Common/my_http { actions { definition { implementation { } } } } # %s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, version, url)
+}
+
+func TestAccBigipSysIappTemplate(t *testing.T) {
+	var uploaded []byte
+	var redeployed []string
+	installs, corrupt, installed := 0, false, false
+	checksum := ""
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/file-transfer/uploads/Common_my_http.tmpl", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, _ = ioutil.ReadAll(r.Body)
+		if corrupt {
+			uploaded = uploaded[1:]
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/util/bash", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "-c 'sha256sum /var/config/rest/downloads/Common_my_http.tmpl'", body["utilCmdArgs"])
+		fmt.Fprintf(w, `{"commandResult":"%x  /var/config/rest/downloads/Common_my_http.tmpl\n"}`, sha256.Sum256(uploaded))
+	})
+	mux.HandleFunc("/mgmt/tm/sys/config", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"command":"load","name":"merge","options":[{"file":"/var/config/rest/downloads/Common_my_http.tmpl"}]}`, string(b))
+		installs++
+		installed, checksum = true, fmt.Sprintf("c%d", installs)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/application/template/~Common~my_http", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			installed = false
+			return
+		}
+		if !installed {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"my_http","fullPath":"/Common/my_http","tmplChecksum":"%s"}`, checksum)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/application/service", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"items":[{"fullPath":"/Common/web.app/web","template":"/Common/my_http"},{"fullPath":"/Common/other.app/other","template":"/Common/f5.http"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/application/service/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "definition", body["execute-action"])
+		redeployed = append(redeployed, r.Method+" "+r.URL.Path)
+		fmt.Fprintf(w, `{}`)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if installed {
+				return fmt.Errorf("iApp template /Common/my_http was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysIappTemplate(server.URL, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_iapp_template.my_http", "id", "/Common/my_http"),
+					func(s *terraform.State) error {
+						checksum := s.RootModule().Resources["bigip_sys_iapp_template.my_http"].Primary.Attributes["checksum"]
+						assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256(uploaded)), checksum)
+						return nil
+					},
+					resource.TestCheckResourceAttr("bigip_sys_iapp_template.my_http", "tmpl_checksum", "c1"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp_template.my_http", "services.#", "1"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp_template.my_http", "services.0", "/Common/web.app/web"),
+				),
+			},
+			{
+				// A new definition is installed in place, and the application services redeployed
+				Config: testBigipSysIappTemplate(server.URL, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_iapp_template.my_http", "tmpl_checksum", "c2"),
+					func(*terraform.State) error {
+						assert.Equal(t, []string{"PATCH /mgmt/tm/sys/application/service/~Common~web.app~web"}, redeployed)
+						return nil
+					},
+				),
+			},
+			{
+				// The template changed on the device is installed again
+				PreConfig: func() {
+					checksum = "modified"
+				},
+				Config:             testBigipSysIappTemplate(server.URL, "v2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					corrupt = true
+				},
+				Config:      testBigipSysIappTemplate(server.URL, "v3"),
+				ExpectError: regexp.MustCompile(`Checksum of iApp template \(/Common/my_http\) uploaded is [0-9a-f]+ +/var/config/rest/downloads/Common_my_http.tmpl, expected`),
+			},
+		},
+	})
+}
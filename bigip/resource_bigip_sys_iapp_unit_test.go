@@ -0,0 +1,161 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testIappServicePath = "/mgmt/tm/sys/application/service/~Common~web.app~web"
+
+func testBigipSysIapp(url, port string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_iapp" "web" {
+			name = "web"
+			template = "/Common/f5.http"
+			variables {
+				name = "pool__addr"
+				value = "10.0.1.100"
+			}
+			variables {
+				name = "pool__port"
+				value = "%s"
+			}
+			variables {
+				name = "ssl__client_key_passphrase"
+				encrypted = "yes"
+				value = "secret"
+			}
+			tables {
+				name = "pool__members"
+				column_names = ["addr", "port"]
+				rows {
+					row = ["10.0.2.167", "80"]
+				}
+			}
+			lists {
+				name = "irules__irules"
+				values = ["/Common/redirect"]
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, port, url)
+}
+
+// iappHandler answers the application service web deployed by the test, with the definition posted and the
+// defaults of its template, and the encrypted values masked as the device does
+func iappHandler(service *iappService, patches *[]iappService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var p iappService
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/mgmt/tm/sys/application/service":
+			json.NewDecoder(r.Body).Decode(service)
+			service.Variables = append(service.Variables, iappVariable{Name: "net__client_mode", Encrypted: "no", Value: "wan"})
+			service.Tables = append(service.Tables, iappTable{Name: "pool__hosts"})
+			service.TemplateModified = "no"
+		case r.Method == "PATCH" && r.URL.Path == testIappServicePath:
+			json.NewDecoder(r.Body).Decode(&p)
+			*patches = append(*patches, p)
+			service.Variables = p.Variables
+		case r.Method == "DELETE" && r.URL.Path == testIappServicePath:
+			service.Name = ""
+			return
+		}
+		if service.Name == "" || r.URL.Path != testIappServicePath && r.Method != "POST" {
+			http.Error(w, `{"code":404}`, http.StatusNotFound)
+			return
+		}
+		read := *service
+		read.Variables = nil
+		for _, v := range service.Variables {
+			if v.Encrypted == "yes" {
+				v.Value = ""
+			}
+			read.Variables = append(read.Variables, v)
+		}
+		json.NewEncoder(w).Encode(read)
+	}
+}
+
+func TestAccBigipSysIappDefinition(t *testing.T) {
+	var service iappService
+	var patches []iappService
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/application/service", iappHandler(&service, &patches))
+	mux.HandleFunc("/mgmt/tm/sys/application/service/", iappHandler(&service, &patches))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if service.Name != "" {
+				return fmt.Errorf("application service %s was not deleted", service.Name)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysIapp(server.URL, "80"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "id", "web"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "variables.#", "3"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "variables.2.value", "secret"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "tables.#", "1"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "tables.0.rows.0.row.1", "80"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "lists.0.values.0", "/Common/redirect"),
+					func(*terraform.State) error {
+						assert.Equal(t, "/Common/f5.http", service.Template)
+						assert.Equal(t, "Common", service.Partition)
+						assert.Equal(t, "enabled", service.StrictUpdates)
+						return nil
+					},
+				),
+			},
+			{
+				// The variables and tables the template adds aren't in the plan
+				Config:   testBigipSysIapp(server.URL, "80"),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					service.Variables[0].Value = "10.0.1.200"
+				},
+				Config:             testBigipSysIapp(server.URL, "80"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testBigipSysIapp(server.URL, "8080"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "variables.0.value", "10.0.1.100"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.web", "variables.1.value", "8080"),
+					func(*terraform.State) error {
+						if assert.Len(t, patches, 1) {
+							assert.Equal(t, "definition", patches[0].ExecuteAction)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
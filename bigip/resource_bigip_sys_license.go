@@ -0,0 +1,297 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The BIG-IQ task assigning and revoking the licenses of a license pool
+var uriBigiqLicenseTasks = []string{"mgmt", "cm", "device", "tasks", "licensing", "pool", "member-management"}
+
+type licenseInstall struct {
+	Command         string   `json:"command"`
+	RegistrationKey string   `json:"registrationKey,omitempty"`
+	AddOnKeys       []string `json:"addOnKeys,omitempty"`
+}
+
+type bigiqLicenseTask struct {
+	Id              string `json:"id,omitempty"`
+	LicensePoolName string `json:"licensePoolName,omitempty"`
+	Command         string `json:"command,omitempty"`
+	Address         string `json:"address,omitempty"`
+	User            string `json:"user,omitempty"`
+	Password        string `json:"password,omitempty"`
+	UnitOfMeasure   string `json:"unitOfMeasure,omitempty"`
+	SkuKeyword1     string `json:"skuKeyword1,omitempty"`
+	SkuKeyword2     string `json:"skuKeyword2,omitempty"`
+	Status          string `json:"status,omitempty"`
+	ErrorMessage    string `json:"errorMessage,omitempty"`
+}
+
+// bigip_sys_license licenses the BIG-IP, with a registration key activated by the BIG-IP itself or with a
+// license of a BIG-IQ license pool. There is only one license, the id of the resource is license.
+func resourceBigipSysLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysLicenseCreate,
+		Update: resourceBigipSysLicenseUpdate,
+		Read:   resourceBigipSysLicenseRead,
+		Delete: resourceBigipSysLicenseDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registration_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Base registration key the BIG-IP is licensed with, it activates the key with the F5 license server",
+				ConflictsWith: []string{"license_pool"},
+			},
+			"add_on_keys": {
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				Description:   "Registration keys of add-on modules",
+				ConflictsWith: []string{"license_pool"},
+			},
+			"license_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the BIG-IQ license pool the license is assigned from",
+			},
+			"bigiq_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Address of the BIG-IQ managing license_pool",
+			},
+			"bigiq_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User of the BIG-IQ",
+			},
+			"bigiq_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of bigiq_user",
+			},
+			"device_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Address the BIG-IQ reaches the BIG-IP at, the address of the provider when not set",
+			},
+			"unit_of_measure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Billing period of a utility license pool, hourly, daily, monthly or yearly",
+				ValidateFunc: validateStringValue([]string{"hourly", "daily", "monthly", "yearly"}),
+			},
+			"sku_keyword_1": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "First SKU keyword of the offering assigned from a utility or purchased license pool",
+			},
+			"sku_keyword_2": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Second SKU keyword of the offering assigned from a utility or purchased license pool",
+			},
+		},
+	}
+}
+
+func resourceBigipSysLicenseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	if pool := d.Get("license_pool").(string); pool != "" {
+		if d.Get("bigiq_address").(string) == "" {
+			return fmt.Errorf("bigiq_address must be set to assign a license of license_pool %s", pool)
+		}
+		log.Printf("[INFO] Assigning a license of BIG-IQ license pool %s to %s", pool, client.Host)
+		if err := runBigiqLicenseTask(d, meta, "assign", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	} else {
+		if d.Get("registration_key").(string) == "" {
+			return fmt.Errorf("one of registration_key or license_pool must be set")
+		}
+		if err := installSysLicense(d, meta); err != nil {
+			return err
+		}
+	}
+	if err := waitForDeviceReady(client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId("license")
+	return checkSysLicense(d, meta)
+}
+
+func resourceBigipSysLicenseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	if d.Get("license_pool").(string) == "" && (d.HasChange("registration_key") || d.HasChange("add_on_keys")) {
+		if err := installSysLicense(d, meta); err != nil {
+			return err
+		}
+		if err := waitForDeviceReady(client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		return checkSysLicense(d, meta)
+	}
+	return resourceBigipSysLicenseRead(d, meta)
+}
+
+func resourceBigipSysLicenseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	key, err := getSysLicenseKey(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve the license of %s (%v)", client.Host, err)
+		return err
+	}
+	if key == "" {
+		log.Printf("[WARN] %s is not licensed, removing from state", client.Host)
+		d.SetId("")
+		return nil
+	}
+	d.Set("registration_key", key)
+	return nil
+}
+
+func resourceBigipSysLicenseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	if pool := d.Get("license_pool").(string); pool != "" {
+		log.Printf("[INFO] Revoking the license of %s, returning it to BIG-IQ license pool %s", client.Host, pool)
+		if err := runBigiqLicenseTask(d, meta, "revoke", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	} else {
+		// Revoking a registration key would leave the BIG-IP unusable until it is licensed again
+		log.Printf("[INFO] Leaving %s licensed with %s", client.Host, d.Get("registration_key").(string))
+	}
+	d.SetId("")
+	return nil
+}
+
+// installSysLicense makes the BIG-IP activate its registration keys with the F5 license server
+func installSysLicense(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	key := d.Get("registration_key").(string)
+	log.Printf("[INFO] Licensing %s with registration key %s", client.Host, key)
+
+	l := &licenseInstall{
+		Command:         "install",
+		RegistrationKey: key,
+		AddOnKeys:       listToStringSlice(d.Get("add_on_keys").([]interface{})),
+	}
+	if err := postEntity(client, l, "sys", "license"); err != nil {
+		return fmt.Errorf("Error licensing %s with registration key %s: %s", client.Host, key, err)
+	}
+	return nil
+}
+
+// checkSysLicense reads the license once the BIG-IP is ready again, failing if the expected key wasn't installed
+func checkSysLicense(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	expected := d.Get("registration_key").(string)
+	if err := resourceBigipSysLicenseRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("%s is not licensed after the license was installed", client.Host)
+	}
+	if key := d.Get("registration_key").(string); d.Get("license_pool").(string) == "" && key != expected {
+		return fmt.Errorf("%s is licensed with %s instead of %s", client.Host, key, expected)
+	}
+	return nil
+}
+
+// getSysLicenseKey returns the base registration key of the BIG-IP, or "" if it is not licensed
+func getSysLicenseKey(client *bigip.BigIP) (string, error) {
+	var l stats
+	ok, err := getForEntity(client, &l, "sys", "license")
+	if err != nil || !ok {
+		return "", err
+	}
+	for _, e := range l.Entries {
+		if key, ok := e.NestedStats.Entries["registrationKey"]; ok {
+			return key.Description, nil
+		}
+	}
+	return "", nil
+}
+
+// runBigiqLicenseTask runs a BIG-IQ license pool task for the BIG-IP, command is assign or revoke
+func runBigiqLicenseTask(d *schema.ResourceData, meta interface{}, command string, timeout time.Duration) error {
+	client := meta.(*bigip.BigIP)
+
+	address := d.Get("device_address").(string)
+	if address == "" {
+		address = client.Host
+		if u, err := url.Parse(client.Host); err == nil && u.Hostname() != "" {
+			address = u.Hostname()
+		}
+	}
+	task := &bigiqLicenseTask{
+		LicensePoolName: d.Get("license_pool").(string),
+		Command:         command,
+		Address:         address,
+		User:            client.User,
+		Password:        client.Password,
+	}
+	if command == "assign" {
+		task.UnitOfMeasure = d.Get("unit_of_measure").(string)
+		task.SkuKeyword1 = d.Get("sku_keyword_1").(string)
+		task.SkuKeyword2 = d.Get("sku_keyword_2").(string)
+	}
+
+	bigiq := bigip.NewSession(d.Get("bigiq_address").(string), d.Get("bigiq_user").(string), d.Get("bigiq_password").(string), client.ConfigOptions)
+	reported := *task
+	reported.Password = "********"
+	if skip, err := reportDryRun(client, "POST", bigiq.Host+"/"+strings.Join(uriBigiqLicenseTasks, "/"), reported); skip || err != nil {
+		return err
+	}
+
+	var started bigiqLicenseTask
+	if err := postForEntity(bigiq, task, &started, uriBigiqLicenseTasks...); err != nil {
+		return fmt.Errorf("Error starting the BIG-IQ task to %s the license of %s: %s", command, address, err)
+	}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		var t bigiqLicenseTask
+		_, err := getForEntity(bigiq, &t, append(append([]string{}, uriBigiqLicenseTasks...), started.Id)...)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch t.Status {
+		case "FINISHED":
+			return nil
+		case "FAILED":
+			return resource.NonRetryableError(fmt.Errorf("BIG-IQ could not %s the license of %s: %s", command, address, t.ErrorMessage))
+		}
+		return resource.RetryableError(fmt.Errorf("BIG-IQ task to %s the license of %s is %s", command, address, t.Status))
+	})
+}
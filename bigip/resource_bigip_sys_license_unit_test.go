@@ -0,0 +1,151 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func sysLicenseHandler(key *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/license/0":{"nestedStats":{"entries":{
+			"registrationKey":{"description":"%s"},"licensedVersion":{"description":"14.1.2"}}}}}}`, *key)
+	}
+}
+
+func sysReadyHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/ready/0":{"nestedStats":{"entries":{
+		"configReady":{"description":"yes"},"licenseReady":{"description":"yes"},"provisionReady":{"description":"yes"}}}}}}`)
+}
+
+func testBigipSysLicense(url, key string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_license" "test-license" {
+			registration_key = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, key, url)
+}
+
+func TestAccBigipSysLicenseRegistrationKey(t *testing.T) {
+	defer func(interval time.Duration) { deviceSettleInterval = interval }(deviceSettleInterval)
+	deviceSettleInterval = 0
+
+	var installs []string
+	key := ""
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/ready", sysReadyHandler)
+	mux.HandleFunc("/mgmt/tm/sys/license", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			b, _ := ioutil.ReadAll(r.Body)
+			installs = append(installs, string(b))
+			key = map[int]string{1: "AAAAA-BBBBB-CCCCC-DDDDD-EEEEEEE", 2: "FFFFF-GGGGG-HHHHH-IIIII-JJJJJJJ"}[len(installs)]
+			fmt.Fprintf(w, `{}`)
+			return
+		}
+		sysLicenseHandler(&key)(w, r)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysLicense(server.URL, "AAAAA-BBBBB-CCCCC-DDDDD-EEEEEEE"),
+				Check:  resource.TestCheckResourceAttr("bigip_sys_license.test-license", "id", "license"),
+			},
+			{
+				// A new key re-licenses the BIG-IP
+				Config: testBigipSysLicense(server.URL, "FFFFF-GGGGG-HHHHH-IIIII-JJJJJJJ"),
+				Check:  resource.TestCheckResourceAttr("bigip_sys_license.test-license", "registration_key", "FFFFF-GGGGG-HHHHH-IIIII-JJJJJJJ"),
+			},
+		},
+	})
+	if assert.Len(t, installs, 2) {
+		assert.JSONEq(t, `{"command":"install","registrationKey":"AAAAA-BBBBB-CCCCC-DDDDD-EEEEEEE"}`, installs[0])
+		assert.JSONEq(t, `{"command":"install","registrationKey":"FFFFF-GGGGG-HHHHH-IIIII-JJJJJJJ"}`, installs[1])
+	}
+	assert.Equal(t, "FFFFF-GGGGG-HHHHH-IIIII-JJJJJJJ", key, "A registration key is not revoked on destroy")
+}
+
+func TestAccBigipSysLicensePool(t *testing.T) {
+	defer func(interval time.Duration) { deviceSettleInterval = interval }(deviceSettleInterval)
+	deviceSettleInterval = 0
+
+	var tasks []string
+	key := ""
+	polls := 0
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/ready", sysReadyHandler)
+	mux.HandleFunc("/mgmt/tm/sys/license", sysLicenseHandler(&key))
+	// The mock server plays the BIG-IQ as well
+	mux.HandleFunc("/mgmt/cm/device/tasks/licensing/pool/member-management", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		tasks = append(tasks, string(b))
+		polls = 0
+		fmt.Fprintf(w, `{"id":"task-%d","status":"STARTED"}`, len(tasks))
+	})
+	mux.HandleFunc("/mgmt/cm/device/tasks/licensing/pool/member-management/", func(w http.ResponseWriter, r *http.Request) {
+		status := "STARTED"
+		if polls++; polls > 1 {
+			status = "FINISHED"
+			key = map[string]string{"/mgmt/cm/device/tasks/licensing/pool/member-management/task-1": "KKKKK-LLLLL-MMMMM-NNNNN-OOOOOOO"}[r.URL.Path]
+		}
+		fmt.Fprintf(w, `{"status":"%s"}`, status)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_sys_license" "test-license" {
+						license_pool = "ve-pool"
+						bigiq_address = "%s"
+						bigiq_user = "admin"
+						bigiq_password = "bigiq-pass"
+						device_address = "10.1.1.10"
+						unit_of_measure = "hourly"
+						sku_keyword_1 = "BT"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL, server.URL),
+				Check: resource.TestCheckResourceAttr("bigip_sys_license.test-license", "registration_key", "KKKKK-LLLLL-MMMMM-NNNNN-OOOOOOO"),
+			},
+		},
+	})
+	if assert.Len(t, tasks, 2) {
+		assert.JSONEq(t, `{"licensePoolName":"ve-pool","command":"assign","address":"10.1.1.10","user":"xxxx","password":"xxxx",
+			"unitOfMeasure":"hourly","skuKeyword1":"BT"}`, tasks[0])
+		assert.JSONEq(t, `{"licensePoolName":"ve-pool","command":"revoke","address":"10.1.1.10","user":"xxxx","password":"xxxx"}`, tasks[1],
+			"The license is returned to the pool on destroy")
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriArcsight = "arcsight"
+
+type logDestinationArcsight struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ForwardTo   string `json:"forwardTo,omitempty"`
+}
+
+func resourceBigipSysLogDestinationArcsight() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysLogDestinationArcsightCreate,
+		Update: resourceBigipSysLogDestinationArcsightUpdate,
+		Read:   resourceBigipSysLogDestinationArcsightRead,
+		Delete: resourceBigipSysLogDestinationArcsightDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the ArcSight log destination",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"remote_hsl": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the remote high-speed log destination the messages formatted as ArcSight CEF are sent through",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipSysLogDestinationArcsightCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating ArcSight log destination " + name)
+
+	r, err := getSysLogDestinationArcsightConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	r.Name = name
+	err = postEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriArcsight)
+	if err != nil {
+		return fmt.Errorf("Error creating ArcSight log destination (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysLogDestinationArcsightRead)
+}
+
+func resourceBigipSysLogDestinationArcsightUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	r, err := getSysLogDestinationArcsightConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	err = putEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriArcsight, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying ArcSight log destination (%s): %s", name, err)
+	}
+	return resourceBigipSysLogDestinationArcsightRead(d, meta)
+}
+
+func resourceBigipSysLogDestinationArcsightRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r logDestinationArcsight
+	ok, err := getForEntity(client, &r, "sys", uriLogConfig, uriLogDestination, uriArcsight, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve ArcSight log destination (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] ArcSight log destination (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("remote_hsl", r.ForwardTo)
+	return nil
+}
+
+func resourceBigipSysLogDestinationArcsightDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting ArcSight log destination " + name)
+
+	err := deleteEntity(client, "sys", uriLogConfig, uriLogDestination, uriArcsight, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete ArcSight log destination (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysLogDestinationArcsightConfig(d *schema.ResourceData, meta interface{}) (*logDestinationArcsight, error) {
+	client := meta.(*bigip.BigIP)
+
+	hsl := d.Get("remote_hsl").(string)
+	err := checkReferenceExists(client, "ArcSight log destination "+d.Get("name").(string), "Remote high-speed log destination", hsl,
+		"sys", uriLogConfig, uriLogDestination, uriRemoteHsl, hsl)
+	if err != nil {
+		return nil, err
+	}
+	return &logDestinationArcsight{
+		Description: d.Get("description").(string),
+		ForwardTo:   hsl,
+	}, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	uriLogConfig      = "log-config"
+	uriLogDestination = "destination"
+	uriRemoteHsl      = "remote-high-speed-log"
+)
+
+type logDestinationHsl struct {
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	PoolName     string `json:"poolName,omitempty"`
+	Protocol     string `json:"protocol,omitempty"`
+	Distribution string `json:"distribution,omitempty"`
+}
+
+func resourceBigipSysLogDestinationHsl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysLogDestinationHslCreate,
+		Update: resourceBigipSysLogDestinationHslUpdate,
+		Read:   resourceBigipSysLogDestinationHslRead,
+		Delete: resourceBigipSysLogDestinationHslDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the remote high-speed log destination",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"pool": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the LTM pool of log servers the messages are sent to",
+				ValidateFunc: validateF5Name,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tcp",
+				Description:  "Protocol the messages are sent with, tcp or udp",
+				ValidateFunc: validateStringValue([]string{"tcp", "udp"}),
+			},
+			"distribution": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "adaptive",
+				Description:  "How the messages are distributed over the pool members, adaptive, balanced or replicated",
+				ValidateFunc: validateStringValue([]string{"adaptive", "balanced", "replicated"}),
+			},
+		},
+	}
+}
+
+func resourceBigipSysLogDestinationHslCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating remote high-speed log destination " + name)
+
+	r, err := getSysLogDestinationHslConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	r.Name = name
+	err = postEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriRemoteHsl)
+	if err != nil {
+		return fmt.Errorf("Error creating remote high-speed log destination (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysLogDestinationHslRead)
+}
+
+func resourceBigipSysLogDestinationHslUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	r, err := getSysLogDestinationHslConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	err = putEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriRemoteHsl, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying remote high-speed log destination (%s): %s", name, err)
+	}
+	return resourceBigipSysLogDestinationHslRead(d, meta)
+}
+
+func resourceBigipSysLogDestinationHslRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r logDestinationHsl
+	ok, err := getForEntity(client, &r, "sys", uriLogConfig, uriLogDestination, uriRemoteHsl, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve remote high-speed log destination (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Remote high-speed log destination (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("pool", r.PoolName)
+	d.Set("protocol", r.Protocol)
+	d.Set("distribution", r.Distribution)
+	return nil
+}
+
+func resourceBigipSysLogDestinationHslDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting remote high-speed log destination " + name)
+
+	err := deleteEntity(client, "sys", uriLogConfig, uriLogDestination, uriRemoteHsl, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete remote high-speed log destination (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysLogDestinationHslConfig(d *schema.ResourceData, meta interface{}) (*logDestinationHsl, error) {
+	client := meta.(*bigip.BigIP)
+
+	pool := d.Get("pool").(string)
+	err := checkReferenceExists(client, "Remote high-speed log destination "+d.Get("name").(string), "Pool", pool, uriLtm, "pool", pool)
+	if err != nil {
+		return nil, err
+	}
+	return &logDestinationHsl{
+		Description:  d.Get("description").(string),
+		PoolName:     pool,
+		Protocol:     d.Get("protocol").(string),
+		Distribution: d.Get("distribution").(string),
+	}, nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriRemoteSyslog = "remote-syslog"
+
+type logDestinationSyslog struct {
+	Name               string `json:"name,omitempty"`
+	Description        string `json:"description,omitempty"`
+	RemoteHighSpeedLog string `json:"remoteHighSpeedLog,omitempty"`
+	Format             string `json:"format,omitempty"`
+	DefaultFacility    string `json:"defaultFacility,omitempty"`
+	DefaultSeverity    string `json:"defaultSeverity,omitempty"`
+}
+
+func resourceBigipSysLogDestinationSyslog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysLogDestinationSyslogCreate,
+		Update: resourceBigipSysLogDestinationSyslogUpdate,
+		Read:   resourceBigipSysLogDestinationSyslogRead,
+		Delete: resourceBigipSysLogDestinationSyslogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the remote syslog log destination",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"remote_hsl": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Full path of the remote high-speed log destination the formatted messages are sent through",
+				ValidateFunc: validateF5Name,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rfc5424",
+				Description:  "Syslog format of the messages, rfc3164, rfc5424 or legacy-bigip",
+				ValidateFunc: validateStringValue([]string{"rfc3164", "rfc5424", "legacy-bigip"}),
+			},
+			"default_facility": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "local0",
+				Description: "Facility of messages that have none, e.g. local0",
+			},
+			"default_severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "info",
+				Description: "Severity of messages that have none, e.g. info",
+			},
+		},
+	}
+}
+
+func resourceBigipSysLogDestinationSyslogCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating remote syslog log destination " + name)
+
+	r, err := getSysLogDestinationSyslogConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	r.Name = name
+	err = postEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriRemoteSyslog)
+	if err != nil {
+		return fmt.Errorf("Error creating remote syslog log destination (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysLogDestinationSyslogRead)
+}
+
+func resourceBigipSysLogDestinationSyslogUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	r, err := getSysLogDestinationSyslogConfig(d, meta)
+	if err != nil {
+		return err
+	}
+	err = putEntity(client, r, "sys", uriLogConfig, uriLogDestination, uriRemoteSyslog, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying remote syslog log destination (%s): %s", name, err)
+	}
+	return resourceBigipSysLogDestinationSyslogRead(d, meta)
+}
+
+func resourceBigipSysLogDestinationSyslogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r logDestinationSyslog
+	ok, err := getForEntity(client, &r, "sys", uriLogConfig, uriLogDestination, uriRemoteSyslog, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve remote syslog log destination (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Remote syslog log destination (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("remote_hsl", r.RemoteHighSpeedLog)
+	d.Set("format", r.Format)
+	d.Set("default_facility", r.DefaultFacility)
+	d.Set("default_severity", r.DefaultSeverity)
+	return nil
+}
+
+func resourceBigipSysLogDestinationSyslogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting remote syslog log destination " + name)
+
+	err := deleteEntity(client, "sys", uriLogConfig, uriLogDestination, uriRemoteSyslog, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete remote syslog log destination (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysLogDestinationSyslogConfig(d *schema.ResourceData, meta interface{}) (*logDestinationSyslog, error) {
+	client := meta.(*bigip.BigIP)
+
+	hsl := d.Get("remote_hsl").(string)
+	err := checkReferenceExists(client, "Remote syslog log destination "+d.Get("name").(string), "Remote high-speed log destination", hsl,
+		"sys", uriLogConfig, uriLogDestination, uriRemoteHsl, hsl)
+	if err != nil {
+		return nil, err
+	}
+	return &logDestinationSyslog{
+		Description:        d.Get("description").(string),
+		RemoteHighSpeedLog: hsl,
+		Format:             d.Get("format").(string),
+		DefaultFacility:    d.Get("default_facility").(string),
+		DefaultSeverity:    d.Get("default_severity").(string),
+	}, nil
+}
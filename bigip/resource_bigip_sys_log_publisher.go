@@ -0,0 +1,148 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriLogPublisher = "publisher"
+
+type logPublisher struct {
+	Name         string                    `json:"name,omitempty"`
+	Description  string                    `json:"description,omitempty"`
+	Destinations []logPublisherDestination `json:"destinations"`
+}
+
+type logPublisherDestination struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+}
+
+func resourceBigipSysLogPublisher() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysLogPublisherCreate,
+		Update: resourceBigipSysLogPublisherUpdate,
+		Read:   resourceBigipSysLogPublisherRead,
+		Delete: resourceBigipSysLogPublisherDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the log publisher",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"destinations": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Full paths of the log destinations the messages are published to, e.g. /Common/local-db",
+			},
+		},
+	}
+}
+
+func resourceBigipSysLogPublisherCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating log publisher " + name)
+
+	r := getSysLogPublisherConfig(d)
+	r.Name = name
+	err := postEntity(client, r, "sys", uriLogConfig, uriLogPublisher)
+	if err != nil {
+		return fmt.Errorf("Error creating log publisher (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysLogPublisherRead)
+}
+
+func resourceBigipSysLogPublisherUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getSysLogPublisherConfig(d), "sys", uriLogConfig, uriLogPublisher, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying log publisher (%s): %s", name, err)
+	}
+	return resourceBigipSysLogPublisherRead(d, meta)
+}
+
+func resourceBigipSysLogPublisherRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r logPublisher
+	ok, err := getForEntity(client, &r, "sys", uriLogConfig, uriLogPublisher, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve log publisher (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Log publisher (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+
+	var destinations []string
+	for _, dest := range r.Destinations {
+		if dest.Partition != "" {
+			destinations = append(destinations, fmt.Sprintf("/%s/%s", dest.Partition, dest.Name))
+		} else {
+			destinations = append(destinations, dest.Name)
+		}
+	}
+	if err := d.Set("destinations", destinations); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Destinations to state for log publisher (%s): %s", name, err)
+	}
+	return nil
+}
+
+func resourceBigipSysLogPublisherDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting log publisher " + name)
+
+	err := deleteEntity(client, "sys", uriLogConfig, uriLogPublisher, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete log publisher (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysLogPublisherConfig(d *schema.ResourceData) *logPublisher {
+	r := &logPublisher{
+		Description:  d.Get("description").(string),
+		Destinations: []logPublisherDestination{},
+	}
+	for _, dest := range setToStringSlice(d.Get("destinations").(*schema.Set)) {
+		r.Destinations = append(r.Destinations, logPublisherDestination{Name: dest})
+	}
+	return r
+}
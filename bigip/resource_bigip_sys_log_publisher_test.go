@@ -0,0 +1,122 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_LOG_PUBLISHER_NAME = fmt.Sprintf("/%s/test-publisher", TEST_PARTITION)
+
+var TEST_LOG_PUBLISHER_RESOURCE = `resource "bigip_ltm_pool" "test-log-pool" {
+	name = "/Common/test-log-pool"
+	load_balancing_mode = "round-robin"
+}
+
+resource "bigip_sys_log_destination_hsl" "test-hsl" {
+	name = "/Common/test-hsl"
+	pool = bigip_ltm_pool.test-log-pool.name
+	protocol = "udp"
+}
+
+resource "bigip_sys_log_destination_syslog" "test-syslog-dest" {
+	name = "/Common/test-syslog-dest"
+	remote_hsl = bigip_sys_log_destination_hsl.test-hsl.name
+	format = "rfc5424"
+}
+
+resource "bigip_sys_log_publisher" "test-publisher" {
+	name = "` + TEST_LOG_PUBLISHER_NAME + `"
+	description = "audit logs"
+	destinations = [bigip_sys_log_destination_syslog.test-syslog-dest.name]
+}
+`
+
+func TestAccBigipSysLogPublisher_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysLogPublisherDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_LOG_PUBLISHER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogPublisherExists(TEST_LOG_PUBLISHER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_log_publisher.test-publisher", "name", TEST_LOG_PUBLISHER_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_log_publisher.test-publisher", "description", "audit logs"),
+					resource.TestCheckResourceAttr("bigip_sys_log_publisher.test-publisher", "destinations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysLogPublisher_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysLogPublisherDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_LOG_PUBLISHER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogPublisherExists(TEST_LOG_PUBLISHER_NAME, true),
+				),
+				ResourceName:      TEST_LOG_PUBLISHER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysLogPublisherExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p logPublisher
+		ok, err := getForEntity(client, &p, "sys", uriLogConfig, uriLogPublisher, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("log publisher %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("log publisher %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysLogPublisherDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_log_publisher" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p logPublisher
+		ok, err := getForEntity(client, &p, "sys", uriLogConfig, uriLogPublisher, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("log publisher %s not destroyed.", name)
+		}
+	}
+	return nil
+}
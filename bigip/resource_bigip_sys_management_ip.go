@@ -0,0 +1,119 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriManagementIp = "management-ip"
+
+type managementIp struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// bigip_sys_management_ip manages an address of the management interface. The BIG-IP has one address per
+// address family, the name of a management IP is the address with its prefix length.
+func resourceBigipSysManagementIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysManagementIpCreate,
+		Update: resourceBigipSysManagementIpUpdate,
+		Read:   resourceBigipSysManagementIpRead,
+		Delete: resourceBigipSysManagementIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Address of the management interface with its prefix length, e.g. 10.1.1.5/24",
+				ValidateFunc: validateAddressWithPrefix,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipSysManagementIpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	ip := d.Get("ip").(string)
+	log.Println("[INFO] Creating management IP " + ip)
+
+	m := &managementIp{
+		Name:        ip,
+		Description: d.Get("description").(string),
+	}
+	err := postEntity(client, m, "sys", uriManagementIp)
+	if err != nil {
+		return fmt.Errorf("Error creating management IP (%s): %s", ip, err)
+	}
+	d.SetId(ip)
+	return readAfterCreate(d, meta, resourceBigipSysManagementIpRead)
+}
+
+func resourceBigipSysManagementIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	ip := d.Id()
+
+	m := &managementIp{
+		Description: d.Get("description").(string),
+	}
+	err := patchEntity(client, m, "sys", uriManagementIp, ip)
+	if err != nil {
+		return fmt.Errorf("Error modifying management IP (%s): %s", ip, err)
+	}
+	return resourceBigipSysManagementIpRead(d, meta)
+}
+
+func resourceBigipSysManagementIpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	ip := d.Id()
+
+	var m managementIp
+	ok, err := getForEntity(client, &m, "sys", uriManagementIp, ip)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve management IP (%s) (%v)", ip, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Management IP (%s) not found, removing from state", ip)
+		d.SetId("")
+		return nil
+	}
+	d.Set("ip", ip)
+	d.Set("description", m.Description)
+	return nil
+}
+
+func resourceBigipSysManagementIpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	ip := d.Id()
+	log.Println("[INFO] Deleting management IP " + ip)
+
+	err := deleteEntity(client, "sys", uriManagementIp, ip)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete management IP (%s) (%v) ", ip, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysManagementIp(url, ip string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_management_ip" "test-mgmt-ip" {
+			ip = "%s"
+			description = "post-boot management address"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, ip, url)
+}
+
+func TestAccBigipSysManagementIpCreate(t *testing.T) {
+	created := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/management-ip", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"10.1.1.5/24","description":"post-boot management address"}`, string(b))
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+	// The / of the prefix length is escaped in the path
+	mux.HandleFunc("/mgmt/tm/sys/management-ip/10.1.1.5~24", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = false
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"10.1.1.5/24","fullPath":"10.1.1.5/24","description":"post-boot management address"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysManagementIp(server.URL, "10.1.1.5/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_management_ip.test-mgmt-ip", "id", "10.1.1.5/24"),
+					resource.TestCheckResourceAttr("bigip_sys_management_ip.test-mgmt-ip", "description", "post-boot management address"),
+				),
+			},
+		},
+	})
+	assert.False(t, created, "Management IP was not deleted")
+}
+
+func TestAccBigipSysManagementIpInvalidAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipSysManagementIp("https://localhost", "10.1.1.5"),
+				ExpectError: regexp.MustCompile("must be an address with its prefix length"),
+			},
+		},
+	})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriManagementRoute = "management-route"
+
+type managementRoute struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Network     string `json:"network,omitempty"`
+	Gateway     string `json:"gateway,omitempty"`
+	MTU         int    `json:"mtu,omitempty"`
+}
+
+// bigip_sys_management_route manages a route of the management interface, used by the traffic of the
+// management plane, e.g. to NTP, DNS, syslog or license servers
+func resourceBigipSysManagementRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysManagementRouteCreate,
+		Update: resourceBigipSysManagementRouteUpdate,
+		Read:   resourceBigipSysManagementRouteRead,
+		Delete: resourceBigipSysManagementRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the management route",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination network, e.g. 10.20.0.0/16 or default",
+			},
+			"gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Gateway address",
+			},
+			"mtu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum transmission unit of the route, 0 to use the one of the management interface",
+			},
+		},
+	}
+}
+
+func resourceBigipSysManagementRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating management route " + name)
+
+	r := getSysManagementRouteConfig(d)
+	r.Name = name
+	r.Network = d.Get("network").(string)
+	err := postEntity(client, r, "sys", uriManagementRoute)
+	if err != nil {
+		return fmt.Errorf("Error creating management route (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysManagementRouteRead)
+}
+
+func resourceBigipSysManagementRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getSysManagementRouteConfig(d), "sys", uriManagementRoute, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying management route (%s): %s", name, err)
+	}
+	return resourceBigipSysManagementRouteRead(d, meta)
+}
+
+func resourceBigipSysManagementRouteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r managementRoute
+	ok, err := getForEntity(client, &r, "sys", uriManagementRoute, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve management route (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Management route (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("network", r.Network)
+	d.Set("gateway", r.Gateway)
+	d.Set("mtu", r.MTU)
+	return nil
+}
+
+func resourceBigipSysManagementRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting management route " + name)
+
+	err := deleteEntity(client, "sys", uriManagementRoute, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete management route (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysManagementRouteConfig(d *schema.ResourceData) *managementRoute {
+	return &managementRoute{
+		Description: d.Get("description").(string),
+		Gateway:     d.Get("gateway").(string),
+		MTU:         d.Get("mtu").(int),
+	}
+}
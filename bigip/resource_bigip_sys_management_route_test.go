@@ -0,0 +1,108 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_MANAGEMENT_ROUTE_NAME = fmt.Sprintf("/%s/test-mgmt-route", TEST_PARTITION)
+
+var TEST_MANAGEMENT_ROUTE_RESOURCE = `
+resource "bigip_sys_management_route" "test-mgmt-route" {
+	name = "` + TEST_MANAGEMENT_ROUTE_NAME + `"
+	network = "10.20.0.0/16"
+	gateway = "10.1.1.254"
+	description = "route to the NTP servers"
+}
+`
+
+func TestAccBigipSysManagementRoute_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysManagementRouteDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_MANAGEMENT_ROUTE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysManagementRouteExists(TEST_MANAGEMENT_ROUTE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-mgmt-route", "name", TEST_MANAGEMENT_ROUTE_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-mgmt-route", "network", "10.20.0.0/16"),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-mgmt-route", "gateway", "10.1.1.254"),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-mgmt-route", "description", "route to the NTP servers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysManagementRoute_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysManagementRouteDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_MANAGEMENT_ROUTE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysManagementRouteExists(TEST_MANAGEMENT_ROUTE_NAME, true),
+				),
+				ResourceName:      TEST_MANAGEMENT_ROUTE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysManagementRouteExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p managementRoute
+		ok, err := getForEntity(client, &p, "sys", uriManagementRoute, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("Management route %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("Management route %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysManagementRouteDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_management_route" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p managementRoute
+		ok, err := getForEntity(client, &p, "sys", uriManagementRoute, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("Management route %s not destroyed.", name)
+		}
+	}
+	return nil
+}
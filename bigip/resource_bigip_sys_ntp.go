@@ -13,6 +13,13 @@ import (
 	"log"
 )
 
+// sysNtp is bigip.NTP without omitempty on the servers, which would keep the last servers from being removed
+type sysNtp struct {
+	Description string   `json:"description,omitempty"`
+	Servers     []string `json:"servers"`
+	Timezone    string   `json:"timezone,omitempty"`
+}
+
 func resourceBigipSysNtp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSysNtpCreate,
@@ -42,6 +49,7 @@ func resourceBigipSysNtp() *schema.Resource {
 			"timezone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Servers timezone",
 			},
 		},
@@ -69,7 +77,7 @@ func resourceBigipSysNtpCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	d.SetId(description)
-	return resourceBigipSysNtpRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSysNtpRead)
 }
 
 func resourceBigipSysNtpUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -79,13 +87,13 @@ func resourceBigipSysNtpUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Println("[INFO] Updating NTP " + description)
 
-	r := &bigip.NTP{
+	r := &sysNtp{
 		Description: description,
 		Servers:     setToStringSlice(d.Get("servers").(*schema.Set)),
 		Timezone:    d.Get("timezone").(string),
 	}
 
-	err := client.ModifyNTP(r)
+	err := putEntity(client, r, "sys", "ntp")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Modify  NTP  (%v) ", err)
 		return err
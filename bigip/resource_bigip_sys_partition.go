@@ -0,0 +1,129 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type authPartition struct {
+	Name               string `json:"name,omitempty"`
+	DefaultRouteDomain int    `json:"defaultRouteDomain"`
+	Description        string `json:"description"`
+}
+
+// bigip_sys_partition manages an administrative partition, so that the objects of a tenant can be created in it.
+// The device refuses to delete a partition that still has objects.
+func resourceBigipSysPartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysPartitionCreate,
+		Update: resourceBigipSysPartitionUpdate,
+		Read:   resourceBigipSysPartitionRead,
+		Delete: resourceBigipSysPartitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the partition, e.g. Tenant",
+				ValidateFunc: validatePartitionName,
+			},
+			"default_route_domain": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "ID of the route domain of the addresses of the partition that have none",
+				ValidateFunc: validation.IntBetween(0, 65534),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the partition",
+			},
+		},
+	}
+}
+
+func resourceBigipSysPartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating partition " + name)
+
+	p := getSysPartitionConfig(d)
+	p.Name = name
+	err := postEntity(client, p, "auth", "partition")
+	if err != nil {
+		return fmt.Errorf("Error creating partition (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysPartitionRead)
+}
+
+func resourceBigipSysPartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := patchEntity(client, getSysPartitionConfig(d), "auth", "partition", name)
+	if err != nil {
+		return fmt.Errorf("Error modifying partition (%s): %s", name, err)
+	}
+	return resourceBigipSysPartitionRead(d, meta)
+}
+
+func resourceBigipSysPartitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var p authPartition
+	ok, err := getForEntity(client, &p, "auth", "partition", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve partition (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] Partition (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("default_route_domain", p.DefaultRouteDomain)
+	d.Set("description", p.Description)
+	return nil
+}
+
+func resourceBigipSysPartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting partition " + name)
+
+	err := deleteEntity(client, "auth", "partition", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete partition (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysPartitionConfig(d *schema.ResourceData) *authPartition {
+	return &authPartition{
+		DefaultRouteDomain: d.Get("default_route_domain").(int),
+		Description:        d.Get("description").(string),
+	}
+}
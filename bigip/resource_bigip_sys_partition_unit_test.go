@@ -0,0 +1,93 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysPartition(url, description string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_partition" "tenant" {
+			name = "Tenant"
+			default_route_domain = 2
+			description = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, description, url)
+}
+
+func TestAccBigipSysPartition(t *testing.T) {
+	var partition map[string]interface{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/auth/partition", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &partition)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/auth/partition/Tenant", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &partition)
+		case "DELETE":
+			partition = nil
+			return
+		}
+		if partition == nil {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(partition)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Nil(t, partition, "the partition was deleted")
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysPartition(server.URL, "Tenant A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_partition.tenant", "id", "Tenant"),
+					resource.TestCheckResourceAttr("bigip_sys_partition.tenant", "default_route_domain", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_partition.tenant", "description", "Tenant A"),
+				),
+			},
+			{
+				Config: testBigipSysPartition(server.URL, "Tenant B"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_partition.tenant", "description", "Tenant B"),
+					func(s *terraform.State) error {
+						assert.Equal(t, "Tenant", partition["name"], "the partition was modified, not replaced")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type sysDb struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value"`
+}
+
+// The db variables of the GUI preferences, by attribute
+var sysPreferencesDb = map[string]string{
+	"records_per_screen": "ui.system.preferences.recordsperscreen",
+	"start_screen":       "ui.system.preferences.startscreen",
+	"advanced_selection": "ui.system.preferences.advancedselection",
+	"advisory_banner":    "ui.advisory.enabled",
+	"advisory_color":     "ui.advisory.color",
+	"advisory_text":      "ui.advisory.text",
+}
+
+// bigip_sys_preferences manages the preferences of the configuration utility, the GUI. There is only one set
+// of preferences, the id of the resource is preferences. Unset preferences are left as they are, and Delete
+// leaves them all unchanged.
+func resourceBigipSysPreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysPreferencesCreate,
+		Update: resourceBigipSysPreferencesUpdate,
+		Read:   resourceBigipSysPreferencesRead,
+		Delete: resourceBigipSysPreferencesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"records_per_screen": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of records the lists of the GUI show per page",
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"start_screen": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Screen shown after login, e.g. welcome, statistics or virtual_servers",
+			},
+			"advanced_selection": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the GUI shows the basic or advanced settings of objects by default",
+				ValidateFunc: validateStringValue([]string{"basic", "advanced"}),
+			},
+			"advisory_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the advisory banner is shown at the top of every page",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"advisory_color": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Color of the advisory banner",
+				ValidateFunc: validateStringValue([]string{"blue", "green", "orange", "red", "yellow"}),
+			},
+			"advisory_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the advisory banner, the hostname and device role are shown when empty",
+			},
+		},
+	}
+}
+
+func resourceBigipSysPreferencesCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring GUI preferences")
+
+	if err := setSysPreferences(d, meta); err != nil {
+		return err
+	}
+	d.SetId("preferences")
+	return resourceBigipSysPreferencesRead(d, meta)
+}
+
+func resourceBigipSysPreferencesUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating GUI preferences")
+
+	if err := setSysPreferences(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysPreferencesRead(d, meta)
+}
+
+func resourceBigipSysPreferencesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	for attribute, name := range sysPreferencesDb {
+		var db sysDb
+		_, err := getForEntity(client, &db, "sys", "db", name)
+		if err != nil {
+			log.Printf("[ERROR] Unable to retrieve db variable (%s) (%v)", name, err)
+			return err
+		}
+		switch attribute {
+		case "records_per_screen":
+			records, _ := strconv.Atoi(db.Value)
+			d.Set(attribute, records)
+		case "advisory_banner":
+			if db.Value == "true" {
+				d.Set(attribute, "enabled")
+			} else {
+				d.Set(attribute, "disabled")
+			}
+		default:
+			d.Set(attribute, db.Value)
+		}
+	}
+	return nil
+}
+
+func resourceBigipSysPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Leaving GUI preferences unchanged")
+	d.SetId("")
+	return nil
+}
+
+// setSysPreferences sets the db variables of the configured preferences that changed
+func setSysPreferences(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	for attribute, name := range sysPreferencesDb {
+		v, ok := d.GetOk(attribute)
+		if !ok || !d.HasChange(attribute) {
+			continue
+		}
+		var value string
+		switch attribute {
+		case "records_per_screen":
+			value = strconv.Itoa(v.(int))
+		case "advisory_banner":
+			value = strconv.FormatBool(v.(string) == "enabled")
+		default:
+			value = v.(string)
+		}
+		err := patchEntity(client, &sysDb{Value: value}, "sys", "db", name)
+		if err != nil {
+			return fmt.Errorf("Error setting db variable (%s): %s", name, err)
+		}
+	}
+	return nil
+}
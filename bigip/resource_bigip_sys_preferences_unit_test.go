@@ -0,0 +1,77 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSysPreferencesCreate(t *testing.T) {
+	db := map[string]string{
+		"ui.system.preferences.recordsperscreen":  "10",
+		"ui.system.preferences.startscreen":       "welcome",
+		"ui.system.preferences.advancedselection": "basic",
+		"ui.advisory.enabled":                     "false",
+		"ui.advisory.color":                       "green",
+		"ui.advisory.text":                        "",
+	}
+	patches := map[string]string{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/db/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/mgmt/tm/sys/db/")
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			patches[name] = string(b)
+			var v sysDb
+			json.Unmarshal(b, &v)
+			db[name] = v.Value
+		}
+		json.NewEncoder(w).Encode(sysDb{Name: name, Value: db[name]})
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_sys_preferences" "gui" {
+						records_per_screen = 100
+						advisory_banner = "enabled"
+						advisory_color = "red"
+						advisory_text = "PRODUCTION"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_preferences.gui", "id", "preferences"),
+					resource.TestCheckResourceAttr("bigip_sys_preferences.gui", "records_per_screen", "100"),
+					resource.TestCheckResourceAttr("bigip_sys_preferences.gui", "advisory_banner", "enabled"),
+					resource.TestCheckResourceAttr("bigip_sys_preferences.gui", "start_screen", "welcome"),
+				),
+			},
+		},
+	})
+	assert.Len(t, patches, 4, "Unset preferences are left as they are")
+	assert.JSONEq(t, `{"value":"100"}`, patches["ui.system.preferences.recordsperscreen"])
+	assert.JSONEq(t, `{"value":"true"}`, patches["ui.advisory.enabled"])
+}
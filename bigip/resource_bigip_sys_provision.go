@@ -8,11 +8,31 @@ package bigip
 
 import (
 	"fmt"
+	"log"
+	"strings"
+	"time"
+
 	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	"log"
 )
 
+const uriProvision = "provision"
+
+var provisionModules = []string{"ltm", "gtm", "asm", "apm", "afm", "avr", "ilx", "pem", "fps", "swg", "urldb", "sslo", "cgnat", "dos"}
+
+// How long to wait after a provisioning or license change before polling the device, mcpd takes a moment
+// to start applying it and reports being ready until then
+var deviceSettleInterval = 15 * time.Second
+
+type sysProvision struct {
+	FullPath    string `json:"fullPath,omitempty"`
+	Level       string `json:"level,omitempty"`
+	CpuRatio    int    `json:"cpuRatio,omitempty"`
+	DiskRatio   int    `json:"diskRatio,omitempty"`
+	MemoryRatio int    `json:"memoryRatio,omitempty"`
+}
+
 func resourceBigipSysProvision() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSysProvisionCreate,
@@ -22,42 +42,54 @@ func resourceBigipSysProvision() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the module to be provisioned",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the module to be provisioned, e.g. ltm, gtm, asm, apm, afm or avr",
+				ValidateFunc: validateProvisionModule,
 			},
 
 			"full_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				Deprecated:  "The module is set by name",
 				Description: "path",
 			},
 
 			"cpu_ratio": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				Description: "cpu Ratio",
 			},
 
 			"disk_ratio": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				Description: "disk Ratio",
 			},
 
 			"level": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "what level nominal or dedicated",
-				Default:     "nominal",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Provisioning level of the module, none, minimum, nominal or dedicated",
+				Default:      "nominal",
+				ValidateFunc: validateStringValue([]string{"none", "minimum", "nominal", "dedicated"}),
 			},
 
 			"memory_ratio": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				Description: "memory Ratio",
 			},
 		},
@@ -65,54 +97,30 @@ func resourceBigipSysProvision() *schema.Resource {
 
 }
 
-func resourceBigipSysProvisionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*bigip.BigIP)
-
-	name := d.Get("name").(string)
-	FullPath := d.Get("full_path").(string)
-	cpuRatio := d.Get("cpu_ratio").(int)
-	diskRatio := d.Get("disk_ratio").(int)
-	level := d.Get("level").(string)
-	memoryRatio := d.Get("memory_ratio").(int)
+// provisionModule returns the module of a provision name, the name used to be given as /Common/<module>
+func provisionModule(name string) string {
+	return strings.TrimPrefix(name, "/Common/")
+}
 
-	log.Println("[INFO] Provisioning  ")
+func validateProvisionModule(value interface{}, field string) (ws []string, errors []error) {
+	return validateStringValue(provisionModules)(provisionModule(value.(string)), field)
+}
 
-	err := client.CreateProvision(
-		name,
-		FullPath,
-		cpuRatio,
-		diskRatio,
-		level,
-		memoryRatio,
-	)
+func resourceBigipSysProvisionCreate(d *schema.ResourceData, meta interface{}) error {
+	module := provisionModule(d.Get("name").(string))
+	log.Printf("[INFO] Provisioning %s at level %s", module, d.Get("level").(string))
 
-	if err != nil {
-		log.Printf("[ERROR] Unable to Create Provision  (%s) ", err)
+	if err := setSysProvision(d, meta, module, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return err
 	}
-	d.SetId(name)
+	d.SetId(module)
 	return resourceBigipSysProvisionRead(d, meta)
 }
 
 func resourceBigipSysProvisionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*bigip.BigIP)
-
-	name := d.Id()
+	log.Printf("[INFO] Updating Provisioning of %s to level %s", d.Id(), d.Get("level").(string))
 
-	log.Println("[INFO] Updating Provsioning " + name)
-
-	r := &bigip.Provision{
-		Name:        name,
-		FullPath:    d.Get("full_path").(string),
-		CpuRatio:    d.Get("cpu_ratio").(int),
-		DiskRatio:   d.Get("disk_ratio").(int),
-		Level:       d.Get("level").(string),
-		MemoryRatio: d.Get("memory_ratio").(int),
-	}
-
-	err := client.ModifyProvision(r)
-	if err != nil {
-		log.Printf("[ERROR] Unable to Retrieve Provision (%v) ", err)
+	if err := setSysProvision(d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 	return resourceBigipSysProvisionRead(d, meta)
@@ -121,23 +129,25 @@ func resourceBigipSysProvisionUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceBigipSysProvisionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 
-	name := d.Id()
+	module := d.Id()
 
-	log.Println("[INFO] Reading Provisions " + name)
+	log.Println("[INFO] Reading Provisions " + module)
 
-	p, err := client.Provisions(name)
+	var p sysProvision
+	ok, err := getForEntity(client, &p, "sys", uriProvision, module)
 	if err != nil {
-		log.Printf("[ERROR] Unable to Retrieve Provision (%s) (%v) ", name, err)
+		log.Printf("[ERROR] Unable to Retrieve Provision (%s) (%v) ", module, err)
 		return err
 	}
-	if p == nil {
-		log.Printf("[WARN] Provision (%s) not found, removing from state", d.Id())
+	if !ok {
+		log.Printf("[WARN] Provision (%s) not found, removing from state", module)
 		d.SetId("")
 		return nil
 	}
-	if err := d.Set("full_path", p.FullPath); err != nil {
-		return fmt.Errorf("[DEBUG] Error saving FullPath to state for Provision  (%s): %s", d.Id(), err)
+	if _, ok := d.GetOk("name"); !ok {
+		d.Set("name", module)
 	}
+	d.Set("full_path", p.FullPath)
 	d.Set("cpu_ratio", p.CpuRatio)
 	d.Set("disk_ratio", p.DiskRatio)
 	d.Set("level", p.Level)
@@ -147,6 +157,50 @@ func resourceBigipSysProvisionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBigipSysProvisionDelete(d *schema.ResourceData, meta interface{}) error {
-	//API is not supported for Deleting
+	// Modules can't be deleted, the provisioning is left as is rather than deprovisioning a module
+	// other resources may still use
+	log.Printf("[INFO] Leaving provisioning of %s unchanged", d.Id())
+	d.SetId("")
 	return nil
 }
+
+// setSysProvision sets the provisioning level of a module and waits for the device to apply it
+func setSysProvision(d *schema.ResourceData, meta interface{}, module string, timeout time.Duration) error {
+	client := meta.(*bigip.BigIP)
+
+	p := &sysProvision{
+		Level:       d.Get("level").(string),
+		CpuRatio:    d.Get("cpu_ratio").(int),
+		DiskRatio:   d.Get("disk_ratio").(int),
+		MemoryRatio: d.Get("memory_ratio").(int),
+	}
+	err := patchEntity(client, p, "sys", uriProvision, module)
+	if err != nil {
+		return fmt.Errorf("Error provisioning %s: %s", module, err)
+	}
+	return waitForDeviceReady(client, timeout)
+}
+
+// waitForDeviceReady waits until the device is ready after a provisioning or license change. Depending on
+// the change, mcpd and the REST framework restart or the device reboots, so failing requests are retried.
+func waitForDeviceReady(client *bigip.BigIP, timeout time.Duration) error {
+	log.Printf("[INFO] Waiting for %s to be ready", client.Host)
+	time.Sleep(deviceSettleInterval)
+	return resource.Retry(timeout-deviceSettleInterval, func() *resource.RetryError {
+		var ready stats
+		_, err := getForEntity(client, &ready, "sys", "ready")
+		if err != nil {
+			return resource.RetryableError(fmt.Errorf("%s is not reachable: %s", client.Host, err))
+		}
+		for _, e := range ready.Entries {
+			for _, check := range []string{"configReady", "licenseReady", "provisionReady"} {
+				if e.NestedStats.Entries[check].Description != "yes" {
+					return resource.RetryableError(fmt.Errorf("%s is not ready, %s is %q", client.Host, check,
+						e.NestedStats.Entries[check].Description))
+				}
+			}
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("%s did not report whether it is ready", client.Host))
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysProvision(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_provision" "test-provision" {
+			name = "asm"
+			level = "nominal"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysProvisionWaitsForReady(t *testing.T) {
+	defer func(interval time.Duration) { deviceSettleInterval = interval }(deviceSettleInterval)
+	deviceSettleInterval = 0
+
+	level := "none"
+	notReady := 0
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"level":"nominal"}`, string(b))
+			level = "nominal"
+			notReady = 2
+		}
+		fmt.Fprintf(w, `{"name":"asm","fullPath":"asm","cpuRatio":0,"diskRatio":0,"memoryRatio":0,"level":"%s"}`, level)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ready", func(w http.ResponseWriter, r *http.Request) {
+		provisionReady := "yes"
+		if notReady > 0 {
+			notReady--
+			provisionReady = "no"
+		}
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/ready/0":{"nestedStats":{"entries":{
+			"configReady":{"description":"yes"},"licenseReady":{"description":"yes"},"provisionReady":{"description":"%s"}}}}}}`, provisionReady)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysProvision(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_provision.test-provision", "id", "asm"),
+					resource.TestCheckResourceAttr("bigip_sys_provision.test-provision", "level", "nominal"),
+					func(s *terraform.State) error {
+						assert.Equal(t, 0, notReady, "Create returned before the device was ready")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysProvisionInvalidLevel(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bigip_sys_provision" "test-provision" {
+						name = "asm"
+						level = "custom"
+					}
+					provider "bigip" {
+						address = "xxx.xxx.xxx.xxx"
+						username = "xxxxx"
+						password = "xxxxx"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`"level" must be one of`),
+			},
+		},
+	})
+}
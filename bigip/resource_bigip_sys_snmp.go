@@ -13,6 +13,13 @@ import (
 	"log"
 )
 
+// sysSnmp is bigip.SNMP without omitempty on the allowed addresses, which would keep the last ones from being removed
+type sysSnmp struct {
+	SysContact       string   `json:"sysContact,omitempty"`
+	SysLocation      string   `json:"sysLocation,omitempty"`
+	AllowedAddresses []string `json:"allowedAddresses"`
+}
+
 // this module does not have DELETE function as there is no API for Delete.
 func resourceBigipSysSnmp() *schema.Resource {
 	return &schema.Resource{
@@ -28,11 +35,13 @@ func resourceBigipSysSnmp() *schema.Resource {
 			"sys_contact": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Contact Person email",
 			},
 			"sys_location": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Location of the F5 ",
 			},
 			"allowedaddresses": {
@@ -67,7 +76,7 @@ func resourceBigipSysSnmpCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetId(sysContact)
-	return resourceBigipSysSnmpRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSysSnmpRead)
 }
 
 func resourceBigipSysSnmpUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -77,13 +86,13 @@ func resourceBigipSysSnmpUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Updating SNMP " + sysContact)
 
-	r := &bigip.SNMP{
-		SysContact:       sysContact,
+	r := &sysSnmp{
+		SysContact:       d.Get("sys_contact").(string),
 		SysLocation:      d.Get("sys_location").(string),
 		AllowedAddresses: setToStringSlice(d.Get("allowedaddresses").(*schema.Set)),
 	}
 
-	err := client.ModifySNMP(r)
+	err := putEntity(client, r, "sys", "snmp")
 	if err != nil {
 		log.Printf("[ERROR] Unable to Modify SNMP (%s) (%v) ", sysContact, err)
 		return err
@@ -0,0 +1,163 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriSnmpCommunities = "communities"
+
+type snmpCommunity struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	CommunityName string `json:"communityName,omitempty"`
+	Source        string `json:"source,omitempty"`
+	OidSubset     string `json:"oidSubset,omitempty"`
+	Access        string `json:"access,omitempty"`
+	Ipv6          string `json:"ipv6,omitempty"`
+}
+
+func resourceBigipSysSnmpCommunity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSnmpCommunityCreate,
+		Update: resourceBigipSysSnmpCommunityUpdate,
+		Read:   resourceBigipSysSnmpCommunityRead,
+		Delete: resourceBigipSysSnmpCommunityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the SNMP v1/v2c community",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"community_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Community string SNMP clients use",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address or network the community is restricted to, any source when not set",
+			},
+			"oid_subset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OID of the subtree the community is restricted to, e.g. .1.3.6.1.4.1.3375",
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ro",
+				Description:  "ro for read only access, rw for read write access",
+				ValidateFunc: validateStringValue([]string{"ro", "rw"}),
+			},
+			"ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether source is an IPv6 address",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysSnmpCommunityCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating SNMP community " + name)
+
+	r := getSysSnmpCommunityConfig(d)
+	r.Name = name
+	err := postEntity(client, r, "sys", "snmp", uriSnmpCommunities)
+	if err != nil {
+		return fmt.Errorf("Error creating SNMP community (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysSnmpCommunityRead)
+}
+
+func resourceBigipSysSnmpCommunityUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getSysSnmpCommunityConfig(d), "sys", "snmp", uriSnmpCommunities, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying SNMP community (%s): %s", name, err)
+	}
+	return resourceBigipSysSnmpCommunityRead(d, meta)
+}
+
+func resourceBigipSysSnmpCommunityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r snmpCommunity
+	ok, err := getForEntity(client, &r, "sys", "snmp", uriSnmpCommunities, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve SNMP community (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] SNMP community (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("community_name", r.CommunityName)
+	d.Set("source", r.Source)
+	d.Set("oid_subset", r.OidSubset)
+	d.Set("access", r.Access)
+	d.Set("ipv6", r.Ipv6)
+	return nil
+}
+
+func resourceBigipSysSnmpCommunityDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SNMP community " + name)
+
+	err := deleteEntity(client, "sys", "snmp", uriSnmpCommunities, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SNMP community (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysSnmpCommunityConfig(d *schema.ResourceData) *snmpCommunity {
+	return &snmpCommunity{
+		Description:   d.Get("description").(string),
+		CommunityName: d.Get("community_name").(string),
+		Source:        d.Get("source").(string),
+		OidSubset:     d.Get("oid_subset").(string),
+		Access:        d.Get("access").(string),
+		Ipv6:          d.Get("ipv6").(string),
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SNMP_COMMUNITY_NAME = fmt.Sprintf("/%s/test-community", TEST_PARTITION)
+
+var TEST_SNMP_COMMUNITY_RESOURCE = `
+resource "bigip_sys_snmp_community" "test-community" {
+	name = "` + TEST_SNMP_COMMUNITY_NAME + `"
+	community_name = "f5monitoring"
+	source = "10.10.0.0/16"
+	oid_subset = ".1.3.6.1.4.1.3375"
+	access = "ro"
+}
+`
+
+func TestAccBigipSysSnmpCommunity_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysSnmpCommunityDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SNMP_COMMUNITY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysSnmpCommunityExists(TEST_SNMP_COMMUNITY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_community.test-community", "name", TEST_SNMP_COMMUNITY_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_community.test-community", "community_name", "f5monitoring"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_community.test-community", "source", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_community.test-community", "oid_subset", ".1.3.6.1.4.1.3375"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_community.test-community", "access", "ro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysSnmpCommunity_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysSnmpCommunityDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SNMP_COMMUNITY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysSnmpCommunityExists(TEST_SNMP_COMMUNITY_NAME, true),
+				),
+				ResourceName:      TEST_SNMP_COMMUNITY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysSnmpCommunityExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p snmpCommunity
+		ok, err := getForEntity(client, &p, "sys", "snmp", uriSnmpCommunities, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("SNMP community %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("SNMP community %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysSnmpCommunityDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_snmp_community" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p snmpCommunity
+		ok, err := getForEntity(client, &p, "sys", "snmp", uriSnmpCommunities, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("SNMP community %s not destroyed.", name)
+		}
+	}
+	return nil
+}
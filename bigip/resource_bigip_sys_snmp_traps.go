@@ -13,6 +13,24 @@ import (
 	"log"
 )
 
+// snmpTrap is bigip.TRAP with the JSON name of securityName fixed, so that it can be read back
+type snmpTrap struct {
+	Name                     string `json:"name,omitempty"`
+	AuthPasswordEncrypted    string `json:"authPasswordEncrypted,omitempty"`
+	AuthProtocol             string `json:"authProtocol,omitempty"`
+	Community                string `json:"community,omitempty"`
+	Description              string `json:"description,omitempty"`
+	EngineId                 string `json:"engineId,omitempty"`
+	Host                     string `json:"host,omitempty"`
+	Port                     int    `json:"port,omitempty"`
+	PrivacyPassword          string `json:"privacyPassword,omitempty"`
+	PrivacyPasswordEncrypted string `json:"privacyPasswordEncrypted,omitempty"`
+	PrivacyProtocol          string `json:"privacyProtocol,omitempty"`
+	SecurityLevel            string `json:"securityLevel,omitempty"`
+	SecurityName             string `json:"securityName,omitempty"`
+	Version                  string `json:"version,omitempty"`
+}
+
 // this module does not have DELETE function as there is no API for Delete
 func resourceBigipSysSnmpTraps() *schema.Resource {
 	return &schema.Resource{
@@ -34,12 +52,14 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 			"auth_passwordencrypted": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Encrypted password ",
 			},
 
 			"auth_protocol": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies the protocol used to authenticate the user.",
 			},
 
@@ -69,6 +89,7 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 			"port": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				Description: "The port that the trap will be sent to.",
 			},
 			"privacy_password": {
@@ -79,16 +100,19 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 			"privacy_password_encrypted": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies the encrypted password used to encrypt traffic. ",
 			},
 			"privacy_protocol": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies the protocol used to encrypt traffic. ",
 			},
 			"security_level": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies whether or not traffic is encrypted and whether or not authentication is required.",
 			},
 
@@ -101,6 +125,7 @@ func resourceBigipSysSnmpTraps() *schema.Resource {
 			"version": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "SNMP version used for sending the trap. ",
 			},
 		},
@@ -112,45 +137,32 @@ func resourceBigipSysSnmpTrapsCreate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*bigip.BigIP)
 
 	name := d.Get("name").(string)
-	authPasswordEncrypted := d.Get("auth_passwordencrypted").(string)
-	authProtocol := d.Get("auth_protocol").(string)
-	community := d.Get("community").(string)
-	description := d.Get("description").(string)
-	engineId := d.Get("engine_id").(string)
-	host := d.Get("host").(string)
-	port := d.Get("port").(int)
-	privacyPassword := d.Get("privacy_password").(string)
-	privacyPasswordEncrypted := d.Get("privacy_password_encrypted").(string)
-	privacyProtocol := d.Get("privacy_protocol").(string)
-	securityLevel := d.Get("security_level").(string)
-	securityName := d.Get("security_name").(string)
-	version := d.Get("version").(string)
 
 	log.Println("[INFO] Creating Snmp traps ")
 
-	err := client.CreateTRAP(
-		name,
-		authPasswordEncrypted,
-		authProtocol,
-		community,
-		description,
-		engineId,
-		host,
-		port,
-		privacyPassword,
-		privacyPasswordEncrypted,
-		privacyProtocol,
-		securityLevel,
-		securityName,
-		version,
-	)
+	err := postEntity(client, &snmpTrap{
+		Name:                     name,
+		AuthPasswordEncrypted:    d.Get("auth_passwordencrypted").(string),
+		AuthProtocol:             d.Get("auth_protocol").(string),
+		Community:                d.Get("community").(string),
+		Description:              d.Get("description").(string),
+		EngineId:                 d.Get("engine_id").(string),
+		Host:                     d.Get("host").(string),
+		Port:                     d.Get("port").(int),
+		PrivacyPassword:          d.Get("privacy_password").(string),
+		PrivacyPasswordEncrypted: d.Get("privacy_password_encrypted").(string),
+		PrivacyProtocol:          d.Get("privacy_protocol").(string),
+		SecurityLevel:            d.Get("security_level").(string),
+		SecurityName:             d.Get("security_name").(string),
+		Version:                  d.Get("version").(string),
+	}, "sys", "snmp", "traps")
 
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create SNMP trap (%s) (%v) ", name, err)
 		return err
 	}
 	d.SetId(name)
-	return resourceBigipSysSnmpTrapsRead(d, meta)
+	return readAfterCreate(d, meta, resourceBigipSysSnmpTrapsRead)
 }
 
 func resourceBigipSysSnmpTrapsUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -160,9 +172,10 @@ func resourceBigipSysSnmpTrapsUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating SNMP Traps " + name)
 
-	r := &bigip.TRAP{
+	r := &snmpTrap{
 		Name:                     name,
 		Host:                     d.Get("host").(string),
+		Port:                     d.Get("port").(int),
 		AuthPasswordEncrypted:    d.Get("auth_passwordencrypted").(string),
 		AuthProtocol:             d.Get("auth_protocol").(string),
 		Community:                d.Get("community").(string),
@@ -176,7 +189,7 @@ func resourceBigipSysSnmpTrapsUpdate(d *schema.ResourceData, meta interface{}) e
 		Version:                  d.Get("version").(string),
 	}
 
-	err := client.ModifyTRAP(r)
+	err := putEntity(client, r, "sys", "snmp", "traps", name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Modify SNMP trap (%v) ", err)
 		return err
@@ -187,16 +200,17 @@ func resourceBigipSysSnmpTrapsUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceBigipSysSnmpTrapsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 
-	host := d.Id()
+	name := d.Id()
 
-	log.Println("[INFO] Reading SNMP traps " + host)
+	log.Println("[INFO] Reading SNMP traps " + name)
 
-	traps, err := client.TRAPs()
+	var traps snmpTrap
+	ok, err := getForEntity(client, &traps, "sys", "snmp", "traps", name)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve SNMP trap (%v) ", err)
 		return err
 	}
-	if traps == nil {
+	if !ok {
 		log.Printf("[WARN] SNMP traps (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -218,7 +232,7 @@ func resourceBigipSysSnmpTrapsRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("[DEBUG] Error saving Host to state for Snmp Traps  (%s): %s", d.Id(), err)
 	}
 	d.Set("port", traps.Port)
-	d.Set("privacy_password", traps.PrivacyPassword)
+	// The clear text privacy password is not returned, the one of the configuration is kept
 	if err := d.Set("privacy_password_encrypted", traps.PrivacyPasswordEncrypted); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving PrivacyPasswordEncrypted to state for Snmp Traps (%s): %s", d.Id(), err)
 	}
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysSnmpTraps(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_snmp_traps" "test-traps" {
+			name = "test-traps"
+			community = "f5community"
+			host = "195.10.10.1"
+			port = 111
+			security_name = "monitor"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysSnmpTrapsCreate(t *testing.T) {
+	created := false
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/snmp/traps", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"test-traps","community":"f5community","host":"195.10.10.1","port":111,"securityName":"monitor"}`, string(b))
+		created = true
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/snmp/traps/test-traps", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			created = false
+			return
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-traps","community":"f5community","host":"195.10.10.1","port":111,
+			"securityName":"monitor","authProtocol":"none","privacyProtocol":"none","securityLevel":"no-auth-no-privacy","version":"2c"}`)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysSnmpTraps(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_snmp_traps.test-traps", "host", "195.10.10.1"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_traps.test-traps", "port", "111"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_traps.test-traps", "security_name", "monitor"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_traps.test-traps", "version", "2c"),
+				),
+			},
+		},
+	})
+	assert.False(t, created, "SNMP trap was not deleted")
+}
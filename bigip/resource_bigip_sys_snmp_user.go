@@ -0,0 +1,181 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriSnmpUsers = "users"
+
+type snmpUser struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Username        string `json:"username,omitempty"`
+	AuthProtocol    string `json:"authProtocol,omitempty"`
+	AuthPassword    string `json:"authPassword,omitempty"`
+	PrivacyProtocol string `json:"privacyProtocol,omitempty"`
+	PrivacyPassword string `json:"privacyPassword,omitempty"`
+	OidSubset       string `json:"oidSubset,omitempty"`
+	Access          string `json:"access,omitempty"`
+}
+
+func resourceBigipSysSnmpUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSnmpUserCreate,
+		Update: resourceBigipSysSnmpUserUpdate,
+		Read:   resourceBigipSysSnmpUserRead,
+		Delete: resourceBigipSysSnmpUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the SNMP v3 user",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User name SNMP clients authenticate with",
+			},
+			"auth_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha",
+				Description:  "Protocol used to authenticate the user, md5, sha or none",
+				ValidateFunc: validateStringValue([]string{"md5", "sha", "none"}),
+			},
+			"auth_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to authenticate the user, at least 8 characters",
+			},
+			"privacy_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "aes",
+				Description:  "Protocol used to encrypt the traffic, aes, des or none",
+				ValidateFunc: validateStringValue([]string{"aes", "des", "none"}),
+			},
+			"privacy_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to encrypt the traffic, at least 8 characters",
+			},
+			"oid_subset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OID of the subtree the user is restricted to, e.g. .1.3.6.1.4.1.3375",
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ro",
+				Description:  "ro for read only access, rw for read write access",
+				ValidateFunc: validateStringValue([]string{"ro", "rw"}),
+			},
+		},
+	}
+}
+
+func resourceBigipSysSnmpUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating SNMP user " + name)
+
+	r := getSysSnmpUserConfig(d)
+	r.Name = name
+	err := postEntity(client, r, "sys", "snmp", uriSnmpUsers)
+	if err != nil {
+		return fmt.Errorf("Error creating SNMP user (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysSnmpUserRead)
+}
+
+func resourceBigipSysSnmpUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	err := putEntity(client, getSysSnmpUserConfig(d), "sys", "snmp", uriSnmpUsers, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying SNMP user (%s): %s", name, err)
+	}
+	return resourceBigipSysSnmpUserRead(d, meta)
+}
+
+func resourceBigipSysSnmpUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var r snmpUser
+	ok, err := getForEntity(client, &r, "sys", "snmp", uriSnmpUsers, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve SNMP user (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] SNMP user (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", r.Description)
+	d.Set("username", r.Username)
+	d.Set("auth_protocol", r.AuthProtocol)
+	d.Set("privacy_protocol", r.PrivacyProtocol)
+	d.Set("oid_subset", r.OidSubset)
+	d.Set("access", r.Access)
+	// The passwords are only returned encrypted, the ones of the configuration are kept
+	return nil
+}
+
+func resourceBigipSysSnmpUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SNMP user " + name)
+
+	err := deleteEntity(client, "sys", "snmp", uriSnmpUsers, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SNMP user (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysSnmpUserConfig(d *schema.ResourceData) *snmpUser {
+	return &snmpUser{
+		Description:     d.Get("description").(string),
+		Username:        d.Get("username").(string),
+		AuthProtocol:    d.Get("auth_protocol").(string),
+		AuthPassword:    d.Get("auth_password").(string),
+		PrivacyProtocol: d.Get("privacy_protocol").(string),
+		PrivacyPassword: d.Get("privacy_password").(string),
+		OidSubset:       d.Get("oid_subset").(string),
+		Access:          d.Get("access").(string),
+	}
+}
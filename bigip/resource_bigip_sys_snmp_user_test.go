@@ -0,0 +1,114 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SNMP_USER_NAME = fmt.Sprintf("/%s/test-snmp-user", TEST_PARTITION)
+
+var TEST_SNMP_USER_RESOURCE = `
+resource "bigip_sys_snmp_user" "test-snmp-user" {
+	name = "` + TEST_SNMP_USER_NAME + `"
+	username = "monitor"
+	auth_protocol = "sha"
+	auth_password = "authpass123"
+	privacy_protocol = "aes"
+	privacy_password = "privpass123"
+	access = "ro"
+}
+`
+
+func TestAccBigipSysSnmpUser_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysSnmpUserDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SNMP_USER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysSnmpUserExists(TEST_SNMP_USER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "name", TEST_SNMP_USER_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "username", "monitor"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "auth_protocol", "sha"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "auth_password", "authpass123"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "privacy_protocol", "aes"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "privacy_password", "privpass123"),
+					resource.TestCheckResourceAttr("bigip_sys_snmp_user.test-snmp-user", "access", "ro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysSnmpUser_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysSnmpUserDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SNMP_USER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysSnmpUserExists(TEST_SNMP_USER_NAME, true),
+				),
+				ResourceName:      TEST_SNMP_USER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysSnmpUserExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p snmpUser
+		ok, err := getForEntity(client, &p, "sys", "snmp", uriSnmpUsers, name)
+		if err != nil {
+			return err
+		}
+		if exists && !ok {
+			return fmt.Errorf("SNMP user %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("SNMP user %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysSnmpUserDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_snmp_user" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p snmpUser
+		ok, err := getForEntity(client, &p, "sys", "snmp", uriSnmpUsers, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return fmt.Errorf("SNMP user %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type sysSshd struct {
+	Allow             []string `json:"allow,omitempty"`
+	Banner            string   `json:"banner,omitempty"`
+	BannerText        string   `json:"bannerText"`
+	InactivityTimeout int      `json:"inactivityTimeout"`
+	Include           string   `json:"include"`
+	LogLevel          string   `json:"logLevel,omitempty"`
+	Login             string   `json:"login,omitempty"`
+}
+
+// The sshd_config keyword of the ciphers line, which the BIG-IP has no setting for, of sshd include
+const sshdCiphersKeyword = "Ciphers "
+
+// bigip_sys_sshd manages the SSH daemon of the management interface. There is only one, the id of the
+// resource is sshd. Delete leaves the settings unchanged.
+func resourceBigipSysSshd() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSshdCreate,
+		Update: resourceBigipSysSshdUpdate,
+		Read:   resourceBigipSysSshdRead,
+		Delete: resourceBigipSysSshdDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "Addresses or networks allowed to connect, e.g. 10.0.0.0/255.0.0.0, or ALL",
+			},
+			"banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				Description:  "Whether banner_text is shown before login",
+				ValidateFunc: validateEnabledDisabled,
+			},
+			"banner_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text of the banner",
+			},
+			"ciphers": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Ciphers sshd accepts, e.g. aes256-ctr, all the ones of the BIG-IP version when not set",
+			},
+			"include": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Other sshd_config lines, the ciphers are set with ciphers",
+			},
+			"inactivity_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Seconds before an idle session is closed, 0 to never close them",
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "info",
+				Description:  "Log level of sshd",
+				ValidateFunc: validateStringValue([]string{"debug", "debug1", "debug2", "debug3", "error", "fatal", "info", "quiet", "verbose"}),
+			},
+			"login": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				Description:  "Whether users can log in with SSH",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+func resourceBigipSysSshdCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring sshd")
+
+	if err := setSysSshd(d, meta); err != nil {
+		return err
+	}
+	d.SetId("sshd")
+	return resourceBigipSysSshdRead(d, meta)
+}
+
+func resourceBigipSysSshdUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating sshd")
+
+	if err := setSysSshd(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysSshdRead(d, meta)
+}
+
+func resourceBigipSysSshdRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var s sysSshd
+	_, err := getForEntity(client, &s, "sys", "sshd")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve sshd (%v)", err)
+		return err
+	}
+	if err := d.Set("allow", s.Allow); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Allow to state for sshd: %s", err)
+	}
+	d.Set("banner", s.Banner)
+	d.Set("banner_text", s.BannerText)
+	ciphers, include := splitSshdInclude(s.Include)
+	if err := d.Set("ciphers", ciphers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Ciphers to state for sshd: %s", err)
+	}
+	d.Set("include", include)
+	d.Set("inactivity_timeout", s.InactivityTimeout)
+	d.Set("log_level", s.LogLevel)
+	d.Set("login", s.Login)
+	return nil
+}
+
+func resourceBigipSysSshdDelete(d *schema.ResourceData, meta interface{}) error {
+	// Resetting sshd could open it to addresses it was closed to, its settings are left as they are
+	log.Println("[INFO] Leaving sshd settings unchanged")
+	d.SetId("")
+	return nil
+}
+
+func setSysSshd(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	s := &sysSshd{
+		Allow:             setToStringSlice(d.Get("allow").(*schema.Set)),
+		Banner:            d.Get("banner").(string),
+		BannerText:        d.Get("banner_text").(string),
+		InactivityTimeout: d.Get("inactivity_timeout").(int),
+		Include:           joinSshdInclude(listToStringSlice(d.Get("ciphers").([]interface{})), d.Get("include").(string)),
+		LogLevel:          d.Get("log_level").(string),
+		Login:             d.Get("login").(string),
+	}
+	err := patchEntity(client, s, "sys", "sshd")
+	if err != nil {
+		return fmt.Errorf("Error configuring sshd: %s", err)
+	}
+	return nil
+}
+
+// joinSshdInclude returns the sshd include of the ciphers and the other sshd_config lines
+func joinSshdInclude(ciphers []string, include string) string {
+	include = strings.TrimSpace(include)
+	if len(ciphers) == 0 {
+		return include
+	}
+	line := sshdCiphersKeyword + strings.Join(ciphers, ",")
+	if include == "" {
+		return line
+	}
+	return line + "\n" + include
+}
+
+// splitSshdInclude returns the ciphers and the other sshd_config lines of an sshd include
+func splitSshdInclude(include string) ([]string, string) {
+	var ciphers []string
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(include), "\n") {
+		if strings.HasPrefix(line, sshdCiphersKeyword) {
+			ciphers = strings.Split(strings.TrimSpace(strings.TrimPrefix(line, sshdCiphersKeyword)), ",")
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return ciphers, strings.TrimSpace(strings.Join(lines, "\n"))
+}
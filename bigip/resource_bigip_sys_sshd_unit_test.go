@@ -0,0 +1,80 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBigipSysSshdCreate(t *testing.T) {
+	sshd := map[string]interface{}{"allow": []string{"ALL"}, "banner": "disabled", "include": "", "inactivityTimeout": 0,
+		"logLevel": "info", "login": "enabled"}
+	var patches []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/sshd", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			patches = append(patches, string(b))
+			json.Unmarshal(b, &sshd)
+		}
+		json.NewEncoder(w).Encode(sshd)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_sys_sshd" "sshd" {
+						allow = ["10.0.0.0/255.0.0.0"]
+						banner = "enabled"
+						banner_text = "Authorized use only"
+						ciphers = ["aes256-ctr", "aes128-ctr"]
+						include = "MaxAuthTries 3"
+						inactivity_timeout = 600
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_sshd.sshd", "id", "sshd"),
+					resource.TestCheckResourceAttr("bigip_sys_sshd.sshd", "ciphers.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_sshd.sshd", "ciphers.1", "aes128-ctr"),
+					resource.TestCheckResourceAttr("bigip_sys_sshd.sshd", "include", "MaxAuthTries 3"),
+				),
+			},
+		},
+	})
+	if assert.Len(t, patches, 1, "The sshd settings are left unchanged on destroy") {
+		assert.JSONEq(t, `{"allow":["10.0.0.0/255.0.0.0"],"banner":"enabled","bannerText":"Authorized use only",
+			"include":"Ciphers aes256-ctr,aes128-ctr\nMaxAuthTries 3","inactivityTimeout":600,"logLevel":"info","login":"enabled"}`, patches[0])
+	}
+}
+
+func TestSplitSshdInclude(t *testing.T) {
+	ciphers, include := splitSshdInclude("MaxAuthTries 3\nCiphers aes256-ctr,aes128-ctr\nClientAliveInterval 60\n")
+	assert.Equal(t, []string{"aes256-ctr", "aes128-ctr"}, ciphers)
+	assert.Equal(t, "MaxAuthTries 3\nClientAliveInterval 60", include)
+
+	ciphers, include = splitSshdInclude("")
+	assert.Empty(t, ciphers)
+	assert.Equal(t, "", include)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// sysSyslog is the part of sys syslog managed by bigip_sys_syslog. bigip.Syslog can't be used, its
+// MarshalJSON drops the remote servers.
+type sysSyslog struct {
+	RemoteServers []syslogRemoteServer `json:"remoteServers"`
+}
+
+type syslogRemoteServer struct {
+	Name       string `json:"name"`
+	Host       string `json:"host"`
+	RemotePort int    `json:"remotePort,omitempty"`
+	LocalIp    string `json:"localIp,omitempty"`
+}
+
+// bigip_sys_syslog manages the remote servers of the one sys syslog configuration. There is no Create or Delete
+// API for it: create sets the remote servers, delete removes them all.
+func resourceBigipSysSyslog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSyslogCreate,
+		Update: resourceBigipSysSyslogUpdate,
+		Read:   resourceBigipSysSyslogRead,
+		Delete: resourceBigipSysSyslogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"remote_server": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Remote syslog servers the local syslog messages, e.g. the audit log, are sent to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Full path of the remote server, e.g. /Common/remotesyslog1",
+							ValidateFunc: validateF5Name,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address or host name of the remote server",
+						},
+						"remote_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      514,
+							Description:  "Port of the remote server",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"local_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "none",
+							Description: "Address the messages are sent from, none to use the management address",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSysSyslogCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Configuring syslog remote servers")
+
+	if err := setSysSyslogRemoteServers(d, meta); err != nil {
+		return err
+	}
+	d.SetId("syslog")
+	return resourceBigipSysSyslogRead(d, meta)
+}
+
+func resourceBigipSysSyslogUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Updating syslog remote servers")
+
+	if err := setSysSyslogRemoteServers(d, meta); err != nil {
+		return err
+	}
+	return resourceBigipSysSyslogRead(d, meta)
+}
+
+func resourceBigipSysSyslogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	var s sysSyslog
+	_, err := getForEntity(client, &s, "sys", "syslog")
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve syslog (%v)", err)
+		return err
+	}
+
+	var servers []interface{}
+	for _, r := range s.RemoteServers {
+		servers = append(servers, map[string]interface{}{
+			"name":        r.Name,
+			"host":        r.Host,
+			"remote_port": r.RemotePort,
+			"local_ip":    r.LocalIp,
+		})
+	}
+	if err := d.Set("remote_server", servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving RemoteServers to state for syslog: %s", err)
+	}
+	return nil
+}
+
+func resourceBigipSysSyslogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Removing syslog remote servers")
+
+	err := patchEntity(client, &sysSyslog{RemoteServers: []syslogRemoteServer{}}, "sys", "syslog")
+	if err != nil {
+		log.Printf("[ERROR] Unable to remove syslog remote servers (%v)", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func setSysSyslogRemoteServers(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	s := &sysSyslog{RemoteServers: []syslogRemoteServer{}}
+	for _, r := range d.Get("remote_server").(*schema.Set).List() {
+		server := r.(map[string]interface{})
+		s.RemoteServers = append(s.RemoteServers, syslogRemoteServer{
+			Name:       server["name"].(string),
+			Host:       server["host"].(string),
+			RemotePort: server["remote_port"].(int),
+			LocalIp:    server["local_ip"].(string),
+		})
+	}
+	err := patchEntity(client, s, "sys", "syslog")
+	if err != nil {
+		return fmt.Errorf("Error configuring syslog remote servers: %s", err)
+	}
+	return nil
+}
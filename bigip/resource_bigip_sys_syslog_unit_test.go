@@ -0,0 +1,69 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysSyslog(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_syslog" "test-syslog" {
+			remote_server {
+				name = "/Common/remotesyslog1"
+				host = "10.10.10.20"
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysSyslogCreate(t *testing.T) {
+	servers := `[]`
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/syslog", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(r.Body)
+			if servers == `[]` {
+				assert.JSONEq(t, `{"remoteServers":[{"name":"/Common/remotesyslog1","host":"10.10.10.20","remotePort":514,"localIp":"none"}]}`, string(b))
+				servers = `[{"name":"/Common/remotesyslog1","host":"10.10.10.20","remotePort":514,"localIp":"none"}]`
+			} else {
+				assert.JSONEq(t, `{"remoteServers":[]}`, string(b))
+				servers = `[]`
+			}
+		}
+		fmt.Fprintf(w, `{"authPrivFrom":"notice","remoteServers":%s}`, servers)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysSyslog(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_syslog.test-syslog", "id", "syslog"),
+					resource.TestCheckResourceAttr("bigip_sys_syslog.test-syslog", "remote_server.#", "1"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, `[]`, servers, "Remote servers were not removed")
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriUcs = "ucs"
+
+// The file transfer endpoint UCS archives are downloaded from
+var uriUcsDownloads = []string{"shared", "file-transfer", "ucs-downloads"}
+
+// ucsTask saves a UCS archive asynchronously, saving can take longer than the timeout of a request
+type ucsTask struct {
+	Command   string              `json:"command,omitempty"`
+	Name      string              `json:"name,omitempty"`
+	Options   []map[string]string `json:"options,omitempty"`
+	TaskId    string              `json:"_taskId,omitempty"`
+	TaskState string              `json:"_taskState,omitempty"`
+}
+
+type ucsArchives struct {
+	Items []struct {
+		ApiRawValues struct {
+			Filename        string `json:"filename"`
+			FileSize        string `json:"file_size"`
+			FileCreatedDate string `json:"file_created_date"`
+			Encrypted       string `json:"encrypted"`
+			Version         string `json:"version"`
+		} `json:"apiRawValues"`
+	} `json:"items"`
+}
+
+type ucsArchive struct {
+	Name        string
+	Size        int
+	CreatedDate string
+	Encrypted   bool
+	Version     string
+}
+
+// bigip_sys_ucs saves a UCS archive of the configuration on the BIG-IP and optionally downloads it, e.g. as a
+// backup before a change. The id of the resource is the name of the archive.
+func resourceBigipSysUcs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysUcsCreate,
+		Read:   resourceBigipSysUcsRead,
+		Delete: resourceBigipSysUcsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the archive in /var/local/ucs, e.g. pre-change.ucs",
+				ValidateFunc: validateUcsName,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Passphrase the archive is encrypted with",
+			},
+			"no_private_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the private keys are left out of the archive",
+			},
+			"download_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local file the archive is downloaded to once it is saved",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the archive in bytes",
+			},
+			"created_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the archive was saved",
+			},
+			"encrypted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the archive is encrypted",
+			},
+		},
+	}
+}
+
+// validateUcsName validates the name of a UCS archive, which BIG-IP saves with a .ucs extension
+func validateUcsName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasSuffix(value, ".ucs") || strings.Contains(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a file name ending with .ucs, e.g. pre-change.ucs, got %q", k, value))
+	}
+	return
+}
+
+func resourceBigipSysUcsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Saving UCS archive " + name)
+
+	task := &ucsTask{Command: "save", Name: name}
+	if passphrase := d.Get("passphrase").(string); passphrase != "" {
+		task.Options = append(task.Options, map[string]string{"passphrase": passphrase})
+	}
+	if d.Get("no_private_keys").(bool) {
+		task.Options = append(task.Options, map[string]string{"no-private-key": ""})
+	}
+	reported := *task
+	if d.Get("passphrase").(string) != "" {
+		reported.Options = append([]map[string]string{{"passphrase": "********"}}, task.Options[1:]...)
+	}
+	if skip, err := reportDryRun(client, "POST", "/mgmt/tm/task/sys/"+uriUcs, reported); skip || err != nil {
+		d.SetId(name)
+		return err
+	}
+	if err := runUcsTask(client, task, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error saving UCS archive (%s): %s", name, err)
+	}
+	d.SetId(name)
+
+	if file := d.Get("download_path").(string); file != "" {
+		if err := downloadUcs(client, name, file); err != nil {
+			return err
+		}
+	}
+	return readAfterCreate(d, meta, resourceBigipSysUcsRead)
+}
+
+func resourceBigipSysUcsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	archives, err := getUcsArchives(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve UCS archive (%s) (%v)", name, err)
+		return err
+	}
+	for _, a := range archives {
+		if a.Name == name {
+			d.Set("name", name)
+			d.Set("size", a.Size)
+			d.Set("created_date", a.CreatedDate)
+			d.Set("encrypted", a.Encrypted)
+			return nil
+		}
+	}
+	log.Printf("[WARN] UCS archive (%s) not found, removing from state", name)
+	d.SetId("")
+	return nil
+}
+
+func resourceBigipSysUcsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting UCS archive " + name)
+
+	// The downloaded copy is kept, it is the backup
+	err := deleteEntity(client, "sys", uriUcs, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete UCS archive (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// runUcsTask starts a UCS task and waits for it to complete
+func runUcsTask(client *bigip.BigIP, task *ucsTask, timeout time.Duration) error {
+	var started ucsTask
+	if err := postForEntity(client, task, &started, "task", "sys", uriUcs); err != nil {
+		return err
+	}
+	if err := putEntity(client, &ucsTask{TaskState: "VALIDATING"}, "task", "sys", uriUcs, started.TaskId); err != nil {
+		return err
+	}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		var t ucsTask
+		if _, err := getForEntity(client, &t, "task", "sys", uriUcs, started.TaskId); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch t.TaskState {
+		case "COMPLETED":
+			return nil
+		case "FAILED":
+			return resource.NonRetryableError(fmt.Errorf("task %s failed", started.TaskId))
+		}
+		return resource.RetryableError(fmt.Errorf("task %s is %s", started.TaskId, t.TaskState))
+	})
+}
+
+// downloadUcs downloads a UCS archive to a local file, readable by its owner only as it holds the keys
+// and secrets of the BIG-IP
+func downloadUcs(client *bigip.BigIP, name, file string) error {
+	log.Printf("[INFO] Downloading UCS archive %s to %s", name, file)
+
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("Error creating %s to download UCS archive (%s): %s", file, name, err)
+	}
+	_, err = downloadFile(client, f, append(append([]string{}, uriUcsDownloads...), name)...)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("Error downloading UCS archive (%s) to %s: %s", name, file, err)
+	}
+	return nil
+}
+
+// getUcsArchives returns the UCS archives saved in /var/local/ucs
+func getUcsArchives(client *bigip.BigIP) ([]ucsArchive, error) {
+	var l ucsArchives
+	if _, err := getForEntity(client, &l, "sys", uriUcs); err != nil {
+		return nil, err
+	}
+	var archives []ucsArchive
+	for _, item := range l.Items {
+		v := item.ApiRawValues
+		// The size is reported as "<bytes> (in bytes)"
+		size, _ := strconv.Atoi(strings.Fields(v.FileSize + " 0")[0])
+		archives = append(archives, ucsArchive{
+			Name:        path.Base(v.Filename),
+			Size:        size,
+			CreatedDate: v.FileCreatedDate,
+			Encrypted:   v.Encrypted == "yes",
+			Version:     v.Version,
+		})
+	}
+	return archives, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var (
+	uriIcallScript   = []string{"sys", "icall", "script"}
+	uriIcallPeriodic = []string{"sys", "icall", "handler", "periodic"}
+)
+
+type icallScript struct {
+	Name       string `json:"name,omitempty"`
+	Definition string `json:"definition,omitempty"`
+}
+
+type icallPeriodicHandler struct {
+	Name            string `json:"name,omitempty"`
+	Script          string `json:"script,omitempty"`
+	Interval        int    `json:"interval,omitempty"`
+	FirstOccurrence string `json:"firstOccurrence,omitempty"`
+}
+
+// bigip_sys_ucs_schedule saves UCS archives periodically with an iCall script run by a periodic handler,
+// both named after the resource, keeping the latest ones
+func resourceBigipSysUcsSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysUcsScheduleCreate,
+		Update: resourceBigipSysUcsScheduleUpdate,
+		Read:   resourceBigipSysUcsScheduleRead,
+		Delete: resourceBigipSysUcsScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the iCall script and periodic handler of the schedule",
+				ValidateFunc: validateF5Name,
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Prefix of the archive names, the archives are named <prefix>-<YYYYmmdd-HHMMSS>.ucs",
+				ValidateFunc: validateUcsSchedulePrefix,
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				Description:  "Seconds between two archives",
+				ValidateFunc: validation.IntBetween(60, 31536000),
+			},
+			"first_occurrence": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Date the first archive is saved at, e.g. 2019-06-01:02:00:00, the schedule starts when it is created when not set",
+			},
+			"keep": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				Description:  "Number of archives kept, the older ones are deleted, 0 keeps all of them",
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"passphrase": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "Passphrase the archives are encrypted with",
+				ValidateFunc: validateUcsSchedulePassphrase,
+			},
+		},
+	}
+}
+
+func validateUcsSchedulePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[A-Za-z0-9_.-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain letters, digits, '_', '.' and '-', got %q", k, value))
+	}
+	return
+}
+
+// validateUcsSchedulePassphrase validates a passphrase can be quoted with braces in the Tcl of the iCall script
+func validateUcsSchedulePassphrase(v interface{}, k string) (ws []string, errors []error) {
+	if strings.ContainsAny(v.(string), "{}\\") {
+		errors = append(errors, fmt.Errorf("%q may not contain '{', '}' or '\\'", k))
+	}
+	return
+}
+
+func resourceBigipSysUcsScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating UCS schedule " + name)
+
+	s := &icallScript{Name: name, Definition: getUcsScheduleScript(d)}
+	if err := postEntity(client, s, uriIcallScript...); err != nil {
+		return fmt.Errorf("Error creating iCall script of UCS schedule (%s): %s", name, err)
+	}
+	h := getUcsScheduleHandlerConfig(d)
+	h.Name = name
+	h.Script = name
+	if err := postEntity(client, h, uriIcallPeriodic...); err != nil {
+		deleteEntity(client, append(append([]string{}, uriIcallScript...), name)...)
+		return fmt.Errorf("Error creating iCall handler of UCS schedule (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysUcsScheduleRead)
+}
+
+func resourceBigipSysUcsScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	if d.HasChange("prefix") || d.HasChange("keep") || d.HasChange("passphrase") {
+		s := &icallScript{Definition: getUcsScheduleScript(d)}
+		if err := patchEntity(client, s, append(append([]string{}, uriIcallScript...), name)...); err != nil {
+			return fmt.Errorf("Error modifying iCall script of UCS schedule (%s): %s", name, err)
+		}
+	}
+	err := patchEntity(client, getUcsScheduleHandlerConfig(d), append(append([]string{}, uriIcallPeriodic...), name)...)
+	if err != nil {
+		return fmt.Errorf("Error modifying iCall handler of UCS schedule (%s): %s", name, err)
+	}
+	return resourceBigipSysUcsScheduleRead(d, meta)
+}
+
+func resourceBigipSysUcsScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var h icallPeriodicHandler
+	ok, err := getForEntity(client, &h, append(append([]string{}, uriIcallPeriodic...), name)...)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve UCS schedule (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] UCS schedule (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	// The script holds the passphrase, it is not read back
+	d.Set("name", name)
+	d.Set("interval", h.Interval)
+	d.Set("first_occurrence", h.FirstOccurrence)
+	return nil
+}
+
+func resourceBigipSysUcsScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting UCS schedule " + name)
+
+	// The saved archives are kept
+	for _, uri := range [][]string{uriIcallPeriodic, uriIcallScript} {
+		err := deleteEntity(client, append(append([]string{}, uri...), name)...)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete UCS schedule (%s) (%v) ", name, err)
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func getUcsScheduleHandlerConfig(d *schema.ResourceData) *icallPeriodicHandler {
+	return &icallPeriodicHandler{
+		Interval:        d.Get("interval").(int),
+		FirstOccurrence: d.Get("first_occurrence").(string),
+	}
+}
+
+// getUcsScheduleScript returns the Tcl of the iCall script saving an archive and deleting the oldest ones
+func getUcsScheduleScript(d *schema.ResourceData) string {
+	prefix := d.Get("prefix").(string)
+	save := fmt.Sprintf("tmsh::save sys ucs %s-[clock format [clock seconds] -format {%%Y%%m%%d-%%H%%M%%S}].ucs", prefix)
+	if passphrase := d.Get("passphrase").(string); passphrase != "" {
+		save += " passphrase {" + passphrase + "}"
+	}
+	lines := []string{save}
+	if keep := d.Get("keep").(int); keep > 0 {
+		// The timestamps sort the archives from the oldest to the latest
+		lines = append(lines,
+			fmt.Sprintf("set archives [lsort [glob -nocomplain /var/local/ucs/%s-*.ucs]]", prefix),
+			fmt.Sprintf("foreach archive [lrange $archives 0 end-%d] { file delete $archive }", keep))
+	}
+	return strings.Join(lines, "\n")
+}
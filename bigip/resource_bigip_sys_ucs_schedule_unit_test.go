@@ -0,0 +1,98 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysUcsSchedule(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_ucs_schedule" "nightly" {
+			name = "/Common/nightly-ucs"
+			prefix = "nightly"
+			first_occurrence = "2019-06-01:02:00:00"
+			keep = 3
+			passphrase = "s3cret"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipSysUcsScheduleCreate(t *testing.T) {
+	var script icallScript
+	handler := ""
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/sys/icall/script", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		json.NewDecoder(r.Body).Decode(&script)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/icall/script/~Common~nightly-ucs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		script = icallScript{}
+	})
+	mux.HandleFunc("/mgmt/tm/sys/icall/handler/periodic", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		handler = string(b)
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/icall/handler/periodic/~Common~nightly-ucs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			handler = ""
+			return
+		}
+		if handler == "" {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprint(w, handler)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUcsSchedule(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_ucs_schedule.nightly", "id", "/Common/nightly-ucs"),
+					resource.TestCheckResourceAttr("bigip_sys_ucs_schedule.nightly", "interval", "86400"),
+					resource.TestCheckResourceAttr("bigip_sys_ucs_schedule.nightly", "first_occurrence", "2019-06-01:02:00:00"),
+					func(s *terraform.State) error {
+						assert.Equal(t, "/Common/nightly-ucs", script.Name)
+						assert.Equal(t, "tmsh::save sys ucs nightly-[clock format [clock seconds] -format {%Y%m%d-%H%M%S}].ucs passphrase {s3cret}\n"+
+							"set archives [lsort [glob -nocomplain /var/local/ucs/nightly-*.ucs]]\n"+
+							"foreach archive [lrange $archives 0 end-3] { file delete $archive }", script.Definition)
+						assert.JSONEq(t, `{"name":"/Common/nightly-ucs","script":"/Common/nightly-ucs","interval":86400,
+							"firstOccurrence":"2019-06-01:02:00:00"}`, handler)
+						return nil
+					},
+				),
+			},
+		},
+	})
+	assert.Empty(t, handler, "iCall handler was not deleted")
+	assert.Empty(t, script.Name, "iCall script was not deleted")
+}
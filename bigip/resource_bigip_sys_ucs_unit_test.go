@@ -0,0 +1,109 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysUcs(url, file string) string {
+	return fmt.Sprintf(`
+		resource "bigip_sys_ucs" "test-ucs" {
+			name = "pre-change.ucs"
+			passphrase = "s3cret"
+			download_path = "%s"
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, file, url)
+}
+
+func TestAccBigipSysUcsCreate(t *testing.T) {
+	const archive = "UCS archive content"
+	var task string
+	saved := false
+	dir, err := ioutil.TempDir("", "ucs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "pre-change.ucs")
+
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/task/sys/ucs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		task = string(b)
+		fmt.Fprintf(w, `{"_taskId":"1234","_taskState":"CREATED"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/task/sys/ucs/1234", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			b, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"_taskState":"VALIDATING"}`, string(b))
+			saved = true
+		}
+		fmt.Fprintf(w, `{"_taskId":"1234","_taskState":"COMPLETED"}`)
+	})
+	mux.HandleFunc("/mgmt/shared/file-transfer/ucs-downloads/pre-change.ucs", func(w http.ResponseWriter, r *http.Request) {
+		// Answer with chunks of 8 bytes
+		var start, end, size int
+		fmt.Sscanf(r.Header.Get("Content-Range"), "%d-%d/%d", &start, &end, &size)
+		end = start + 7
+		if end >= len(archive) {
+			end = len(archive) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("%d-%d/%d", start, end, len(archive)))
+		fmt.Fprint(w, archive[start:end+1])
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ucs", func(w http.ResponseWriter, r *http.Request) {
+		if !saved {
+			fmt.Fprintf(w, `{"items":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"items":[{"apiRawValues":{"filename":"/var/local/ucs/pre-change.ucs","file_size":"19 (in bytes)",
+			"file_created_date":"2019-06-01T02:00:00Z","encrypted":"yes","version":"14.1.0"}}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/ucs/pre-change.ucs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		saved = false
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUcs(server.URL, file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_ucs.test-ucs", "id", "pre-change.ucs"),
+					resource.TestCheckResourceAttr("bigip_sys_ucs.test-ucs", "size", "19"),
+					resource.TestCheckResourceAttr("bigip_sys_ucs.test-ucs", "encrypted", "true"),
+					resource.TestCheckResourceAttr("bigip_sys_ucs.test-ucs", "created_date", "2019-06-01T02:00:00Z"),
+				),
+			},
+		},
+	})
+	assert.JSONEq(t, `{"command":"save","name":"pre-change.ucs","options":[{"passphrase":"s3cret"}]}`, task)
+	assert.False(t, saved, "UCS archive was not deleted")
+	b, err := ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, archive, string(b), "The downloaded copy is kept")
+}
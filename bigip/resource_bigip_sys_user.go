@@ -0,0 +1,193 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const uriAuthUser = "user"
+
+var userRoles = []string{
+	"admin", "resource-admin", "user-manager", "auditor", "manager", "application-editor", "operator",
+	"certificate-manager", "irule-manager", "guest", "web-application-security-administrator",
+	"web-application-security-editor", "acceleration-policy-editor", "fraud-protection-manager",
+	"firewall-manager", "no-access",
+}
+
+type authUser struct {
+	Name            string                `json:"name,omitempty"`
+	Description     string                `json:"description"` // sent empty to clear a removed description
+	Password        string                `json:"password,omitempty"`
+	Shell           string                `json:"shell,omitempty"`
+	PartitionAccess []userPartitionAccess `json:"partitionAccess,omitempty"`
+}
+
+type userPartitionAccess struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+func resourceBigipSysUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysUserCreate,
+		Update: resourceBigipSysUserUpdate,
+		Read:   resourceBigipSysUserRead,
+		Delete: resourceBigipSysUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the local user account",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full name or description of the user",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user. It is only written, changes made on the BIG-IP are not detected",
+			},
+			"shell": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				Description:  "Shell of the user, bash, tmsh or none to only allow access to the GUI and REST API",
+				ValidateFunc: validateStringValue([]string{"bash", "tmsh", "none"}),
+			},
+			"partition_access": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Roles of the user, per partition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the partition, e.g. Common, or all-partitions",
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Role of the user in the partition, e.g. admin, operator or guest",
+							ValidateFunc: validateStringValue(userRoles),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSysUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating user " + name)
+
+	u := getSysUserConfig(d)
+	u.Name = name
+	u.Password = d.Get("password").(string)
+	err := postEntity(client, u, "auth", uriAuthUser)
+	if err != nil {
+		return fmt.Errorf("Error creating user (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return readAfterCreate(d, meta, resourceBigipSysUserRead)
+}
+
+func resourceBigipSysUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	u := getSysUserConfig(d)
+	if d.HasChange("password") {
+		log.Println("[INFO] Changing the password of user " + name)
+		u.Password = d.Get("password").(string)
+	}
+	err := patchEntity(client, u, "auth", uriAuthUser, name)
+	if err != nil {
+		return fmt.Errorf("Error modifying user (%s): %s", name, err)
+	}
+	return resourceBigipSysUserRead(d, meta)
+}
+
+func resourceBigipSysUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	var u authUser
+	ok, err := getForEntity(client, &u, "auth", uriAuthUser, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve user (%s) (%v)", name, err)
+		return err
+	}
+	if !ok {
+		log.Printf("[WARN] User (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("description", u.Description)
+	d.Set("shell", u.Shell)
+
+	var access []interface{}
+	for _, a := range u.PartitionAccess {
+		access = append(access, map[string]interface{}{
+			"partition": a.Name,
+			"role":      a.Role,
+		})
+	}
+	if err := d.Set("partition_access", access); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving PartitionAccess to state for user (%s): %s", name, err)
+	}
+	// The password is only returned encrypted, the one of the configuration is kept
+	return nil
+}
+
+func resourceBigipSysUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting user " + name)
+
+	err := deleteEntity(client, "auth", uriAuthUser, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete user (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysUserConfig(d *schema.ResourceData) *authUser {
+	u := &authUser{
+		Description: d.Get("description").(string),
+		Shell:       d.Get("shell").(string),
+	}
+	for _, a := range d.Get("partition_access").(*schema.Set).List() {
+		access := a.(map[string]interface{})
+		u.PartitionAccess = append(u.PartitionAccess, userPartitionAccess{
+			Name: access["partition"].(string),
+			Role: access["role"].(string),
+		})
+	}
+	return u
+}
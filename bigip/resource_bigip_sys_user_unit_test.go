@@ -0,0 +1,140 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipSysUser(url, description, password string) string {
+	if description != "" {
+		description = fmt.Sprintf("description = %q", description)
+	}
+	return fmt.Sprintf(`
+		resource "bigip_sys_user" "test-user" {
+			name = "test-operator"
+			%s
+			password = "%s"
+			shell = "tmsh"
+			partition_access {
+				partition = "all-partitions"
+				role = "operator"
+			}
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, description, password, url)
+}
+
+func TestAccBigipSysUserCreate(t *testing.T) {
+	var bodies []string
+	created := false
+	description := ""
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/auth/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		created = true
+		description = "Operator"
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/auth/user/test-operator", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			created = false
+			return
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			var u map[string]interface{}
+			json.Unmarshal(b, &u)
+			if v, ok := u["description"]; ok {
+				description = v.(string)
+			}
+		}
+		if !created {
+			w.WriteHeader(404)
+			fmt.Fprintf(w, `{"code":404,"message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"name":"test-operator","description":"%s","encryptedPassword":"$6$xxxx","shell":"tmsh",
+			"partitionAccess":[{"name":"all-partitions","role":"operator"}]}`, description)
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUser(server.URL, "Operator", "s3cret-pass"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_user.test-user", "id", "test-operator"),
+					resource.TestCheckResourceAttr("bigip_sys_user.test-user", "shell", "tmsh"),
+					resource.TestCheckResourceAttr("bigip_sys_user.test-user", "partition_access.#", "1"),
+				),
+			},
+			{
+				Config: testBigipSysUser(server.URL, "Night operator", "s3cret-pass"),
+			},
+			{
+				Config: testBigipSysUser(server.URL, "", "s3cret-pass"),
+				Check:  resource.TestCheckResourceAttr("bigip_sys_user.test-user", "description", ""),
+			},
+		},
+	})
+	assert.False(t, created, "User was not deleted")
+	if assert.Len(t, bodies, 3) {
+		assert.JSONEq(t, `{"name":"test-operator","description":"Operator","password":"s3cret-pass","shell":"tmsh",
+			"partitionAccess":[{"name":"all-partitions","role":"operator"}]}`, bodies[0])
+		assert.JSONEq(t, `{"description":"Night operator","shell":"tmsh","partitionAccess":[{"name":"all-partitions","role":"operator"}]}`, bodies[1],
+			"An unchanged password is not sent again")
+		assert.JSONEq(t, `{"description":"","shell":"tmsh","partitionAccess":[{"name":"all-partitions","role":"operator"}]}`, bodies[2],
+			"A removed description is cleared on the device")
+	}
+}
+
+func TestAccBigipSysUserInvalidRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bigip_sys_user" "test-user" {
+						name = "test-operator"
+						password = "s3cret-pass"
+						partition_access {
+							partition = "Common"
+							role = "superuser"
+						}
+					}
+					provider "bigip" {
+						address = "xxx.xxx.xxx.xxx"
+						username = "xxxxx"
+						password = "xxxxx"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`"partition_access.0.role" must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The Telemetry Streaming endpoint, declarations are applied synchronously
+var uriTs = []string{"mgmt", "shared", "telemetry", "declare"}
+
+// tsResponse is the response of TS to a declaration, the declaration applied or the errors it found
+type tsResponse struct {
+	Message     string                 `json:"message"`
+	Errors      []interface{}          `json:"errors"`
+	Declaration map[string]interface{} `json:"declaration"`
+}
+
+// bigip_telemetry_streaming applies a Telemetry Streaming declaration, e.g. the system pollers, event listeners
+// and consumers streaming the statistics and logs of the device. TS holds one declaration per device, the id of
+// the resource is fixed, and destroying it applies an empty declaration.
+func resourceBigipTelemetryStreaming() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipTelemetryStreamingCreate,
+		Read:   resourceBigipTelemetryStreamingRead,
+		Update: resourceBigipTelemetryStreamingUpdate,
+		Delete: resourceBigipTelemetryStreamingDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ts_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "TS declaration, as JSON",
+				ValidateFunc:     validateTsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"consumers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Consumers of the declaration applied by TS",
+			},
+		},
+	}
+}
+
+func resourceBigipTelemetryStreamingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Applying TS declaration")
+	if err := applyTsDeclaration(client, d.Get("ts_json").(string)); err != nil {
+		return err
+	}
+	d.SetId("telemetry_streaming")
+	if _, ok := dryRunClients.Load(client); ok {
+		return nil
+	}
+	return resourceBigipTelemetryStreamingRead(d, meta)
+}
+
+func resourceBigipTelemetryStreamingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading TS declaration")
+	var res tsResponse
+	ok, err := getForEntity(client, &res, uriTs...)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve TS declaration (%v)", err)
+		return err
+	}
+	consumers := tsComponents(res.Declaration, "Telemetry_Consumer")
+	var declared map[string]interface{}
+	json.Unmarshal([]byte(d.Get("ts_json").(string)), &declared)
+	if !ok || len(tsComponents(declared, "")) > 0 && len(tsComponents(res.Declaration, "")) == 0 {
+		log.Printf("[WARN] TS declaration not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+	d.Set("consumers", consumers)
+	return nil
+}
+
+func resourceBigipTelemetryStreamingUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Applying TS declaration again")
+	if err := applyTsDeclaration(client, d.Get("ts_json").(string)); err != nil {
+		return err
+	}
+	return resourceBigipTelemetryStreamingRead(d, meta)
+}
+
+func resourceBigipTelemetryStreamingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Applying an empty TS declaration")
+	if err := applyTsDeclaration(client, `{"class":"Telemetry"}`); err != nil {
+		log.Printf("[ERROR] Unable to Delete TS declaration (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// applyTsDeclaration posts a TS declaration, and verifies that TS applied each of its consumers
+func applyTsDeclaration(client *bigip.BigIP, tsJSON string) error {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(tsJSON), &declaration); err != nil {
+		return fmt.Errorf("ts_json is not valid JSON: %s", err)
+	}
+	if skip, err := reportDryRun(client, "POST", "/"+strings.Join(uriTs, "/"), declaration); skip || err != nil {
+		return err
+	}
+	body, err := json.Marshal(declaration)
+	if err != nil {
+		return err
+	}
+	resp, err := client.APICall(&bigip.APIRequest{
+		Method:      "post",
+		URL:         iControlPath(uriTs),
+		Body:        string(body),
+		ContentType: "application/json",
+	})
+	var res tsResponse
+	json.Unmarshal(resp, &res)
+	if err != nil {
+		if len(res.Errors) > 0 {
+			var errors []string
+			for _, e := range res.Errors {
+				b, _ := json.Marshal(e)
+				errors = append(errors, strings.Trim(string(b), `"`))
+			}
+			return fmt.Errorf("Error applying TS declaration: %s: %s", err, strings.Join(errors, ", "))
+		}
+		return fmt.Errorf("Error applying TS declaration: %s", err)
+	}
+	applied := map[string]bool{}
+	for _, c := range tsComponents(res.Declaration, "Telemetry_Consumer") {
+		applied[c] = true
+	}
+	var missing []string
+	for _, c := range tsComponents(declaration, "Telemetry_Consumer") {
+		if !applied[c] {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("TS did not apply the consumers %s of the declaration: %s", strings.Join(missing, ", "), res.Message)
+	}
+	return nil
+}
+
+// tsComponents returns the names of the components of a TS declaration of a class, or of any class when class is
+// empty, sorted. Components are the objects with a class, either at the top of the declaration or in a namespace.
+func tsComponents(declaration map[string]interface{}, class string) []string {
+	names := []string{}
+	for name, v := range declaration {
+		component, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if component["class"] == "Telemetry_Namespace" {
+			for _, c := range tsComponents(component, class) {
+				names = append(names, name+"/"+c)
+			}
+			continue
+		}
+		if c, ok := component["class"].(string); ok && (class == "" || c == class) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
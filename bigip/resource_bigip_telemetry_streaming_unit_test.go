@@ -0,0 +1,142 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBigipTelemetryStreaming(url, consumerType string) string {
+	return fmt.Sprintf(`
+		resource "bigip_telemetry_streaming" "ts" {
+			ts_json = <<EOF
+{
+	"class": "Telemetry",
+	"My_Poller": {"class": "Telemetry_System", "systemPoller": {"interval": 60}},
+	"My_Listener": {"class": "Telemetry_Listener", "port": 6514},
+	"My_Consumer": {"class": "Telemetry_Consumer", "type": "%s", "host": "10.1.1.10", "protocol": "https", "port": 443}
+}
+EOF
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, consumerType, url)
+}
+
+// tsHandler answers the TS endpoint with the declaration applied last. TS rejects the consumers of an unknown type,
+// and applies the declarations without their consumers of the dropped type.
+func tsHandler(t *testing.T, declaration *map[string]interface{}, dropped string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			b, _ := json.Marshal(map[string]interface{}{"message": "success", "declaration": *declaration})
+			w.Write(b)
+			return
+		}
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		var d map[string]interface{}
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &d)
+		for name, v := range d {
+			c, ok := v.(map[string]interface{})
+			if !ok || c["class"] != "Telemetry_Consumer" {
+				continue
+			}
+			switch c["type"] {
+			case "Bogus":
+				w.WriteHeader(422)
+				fmt.Fprintf(w, `{"code":422,"message":"Unprocessable entity","errors":["/%s/type: should be equal to one of the allowed values"]}`, name)
+				return
+			case dropped:
+				delete(d, name)
+			}
+		}
+		*declaration = d
+		b, _ = json.Marshal(map[string]interface{}{"message": "success", "declaration": d})
+		w.Write(b)
+	}
+}
+
+func TestAccBigipTelemetryStreaming(t *testing.T) {
+	declaration := map[string]interface{}{"class": "Telemetry"}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/telemetry/declare", tsHandler(t, &declaration, ""))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(tsComponents(declaration, "")) > 0 {
+				return fmt.Errorf("TS declaration %v was not emptied", declaration)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipTelemetryStreaming(server.URL, "Splunk"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_telemetry_streaming.ts", "id", "telemetry_streaming"),
+					resource.TestCheckResourceAttr("bigip_telemetry_streaming.ts", "consumers.#", "1"),
+					resource.TestCheckResourceAttr("bigip_telemetry_streaming.ts", "consumers.0", "My_Consumer"),
+				),
+			},
+			{
+				// The declaration was reset on the device, it is applied again
+				PreConfig: func() { declaration = map[string]interface{}{"class": "Telemetry"} },
+				Config:    testBigipTelemetryStreaming(server.URL, "Splunk"),
+				Check: func(s *terraform.State) error {
+					if len(tsComponents(declaration, "")) != 3 {
+						return fmt.Errorf("TS declaration %v was not applied again", declaration)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccBigipTelemetryStreamingFailure(t *testing.T) {
+	declaration := map[string]interface{}{"class": "Telemetry"}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/shared/telemetry/declare", tsHandler(t, &declaration, "Kafka"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipTelemetryStreaming(server.URL, "Bogus"),
+				ExpectError: regexp.MustCompile("Error applying TS declaration: .*: /My_Consumer/type: should be equal to one of the allowed values"),
+			},
+			{
+				Config:      testBigipTelemetryStreaming(server.URL, "Kafka"),
+				ExpectError: regexp.MustCompile("TS did not apply the consumers My_Consumer of the declaration: success"),
+			},
+		},
+	})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigip_tracked_objects_purge deletes the objects of some partitions tagged with a tracking id by track_renames
+// that is not one of the resources of the state. Creating the resource, or changing its arguments, purges them;
+// destroying it deletes nothing.
+func resourceBigipTrackedObjectsPurge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipTrackedObjectsPurgeCreate,
+		Read:   resourceBigipTrackedObjectsPurgeRead,
+		Delete: resourceBigipTrackedObjectsPurgeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"partitions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Partitions the orphans are deleted in",
+			},
+			"known_tracking_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Tracking ids of the resources of the state, the objects tagged with another one are deleted",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that purge the orphans again when changed",
+			},
+			"purged": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Objects deleted, as <type>:<full path>",
+			},
+		},
+	}
+}
+
+func resourceBigipTrackedObjectsPurgeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	partitions := setToSortedStringSlice(d.Get("partitions").(*schema.Set))
+	tagged, err := listTaggedObjects(client, partitions)
+	if err != nil {
+		return err
+	}
+	known := trackingIDSet(d.Get("known_tracking_ids").(*schema.Set))
+
+	purged := []string{}
+	for _, o := range tagged {
+		if known[o.TrackingID] {
+			continue
+		}
+		objectType := strings.Join(o.Collection, "/")
+		log.Printf("[INFO] Deleting %s %s, its tracking id %s is not known", objectType, o.FullPath, o.TrackingID)
+		if err := deleteEntity(client, trackedObjectPath(o.Collection, o.FullPath)...); err != nil {
+			return fmt.Errorf("Error deleting orphan %s (%s), after deleting %v: %s", objectType, o.FullPath, purged, err)
+		}
+		purged = append(purged, objectType+":"+o.FullPath)
+	}
+	d.SetId(fmt.Sprintf("purge-%d", time.Now().Unix()))
+	d.Set("purged", purged)
+	return nil
+}
+
+// resourceBigipTrackedObjectsPurgeRead keeps the objects deleted, the purge has nothing to read back
+func resourceBigipTrackedObjectsPurgeRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceBigipTrackedObjectsPurgeDelete(d *schema.ResourceData, meta interface{}) error {
+	// Nothing to undo, the orphans stay deleted
+	d.SetId("")
+	return nil
+}
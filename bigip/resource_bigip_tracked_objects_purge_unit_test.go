@@ -0,0 +1,102 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// trackedObjectsHandler answers the tracked collections with a virtual server and a pool of the state, and an
+// orphaned virtual server, pool and node, in Tenant, and an object of another configuration in Common
+func trackedObjectsHandler(t *testing.T, deleted *[]string) http.HandlerFunc {
+	collections := map[string]string{
+		"/mgmt/tm/ltm/virtual": `{"items":[
+			{"fullPath":"/Tenant/web_vs","metadata":[{"name":"terraform_id","value":"id-vs"}]},
+			{"fullPath":"/Tenant/old_vs","metadata":[{"name":"owner","value":"ops"},{"name":"terraform_id","value":"id-old-vs"}]},
+			{"fullPath":"/Tenant/manual_vs"}
+		]}`,
+		"/mgmt/tm/ltm/pool": `{"items":[
+			{"fullPath":"/Tenant/web_pool","metadata":[{"name":"terraform_id","value":"id-pool"}]},
+			{"fullPath":"/Tenant/old_pool","metadata":[{"name":"terraform_id","value":"id-old-pool"}]},
+			{"fullPath":"/Common/shared_pool","metadata":[{"name":"terraform_id","value":"id-other"}]}
+		]}`,
+		"/mgmt/tm/ltm/node": `{"items":[
+			{"fullPath":"/Tenant/10.1.1.1","metadata":[{"name":"terraform_id","value":"id-old-node"}]}
+		]}`,
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			*deleted = append(*deleted, r.URL.Path)
+			return
+		}
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		items, ok := collections[r.URL.Path]
+		if !ok || r.URL.Query().Get("$skip") != "0" {
+			items = `{"items":[]}`
+		}
+		if !ok {
+			w.WriteHeader(404)
+			items = `{"code":404,"message":"not found"}`
+		}
+		fmt.Fprint(w, items)
+	}
+}
+
+func TestAccBigipTrackedObjectsPurge(t *testing.T) {
+	var deleted []string
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", trackedObjectsHandler(t, &deleted))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bigip_tracked_objects" "tenant" {
+						partitions = ["Tenant"]
+						known_tracking_ids = ["id-vs", "id-pool"]
+					}
+					resource "bigip_tracked_objects_purge" "tenant" {
+						partitions = ["Tenant"]
+						known_tracking_ids = ["id-vs", "id-pool"]
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "objects.#", "5"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "objects.0.name", "/Tenant/web_vs"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "objects.0.orphaned", "false"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "objects.1.tracking_id", "id-old-vs"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "objects.1.orphaned", "true"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "orphans.#", "3"),
+					resource.TestCheckResourceAttr("data.bigip_tracked_objects.tenant", "orphans.0", "ltm/virtual:/Tenant/old_vs"),
+					resource.TestCheckResourceAttr("bigip_tracked_objects_purge.tenant", "purged.#", "3"),
+					resource.TestCheckResourceAttr("bigip_tracked_objects_purge.tenant", "purged.2", "ltm/node:/Tenant/10.1.1.1"),
+				),
+			},
+		},
+	})
+	assert.Equal(t, []string{
+		"/mgmt/tm/ltm/virtual/~Tenant~old_vs",
+		"/mgmt/tm/ltm/pool/~Tenant~old_pool",
+		"/mgmt/tm/ltm/node/~Tenant~10.1.1.1",
+	}, deleted, "the orphans are deleted, virtual servers first")
+}
@@ -0,0 +1,387 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const uriAsm = "asm"
+
+type wafPolicy struct {
+	ID              string `json:"id,omitempty"`
+	FullPath        string `json:"fullPath,omitempty"`
+	VersionDatetime string `json:"versionDatetime,omitempty"`
+	EnforcementMode string `json:"enforcementMode,omitempty"`
+}
+
+type wafPolicyReference struct {
+	FullPath string `json:"fullPath,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+type wafTask struct {
+	Filename        string              `json:"filename,omitempty"`
+	File            string              `json:"file,omitempty"`
+	Policy          *wafPolicyReference `json:"policy,omitempty"`
+	PolicyReference *wafPolicyReference `json:"policyReference,omitempty"`
+}
+
+type wafTaskStatus struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Result  struct {
+		Message string `json:"message"`
+	} `json:"result"`
+}
+
+func resourceBigipWafPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipWafPolicyCreate,
+		Update: resourceBigipWafPolicyUpdate,
+		Read:   resourceBigipWafPolicyRead,
+		Delete: resourceBigipWafPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceBigipWafPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Full path of the WAF policy",
+				ValidateFunc: validateF5Name,
+			},
+			"policy_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Declarative WAF policy as JSON",
+				ConflictsWith:    []string{"policy_url"},
+				ValidateFunc:     validateWafPolicyJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"policy_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "URL the declarative WAF policy is downloaded from",
+				ConflictsWith: []string{"policy_json"},
+			},
+			"enforcement_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Whether violations are blocked or only logged, overriding the one of the declarative policy",
+				ValidateFunc: validateStringValue([]string{"transparent", "blocking"}),
+			},
+			"min_transparent_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Hours the policy has to be transparent before enforcement_mode can be changed to blocking",
+				ValidateFunc: validation.IntBetween(0, 8760),
+			},
+			"transparent_since": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the policy was first seen transparent, as RFC 3339",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the policy on the BIG-IP",
+			},
+			"policy_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the declarative policy applied, cleared when the policy is changed on the BIG-IP",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version date of the policy on the BIG-IP when it was applied",
+			},
+		},
+	}
+}
+
+func resourceBigipWafPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating WAF policy " + name)
+
+	err := importWafPolicy(d, client, &wafPolicyReference{FullPath: name}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating WAF policy (%s): %s", name, err)
+	}
+	d.SetId(name)
+	return resourceBigipWafPolicyRead(d, meta)
+}
+
+func resourceBigipWafPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating WAF policy " + name)
+
+	var err error
+	if d.HasChange("policy_json") || d.HasChange("policy_url") || d.HasChange("policy_hash") {
+		link := "https://localhost/mgmt/tm/asm/policies/" + d.Get("policy_id").(string)
+		err = importWafPolicy(d, client, &wafPolicyReference{Link: link}, d.Timeout(schema.TimeoutUpdate))
+	} else if d.HasChange("enforcement_mode") {
+		err = setWafEnforcementMode(d, client, d.Get("policy_id").(string), d.Timeout(schema.TimeoutUpdate))
+	}
+	if err != nil {
+		return fmt.Errorf("Error modifying WAF policy (%s): %s", name, err)
+	}
+	return resourceBigipWafPolicyRead(d, meta)
+}
+
+func resourceBigipWafPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+
+	p, err := getWafPolicy(client, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve WAF policy (%s) (%v)", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] WAF policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", name)
+	d.Set("policy_id", p.ID)
+	if _, ok := d.GetOk("enforcement_mode"); ok {
+		d.Set("enforcement_mode", p.EnforcementMode)
+	}
+	switch {
+	case p.EnforcementMode != "transparent":
+		d.Set("transparent_since", "")
+	case d.Get("transparent_since").(string) == "":
+		d.Set("transparent_since", time.Now().UTC().Format(time.RFC3339))
+	}
+	if v := d.Get("version").(string); v != "" && v != p.VersionDatetime {
+		// Changed since it was applied, the next plan imports the policy again
+		log.Printf("[WARN] WAF policy (%s) was modified on the BIG-IP at %s", name, p.VersionDatetime)
+		d.Set("policy_hash", "")
+	}
+	return nil
+}
+
+func resourceBigipWafPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting WAF policy " + name)
+
+	err := deleteEntity(client, uriAsm, "policies", d.Get("policy_id").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipWafPolicyCustomizeDiff plans an update when the declarative policy differs from the one applied,
+// including when the content at policy_url changed or the policy was modified on the BIG-IP
+func resourceBigipWafPolicyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if err := checkWafEnforcementModeChange(d); err != nil {
+		return err
+	}
+	policy, err := wafPolicySource(d.Get("policy_json").(string), d.Get("policy_url").(string))
+	if err != nil {
+		return err
+	}
+	if hash := wafPolicyHash(policy); hash != d.Get("policy_hash").(string) {
+		return d.SetNew("policy_hash", hash)
+	}
+	return nil
+}
+
+// importWafPolicy imports the declarative policy into the policy target refers to and applies it
+func importWafPolicy(d *schema.ResourceData, client *bigip.BigIP, target *wafPolicyReference, timeout time.Duration) error {
+	name := d.Get("name").(string)
+	policy, err := wafPolicySource(d.Get("policy_json").(string), d.Get("policy_url").(string))
+	if err != nil {
+		return err
+	}
+
+	var task wafTaskStatus
+	err = postForEntity(client, &wafTask{Filename: strings.Replace(strings.TrimPrefix(name, "/"), "/", "_", -1) + ".json", File: policy, Policy: target},
+		&task, uriAsm, "tasks", "import-policy")
+	if err != nil {
+		return err
+	}
+	if err := waitForWafTask(client, "import-policy", task.ID, timeout); err != nil {
+		return err
+	}
+
+	p, err := getWafPolicy(client, name)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("policy not found after import")
+	}
+	if err := setWafEnforcementMode(d, client, p.ID, timeout); err != nil {
+		return err
+	}
+	d.Set("policy_hash", wafPolicyHash(policy))
+	return nil
+}
+
+// setWafEnforcementMode sets the enforcement mode of the policy with the given id, if one is configured, and applies
+// the policy
+func setWafEnforcementMode(d *schema.ResourceData, client *bigip.BigIP, id string, timeout time.Duration) error {
+	name := d.Get("name").(string)
+	if mode, ok := d.GetOk("enforcement_mode"); ok {
+		if d.HasChange("enforcement_mode") {
+			log.Printf("[WARN] Changing enforcement mode of WAF policy %s to %s", name, mode)
+		}
+		if err := patchEntity(client, &wafPolicy{EnforcementMode: mode.(string)}, uriAsm, "policies", id); err != nil {
+			return err
+		}
+	}
+
+	var task wafTaskStatus
+	link := "https://localhost/mgmt/tm/asm/policies/" + id
+	err := postForEntity(client, &wafTask{PolicyReference: &wafPolicyReference{Link: link}}, &task, uriAsm, "tasks", "apply-policy")
+	if err != nil {
+		return err
+	}
+	if err := waitForWafTask(client, "apply-policy", task.ID, timeout); err != nil {
+		return err
+	}
+
+	// Applying changes the version date, so it is read once the policy is applied
+	p, err := getWafPolicy(client, name)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("policy not found after apply")
+	}
+	d.Set("version", p.VersionDatetime)
+	return nil
+}
+
+// checkWafEnforcementModeChange returns an error when enforcement_mode changes a transparent policy to blocking
+// before it was transparent for min_transparent_hours
+func checkWafEnforcementModeChange(d *schema.ResourceDiff) error {
+	o, n := d.GetChange("enforcement_mode")
+	minHours := d.Get("min_transparent_hours").(int)
+	transparentSince := d.Get("transparent_since").(string)
+	if o.(string) == "blocking" || n.(string) != "blocking" || minHours == 0 || transparentSince == "" {
+		return nil
+	}
+	since, err := time.Parse(time.RFC3339, transparentSince)
+	if err != nil {
+		return err
+	}
+	if ready := since.Add(time.Duration(minHours) * time.Hour); time.Now().Before(ready) {
+		return fmt.Errorf("enforcement_mode of WAF policy %s cannot be changed to blocking before %s, %d hours after it was made transparent",
+			d.Id(), ready.Format(time.RFC3339), minHours)
+	}
+	return nil
+}
+
+// waitForWafTask waits for an ASM task to complete
+func waitForWafTask(client *bigip.BigIP, taskType, id string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		var task wafTaskStatus
+		_, err := getForEntity(client, &task, uriAsm, "tasks", taskType, id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch task.Status {
+		case "COMPLETED":
+			return nil
+		case "FAILURE":
+			message := task.Result.Message
+			if message == "" {
+				message = task.Message
+			}
+			return resource.NonRetryableError(fmt.Errorf("%s task %s failed: %s", taskType, id, message))
+		}
+		return resource.RetryableError(fmt.Errorf("%s task %s is %s", taskType, id, task.Status))
+	})
+}
+
+// getWafPolicy returns the ASM policy with the given full path, or nil if there is none. ASM policies are
+// addressed by id, so the policies are listed to find it.
+func getWafPolicy(client *bigip.BigIP, fullPath string) (*wafPolicy, error) {
+	var policies []wafPolicy
+	_, err := getCollection(client, &policies, selectQuery("id", "fullPath", "versionDatetime", "enforcementMode"), uriAsm, "policies")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if p.FullPath == fullPath {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
+// wafPolicySource returns the declarative policy, compacted, from the configuration or downloaded from its URL
+func wafPolicySource(policyJSON, policyURL string) (string, error) {
+	if policyURL != "" {
+		resp, err := http.Get(policyURL)
+		if err != nil {
+			return "", fmt.Errorf("Error downloading WAF policy from %s: %s", policyURL, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("Error downloading WAF policy from %s: %s", policyURL, resp.Status)
+		}
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("Error downloading WAF policy from %s: %s", policyURL, err)
+		}
+		policyJSON = string(b)
+	}
+	if policyJSON == "" {
+		return "", fmt.Errorf("one of policy_json or policy_url is required")
+	}
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, []byte(policyJSON)); err != nil {
+		return "", fmt.Errorf("WAF policy is not valid JSON: %s", err)
+	}
+	return buffer.String(), nil
+}
+
+func wafPolicyHash(policy string) string {
+	sum := sha256.Sum256([]byte(policy))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testWafPolicyJSON = `{"policy":{"name":"test-waf","template":{"name":"POLICY_TEMPLATE_RAPID_DEPLOYMENT"},"enforcementMode":"blocking"}}`
+
+func testBigipWafPolicy(url string) string {
+	return fmt.Sprintf(`
+		resource "bigip_waf_policy" "test-waf" {
+			name = "/Common/test-waf"
+			policy_json = <<POLICY
+{
+  "policy": {
+    "name": "test-waf",
+    "template": {"name": "POLICY_TEMPLATE_RAPID_DEPLOYMENT"},
+    "enforcementMode": "blocking"
+  }
+}
+POLICY
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, url)
+}
+
+func TestAccBigipWafPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+	imported := false
+	version := "2019-11-01T10:00:00Z"
+	var importTask map[string]interface{}
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/asm/tasks/import-policy", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &importTask)
+		imported = true
+		fmt.Fprintf(w, `{"id":"import1","status":"NEW"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/import-policy/import1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"import1","status":"COMPLETED"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/apply-policy", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"policyReference":{"link":"https://localhost/mgmt/tm/asm/policies/P1"}}`, string(b))
+		fmt.Fprintf(w, `{"id":"apply1","status":"NEW"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/apply-policy/apply1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"apply1","status":"COMPLETED"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/policies", func(w http.ResponseWriter, r *http.Request) {
+		if !imported {
+			fmt.Fprintf(w, `{"items":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"items":[{"id":"P1","fullPath":"/Common/test-waf","versionDatetime":"%s"}]}`, version)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/policies/P1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		imported = false
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipWafPolicy(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_waf_policy.test-waf", "policy_id", "P1"),
+					resource.TestCheckResourceAttr("bigip_waf_policy.test-waf", "policy_hash", wafPolicyHash(testWafPolicyJSON)),
+					resource.TestCheckResourceAttr("bigip_waf_policy.test-waf", "version", "2019-11-01T10:00:00Z"),
+					func(*terraform.State) error {
+						assert.Equal(t, testWafPolicyJSON, importTask["file"])
+						assert.Equal(t, map[string]interface{}{"fullPath": "/Common/test-waf"}, importTask["policy"])
+						return nil
+					},
+				),
+			},
+			{
+				// Changed on the BIG-IP, so the policy is imported again
+				PreConfig:          func() { version = "2019-11-02T10:00:00Z" },
+				Config:             testBigipWafPolicy(server.URL),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testBigipWafPolicyMode(url, mode string) string {
+	return fmt.Sprintf(`
+		resource "bigip_waf_policy" "test-waf" {
+			name = "/Common/test-waf"
+			policy_json = %q
+			enforcement_mode = "%s"
+			min_transparent_hours = 24
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, testWafPolicyJSON, mode, url)
+}
+
+func TestAccBigipWafPolicyEnforcementMode(t *testing.T) {
+	setup()
+	defer teardown()
+	imported := false
+	mode := "blocking"
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/asm", provisionHandler("nominal"))
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/asm/tasks/import-policy", func(w http.ResponseWriter, r *http.Request) {
+		imported = true
+		fmt.Fprintf(w, `{"id":"import1","status":"NEW"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/import-policy/import1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"import1","status":"COMPLETED"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/apply-policy", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"apply1","status":"NEW"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/tasks/apply-policy/apply1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id":"apply1","status":"COMPLETED"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/policies", func(w http.ResponseWriter, r *http.Request) {
+		if !imported {
+			fmt.Fprintf(w, `{"items":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"items":[{"id":"P1","fullPath":"/Common/test-waf","versionDatetime":"2019-11-01T10:00:00Z","enforcementMode":"%s"}]}`, mode)
+	})
+	mux.HandleFunc("/mgmt/tm/asm/policies/P1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			var p wafPolicy
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &p)
+			assert.JSONEq(t, `{"enforcementMode":"transparent"}`, string(b))
+			mode = p.EnforcementMode
+		case "DELETE":
+			imported = false
+		}
+	})
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipWafPolicyMode(server.URL, "transparent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_waf_policy.test-waf", "enforcement_mode", "transparent"),
+					resource.TestCheckResourceAttrSet("bigip_waf_policy.test-waf", "transparent_since"),
+				),
+			},
+			{
+				Config:      testBigipWafPolicyMode(server.URL, "blocking"),
+				ExpectError: regexp.MustCompile("enforcement_mode of WAF policy /Common/test-waf cannot be changed to blocking before"),
+			},
+		},
+	})
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+)
+
+// REST helpers for objects go-bigip does not model yet. They mirror the unexported
+// helpers of go-bigip and go through client.APICall.
+
+const (
+	uriLtm     = "ltm"
+	uriGtm     = "gtm"
+	uriProfile = "profile"
+)
+
+// stats is the nested layout of the .../stats endpoints
+type stats struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries map[string]statsValue `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+type statsValue struct {
+	Description string `json:"description"`
+	Value       int    `json:"value"`
+}
+
+// iControlPath joins path parts, replacing "/" in object names with "~"
+func iControlPath(parts []string) string {
+	var buffer bytes.Buffer
+	for i, p := range parts {
+		buffer.WriteString(strings.Replace(p, "/", "~", -1))
+		if i < len(parts)-1 {
+			buffer.WriteString("/")
+		}
+	}
+	return buffer.String()
+}
+
+// selectQuery returns the query restricting a response to the given properties, it is appended to
+// the last path part, e.g. getForEntity(client, &p, uriLtm, "pool", name+selectQuery("name", "monitor"))
+func selectQuery(properties ...string) string {
+	return "?$select=" + strings.Join(properties, ",")
+}
+
+// getForEntity populates e from the given path. If the object does not exist (404)
+// e is left untouched and false is returned.
+func getForEntity(client *bigip.BigIP, e interface{}, path ...string) (bool, error) {
+	req := &bigip.APIRequest{
+		Method:      "get",
+		URL:         iControlPath(path),
+		ContentType: "application/json",
+	}
+
+	resp, err := client.APICall(req)
+	if err != nil {
+		var reqError bigip.RequestError
+		json.Unmarshal(resp, &reqError)
+		if reqError.Code == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := json.Unmarshal(resp, e); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkReferenceExists returns an error naming a referenced object, e.g. the VLAN of a SelfIP, if it does not
+// exist, rather than the less helpful one BIG-IP returns when the referencing object is written
+func checkReferenceExists(client *bigip.BigIP, owner, kind, name string, path ...string) error {
+	last := len(path) - 1
+	path = append(append([]string{}, path[:last]...), path[last]+selectQuery("name"))
+	ok, err := getForEntity(client, &struct{}{}, path...)
+	if err != nil {
+		return fmt.Errorf("Error retrieving %s %s of %s: %v", kind, name, owner, err)
+	}
+	if !ok {
+		return fmt.Errorf("%s %s of %s does not exist", kind, name, owner)
+	}
+	return nil
+}
+
+// collectionPageSize is the number of items requested at a time when reading a collection
+const collectionPageSize = 500
+
+type collectionPage struct {
+	Items    []json.RawMessage `json:"items"`
+	NextLink string            `json:"nextLink"`
+}
+
+// getCollection populates items, a pointer to a slice, with every item of the collection at the given path. The
+// items are requested page by page with $top and $skip, so large collections are never truncated. query is an
+// optional query string, e.g. selectQuery("name"). If the collection does not exist (404) false is returned.
+func getCollection(client *bigip.BigIP, items interface{}, query string, path ...string) (bool, error) {
+	var all []json.RawMessage
+	for skip := 0; ; skip += collectionPageSize {
+		pagePath := append([]string{}, path...)
+		pagePath[len(pagePath)-1] += fmt.Sprintf("?$top=%d&$skip=%d", collectionPageSize, skip) + strings.Replace(query, "?", "&", 1)
+		var page collectionPage
+		ok, err := getForEntity(client, &page, pagePath...)
+		if err != nil || !ok {
+			return false, err
+		}
+		all = append(all, page.Items...)
+		if page.NextLink == "" || len(page.Items) == 0 {
+			break
+		}
+	}
+	if all == nil {
+		all = []json.RawMessage{}
+	}
+	b, err := json.Marshal(all)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(b, items)
+}
+
+func sendEntity(client *bigip.BigIP, method string, body interface{}, path ...string) error {
+	return sendForEntity(client, method, body, nil, path...)
+}
+
+// sendForEntity sends body and populates e, unless it is nil, with the response
+func sendForEntity(client *bigip.BigIP, method string, body, e interface{}, path ...string) error {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(body); err != nil {
+		return err
+	}
+
+	req := &bigip.APIRequest{
+		Method:      method,
+		URL:         iControlPath(path),
+		Body:        strings.TrimRight(buffer.String(), "\n"),
+		ContentType: "application/json",
+	}
+
+	resp, err := client.APICall(req)
+	if err != nil || e == nil {
+		return err
+	}
+	return json.Unmarshal(resp, e)
+}
+
+func postEntity(client *bigip.BigIP, body interface{}, path ...string) error {
+	return sendEntity(client, "post", body, path...)
+}
+
+// postForEntity posts body and populates e with the response, e.g. the id of a task it started
+func postForEntity(client *bigip.BigIP, body, e interface{}, path ...string) error {
+	return sendForEntity(client, "post", body, e, path...)
+}
+
+func putEntity(client *bigip.BigIP, body interface{}, path ...string) error {
+	return sendEntity(client, "put", body, path...)
+}
+
+func patchEntity(client *bigip.BigIP, body interface{}, path ...string) error {
+	return sendEntity(client, "patch", body, path...)
+}
+
+func deleteEntity(client *bigip.BigIP, path ...string) error {
+	req := &bigip.APIRequest{
+		Method: "delete",
+		URL:    iControlPath(path),
+	}
+
+	_, err := client.APICall(req)
+	return err
+}
+
+// runBash runs a shell command on the BIG-IP through util/bash and returns its output
+func runBash(client *bigip.BigIP, command string) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"command":     "run",
+		"utilCmdArgs": "-c '" + strings.Replace(command, "'", `'\''`, -1) + "'",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req := &bigip.APIRequest{
+		Method:      "post",
+		URL:         "util/bash",
+		Body:        string(body),
+		ContentType: "application/json",
+	}
+
+	resp, err := client.APICall(req)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		CommandResult string `json:"commandResult"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", err
+	}
+	return result.CommandResult, nil
+}
+
+// downloadChunkSize is the largest chunk the file transfer endpoints return at a time
+const downloadChunkSize = 1024 * 1024
+
+// downloadFile writes the file at the given path of the file transfer endpoints, e.g.
+// shared/file-transfer/ucs-downloads/<name>, to w. It is requested chunk by chunk with Content-Range, the way
+// go-bigip uploads files, and its size is returned.
+func downloadFile(client *bigip.BigIP, w io.Writer, path ...string) (int64, error) {
+	c := &http.Client{
+		Transport: client.Transport,
+		Timeout:   client.ConfigOptions.APICallTimeout,
+	}
+	url := client.Host + "/mgmt/" + iControlPath(path)
+	var start, size int64
+	for {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return 0, err
+		}
+		if client.Token != "" {
+			req.Header.Set("X-F5-Auth-Token", client.Token)
+		} else {
+			req.SetBasicAuth(client.User, client.Password)
+		}
+		req.Header.Set("Content-Range", fmt.Sprintf("%d-%d/%d", start, start+downloadChunkSize-1, size))
+		res, err := c.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		data, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return 0, err
+		}
+		if res.StatusCode >= 400 {
+			return 0, fmt.Errorf("HTTP %d :: %s", res.StatusCode, data)
+		}
+		if _, err := w.Write(data); err != nil {
+			return 0, err
+		}
+		// The response range is start-end/size, the size is unknown until the first chunk is returned
+		var end int64
+		if _, err := fmt.Sscanf(res.Header.Get("Content-Range"), "%d-%d/%d", &start, &end, &size); err != nil {
+			return 0, fmt.Errorf("Unexpected Content-Range %q of %s", res.Header.Get("Content-Range"), url)
+		}
+		start = end + 1
+		if len(data) == 0 || start >= size {
+			return start, nil
+		}
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+)
+
+// How long the first retry of a request waits, each next retry waits twice as long up to the max delay
+var restartRetryInterval = time.Second
+
+// restartRetryTransport retries the requests of a client that fail the way they do while restjavad or
+// restnoded restart, e.g. after provisioning a module or installing an iApp LX package, or during a config
+// sync: the connection is refused or the request is answered with 503, or with 401 once the credentials
+// were accepted. Each request is retried on its own with an exponential backoff until it succeeds, the
+// attempts are spent or the timeout passes, so an apply resumes where it was instead of failing halfway.
+type restartRetryTransport struct {
+	next       http.RoundTripper
+	timeout    time.Duration
+	attempts   int
+	maxDelay   time.Duration
+	apiTimeout time.Duration
+
+	// Set once a request was authenticated, a 401 before that is a wrong password rather than a restart
+	authenticated int32
+}
+
+// enableRestartRetry makes the client retry a request up to attempts times, waiting at most maxDelay between
+// two attempts and up to timeout in all for the REST framework to come back
+func enableRestartRetry(client *bigip.BigIP, timeout time.Duration, attempts int, maxDelay time.Duration) {
+	if timeout <= 0 || attempts <= 0 || client.Transport == nil {
+		return
+	}
+	log.Printf("[DEBUG] Retrying requests to %s %d times for up to %s while the REST framework restarts", client.Host, attempts, timeout)
+	wrapClientTransport(client, func(next http.RoundTripper) http.RoundTripper {
+		return &restartRetryTransport{
+			next:       next,
+			timeout:    timeout,
+			attempts:   attempts,
+			maxDelay:   maxDelay,
+			apiTimeout: client.ConfigOptions.APICallTimeout,
+		}
+	})
+}
+
+// The round trippers registered on the transport of clients, by client
+var clientRoundTrippers sync.Map
+
+// clientRoundTripper is registered once on the transport of a client, a protocol can't be registered twice,
+// and sends the requests through the round trippers wrapped around the transport
+type clientRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (c *clientRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return c.rt.RoundTrip(req)
+}
+
+// wrapClientTransport puts the round tripper returned by wrap in front of the transport of a client, wrap is
+// given the round tripper to send the requests with. It must be called while the client is configured,
+// before any request is sent.
+func wrapClientTransport(client *bigip.BigIP, wrap func(next http.RoundTripper) http.RoundTripper) {
+	if c, ok := clientRoundTrippers.Load(client); ok {
+		c.(*clientRoundTripper).rt = wrap(c.(*clientRoundTripper).rt)
+		return
+	}
+	c := &clientRoundTripper{rt: wrap(client.Transport.Clone())}
+	clientRoundTrippers.Store(client, c)
+	client.Transport.RegisterProtocol("https", c)
+	client.Transport.RegisterProtocol("http", c)
+}
+
+func (t *restartRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline := time.Now().Add(t.timeout)
+	delay := restartRetryInterval
+	for retry := 1; ; retry++ {
+		// The client timeout applies to a single attempt, not to the wait for a restart, so the attempts
+		// are not made with the context of the request
+		ctx, cancel := context.WithCancel(context.Background())
+		if t.apiTimeout > 0 {
+			ctx, cancel = context.WithTimeout(context.Background(), t.apiTimeout)
+		}
+		attempt := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			attempt.Body = body
+		}
+		res, err := t.next.RoundTrip(attempt)
+		if err == nil && res.StatusCode != http.StatusUnauthorized {
+			atomic.StoreInt32(&t.authenticated, 1)
+		}
+		if !t.isTransientFailure(req, res, err) || retry > t.attempts || time.Now().Add(delay).After(deadline) {
+			if res != nil {
+				res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+			} else {
+				cancel()
+			}
+			return res, err
+		}
+		if res != nil {
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+			log.Printf("[WARN] %s %s answered %s, retrying in %s (%d/%d)", req.Method, req.URL.Path, res.Status, delay, retry, t.attempts)
+		} else {
+			log.Printf("[WARN] %s %s failed (%v), retrying in %s (%d/%d)", req.Method, req.URL.Path, err, delay, retry, t.attempts)
+		}
+		cancel()
+		time.Sleep(delay)
+		if delay *= 2; delay > t.maxDelay {
+			delay = t.maxDelay
+		}
+	}
+}
+
+// isTransientFailure reports whether a request failed because the REST framework is restarting or busy. A
+// 401 is only transient once a request of the client was authenticated, and when the request has no token:
+// an expired token is refreshed rather than sent again.
+func (t *restartRetryTransport) isTransientFailure(req *http.Request, res *http.Response, err error) bool {
+	if err == nil && res.StatusCode == http.StatusUnauthorized {
+		return atomic.LoadInt32(&t.authenticated) == 1 && req.Header.Get("X-F5-Auth-Token") == ""
+	}
+	return isRestartFailure(req, res, err)
+}
+
+// isRestartFailure reports whether a request failed because restjavad or restnoded are not running. A
+// dropped connection is only retried for a GET, since a write may have been applied before it dropped.
+func isRestartFailure(req *http.Request, res *http.Response, err error) bool {
+	if err == nil {
+		return res.StatusCode == http.StatusServiceUnavailable
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	return req.Method == http.MethodGet && (errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF))
+}
+
+// cancelOnClose releases the context of a request once its response has been read
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartRetryResumesAfterRestart(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(d time.Duration) { restartRetryInterval = d }(restartRetryInterval)
+	restartRetryInterval = time.Millisecond
+
+	var bodies []string
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `<html>Service Unavailable</html>`)
+			return
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableRestartRetry(client, time.Minute, 10, 10*time.Millisecond)
+	assert.Nil(t, postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node"))
+	if assert.Len(t, bodies, 3) {
+		assert.Equal(t, bodies[0], bodies[2], "the request body is sent again")
+	}
+}
+
+func TestRestartRetryGivesUp(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(d time.Duration) { restartRetryInterval = d }(restartRetryInterval)
+	restartRetryInterval = 10 * time.Millisecond
+
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, `<html>Service Unavailable</html>`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableRestartRetry(client, 50*time.Millisecond, 10, 10*time.Millisecond)
+	err := postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "503")
+	}
+}
+
+func TestRestartRetryAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(d time.Duration) { restartRetryInterval = d }(restartRetryInterval)
+	restartRetryInterval = time.Millisecond
+
+	attempts := 0
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, `<html>Service Unavailable</html>`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableRestartRetry(client, time.Minute, 3, 2*time.Millisecond)
+	assert.NotNil(t, postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node"))
+	assert.Equal(t, 4, attempts, "the request is sent once and retried 3 times")
+}
+
+func TestRestartRetryUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(d time.Duration) { restartRetryInterval = d }(restartRetryInterval)
+	restartRetryInterval = time.Millisecond
+
+	refused := 0
+	mux.HandleFunc("/mgmt/tm/ltm/node", func(w http.ResponseWriter, r *http.Request) {
+		if refused < 2 {
+			refused++
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintf(w, `{"code":401,"message":"Authentication failed."}`)
+			return
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableRestartRetry(client, time.Minute, 10, 2*time.Millisecond)
+	assert.NotNil(t, postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node"),
+		"a 401 is a wrong password until a request was authenticated")
+	refused = 0
+	mux.HandleFunc("/mgmt/tm/sys/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	_, err := getForEntity(client, &struct{}{}, "sys", "version")
+	assert.Nil(t, err)
+	assert.Nil(t, postEntity(client, map[string]string{"name": "/Common/test-node"}, "ltm", "node"))
+	assert.Equal(t, 2, refused)
+}
+
+func TestIsRestartFailure(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	_, refused := http.Get("http://" + addr)
+
+	get, _ := http.NewRequest("GET", "http://"+addr, nil)
+	post, _ := http.NewRequest("POST", "http://"+addr, nil)
+	assert.True(t, isRestartFailure(post, nil, refused), "refused connections are retried")
+	assert.True(t, isRestartFailure(post, &http.Response{StatusCode: 503}, nil))
+	assert.False(t, isRestartFailure(post, &http.Response{StatusCode: 404}, nil))
+	assert.True(t, isRestartFailure(get, nil, fmt.Errorf("read: %w", io.ErrUnexpectedEOF)))
+	assert.False(t, isRestartFailure(post, nil, fmt.Errorf("read: %w", io.ErrUnexpectedEOF)), "writes may have been applied")
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Addresses in a route domain carry its id after a %, e.g. 10.1.1.1%2 or 10.1.1.0%2/24. Addresses without one are
+// in the default route domain of their partition, and the device may answer them with its id: the helpers below
+// keep the addresses of the state in the form they are configured.
+
+// splitRouteDomain splits the route domain id off an address, with or without a mask, e.g. 10.1.1.0%2/24 into
+// 10.1.1.0/24 and 2. ok is false when the address has no route domain id.
+func splitRouteDomain(address string) (string, int, bool) {
+	addr, mask := splitMask(address)
+	i := strings.Index(addr, "%")
+	if i < 0 {
+		return address, 0, false
+	}
+	id, err := strconv.Atoi(addr[i+1:])
+	if err != nil {
+		return address, 0, false
+	}
+	return addr[:i] + mask, id, true
+}
+
+// withRouteDomain adds the id of a route domain other than 0 to an address without one, before its mask
+func withRouteDomain(address string, id int) string {
+	if _, _, ok := splitRouteDomain(address); ok || id == 0 {
+		return address
+	}
+	addr, mask := splitMask(address)
+	return fmt.Sprintf("%s%%%d%s", addr, id, mask)
+}
+
+// checkRouteDomain returns an error when an address carries the id of another route domain than id, set by the
+// route_domain argument of a resource
+func checkRouteDomain(address string, id int) error {
+	if _, inAddress, ok := splitRouteDomain(address); ok && id != 0 && inAddress != id {
+		return fmt.Errorf("address %s is in route domain %d, not in route_domain %d", address, inAddress, id)
+	}
+	return nil
+}
+
+// configuredAddress returns an address read from the device in the form of the configured one: with its route
+// domain id, %0 included, when the configured address has one, without otherwise
+func configuredAddress(configured, device string) string {
+	if configured == "" {
+		return device
+	}
+	address, id, _ := splitRouteDomain(device)
+	if _, _, ok := splitRouteDomain(configured); !ok {
+		return address
+	}
+	addr, mask := splitMask(address)
+	return fmt.Sprintf("%s%%%d%s", addr, id, mask)
+}
+
+// splitMask splits the mask off an address, e.g. 10.1.1.0/24 into 10.1.1.0 and /24
+func splitMask(address string) (string, string) {
+	if i := strings.Index(address, "/"); i >= 0 {
+		return address[:i], address[i:]
+	}
+	return address, ""
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfiguredAddress(t *testing.T) {
+	data := map[[2]string]string{
+		{"10.1.1.1", "10.1.1.1%2"}:       "10.1.1.1",
+		{"10.1.1.1%2", "10.1.1.1%2"}:     "10.1.1.1%2",
+		{"10.1.1.1%0", "10.1.1.1"}:       "10.1.1.1%0",
+		{"0.0.0.0/0", "0.0.0.0%2/0"}:     "0.0.0.0/0",
+		{"0.0.0.0%2/0", "0.0.0.0%2/0"}:   "0.0.0.0%2/0",
+		{"2001:db8::1", "2001:db8::1%3"}: "2001:db8::1",
+		{"", "10.1.1.1%2"}:               "10.1.1.1%2",
+	}
+
+	for d, expected := range data {
+		assert.Equal(t, expected, configuredAddress(d[0], d[1]), "%s read as %s", d[0], d[1])
+	}
+}
+
+func TestWithRouteDomain(t *testing.T) {
+	data := map[string]string{
+		"10.1.1.1":    "10.1.1.1%2",
+		"10.1.1.1%3":  "10.1.1.1%3",
+		"0.0.0.0/0":   "0.0.0.0%2/0",
+		"2001:db8::1": "2001:db8::1%2",
+	}
+
+	for address, expected := range data {
+		assert.Equal(t, expected, withRouteDomain(address, 2))
+	}
+	assert.Equal(t, "10.1.1.1", withRouteDomain("10.1.1.1", 0))
+	assert.NoError(t, checkRouteDomain("10.1.1.1%2", 2))
+	assert.NoError(t, checkRouteDomain("10.1.1.1%2", 0))
+	assert.Error(t, checkRouteDomain("10.1.1.1%2", 3))
+}
+
+func TestAccBigipLtmNodeRouteDomain(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				// The device answers the addresses with their route domain id, the plan is empty once applied
+				Config: fmt.Sprintf(`
+					resource "bigip_ltm_node" "by_attribute" {
+						name = "/Common/by_attribute"
+						address = "10.1.1.1"
+						route_domain = 2
+					}
+					resource "bigip_ltm_node" "by_suffix" {
+						name = "/Common/by_suffix"
+						address = "10.1.1.2%%2"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+					}
+				`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_node.by_attribute", "address", "10.1.1.1"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.by_attribute", "route_domain", "2"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.by_suffix", "address", "10.1.1.2%2"),
+					resource.TestCheckResourceAttr("bigip_ltm_node.by_suffix", "route_domain", "2"),
+					func(*terraform.State) error {
+						assert.Equal(t, "10.1.1.1%2", objects["/mgmt/tm/ltm/node/~Common~by_attribute"]["address"])
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
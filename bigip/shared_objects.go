@@ -0,0 +1,72 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// AS3 declares the objects shared by the tenants in the Shared application of the Common tenant, the
+// /Common/Shared folder. The resources flagged shared follow the same convention, so that AS3 declarations and
+// resources reference the shared objects of each other by the same full path, e.g. /Common/Shared/web_pool.
+const sharedFolder = "/Common/Shared"
+
+// isSharedName returns whether an object's full path is in the folder of the shared objects
+func isSharedName(name string) bool {
+	return strings.HasPrefix(name, sharedFolder+"/") && len(name) > len(sharedFolder)+1
+}
+
+// sharedObjects adds the shared flag to a resource named by full path. The name of a shared resource must be
+// in /Common/Shared, which is created first when it is missing. The flag only applies to the creation of the
+// object, it never replaces an existing one.
+func sharedObjects(r *schema.Resource) {
+	s, ok := r.Schema["name"]
+	if !ok || s.Type != schema.TypeString || s.ValidateFunc == nil || r.Create == nil ||
+		reflect.ValueOf(s.ValidateFunc).Pointer() != reflect.ValueOf(validateF5Name).Pointer() {
+		return
+	}
+	r.Schema["shared"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Create the object in " + sharedFolder + ", shared with the tenants as by AS3",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != ""
+		},
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+		if !d.Get("shared").(bool) || !d.NewValueKnown("name") {
+			return nil
+		}
+		if name := d.Get("name").(string); !isSharedName(name) {
+			return fmt.Errorf("%s is shared, its name must be in %s, e.g. %s/%s", name, sharedFolder, sharedFolder, path.Base(name))
+		}
+		return nil
+	}
+
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if d.Get("shared").(bool) {
+			if err := ensureFolders(meta.(*bigip.BigIP), d.Get("name").(string)); err != nil {
+				return err
+			}
+		}
+		return create(d, meta)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSharedName(t *testing.T) {
+	data := map[string]bool{
+		"/Common/Shared/web_pool":     true,
+		"/Common/Shared/app/web_pool": true,
+		"/Common/Shared":              false,
+		"/Common/Shared/":             false,
+		"/Common/SharedPool":          false,
+		"/Tenant/Shared/web_pool":     false,
+	}
+
+	for name, expected := range data {
+		assert.Equal(t, expected, isSharedName(name), name)
+	}
+}
+
+func testBigipSharedNode(url, name, shared string) string {
+	return fmt.Sprintf(`
+		resource "bigip_ltm_node" "web" {
+			name = "%s"
+			address = "10.1.1.1"
+			%s
+		}
+		provider "bigip" {
+			address = "%s"
+			username = "xxxx"
+			password = "xxxx"
+		}
+	`, name, shared, url)
+}
+
+func TestAccBigipSharedObject(t *testing.T) {
+	objects := map[string]map[string]interface{}{
+		"/mgmt/tm/auth/partition/Common": {"name": "Common"},
+	}
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.HandleFunc("/mgmt/tm/", objectsHandler(objects))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testBigipSharedNode(server.URL, "/Tenant/web", "shared = true"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`/Tenant/web is shared, its name must be in /Common/Shared, e.g. /Common/Shared/web`),
+			},
+			{
+				// The folder of the shared objects is created with the first of them
+				Config: testBigipSharedNode(server.URL, "/Common/Shared/web", "shared = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_ltm_node.web", "shared", "true"),
+					func(*terraform.State) error {
+						assert.Contains(t, objects, "/mgmt/tm/sys/folder/~Common~Shared")
+						assert.Contains(t, objects, "/mgmt/tm/ltm/node/~Common~Shared~web")
+						return nil
+					},
+				),
+			},
+			{
+				// The flag only applies to the creation of the object
+				Config:   testBigipSharedNode(server.URL, "/Common/Shared/web", ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
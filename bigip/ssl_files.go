@@ -0,0 +1,76 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Certificates and keys are installed from files uploaded with the file transfer endpoints. The device reports the
+// checksum of their content, which detects the certificates and keys replaced outside of Terraform.
+
+// sslFileChecksum returns the checksum the device reports for a certificate or key of the given content,
+// SHA1:<size>:<sha1 in hex>
+func sslFileChecksum(content string) string {
+	return fmt.Sprintf("SHA1:%d:%x", len(content), sha1.Sum([]byte(content)))
+}
+
+// installSslFile uploads the content of a certificate or key, kind being ssl-cert or ssl-key, and installs it in
+// partition, or replaces the installed one when replace is set
+func installSslFile(client *bigip.BigIP, kind, name, partition, content string, replace bool) error {
+	path := append(uriFileTransferUploads, name)
+	skip, err := reportDryRun(client, "POST", "/"+strings.Join(path, "/"), map[string]string{"checksum": sslFileChecksum(content)})
+	if err != nil {
+		return err
+	}
+	if !skip {
+		if _, err := client.UploadBytes([]byte(content), name); err != nil {
+			return fmt.Errorf("Error uploading %s (%s): %s", kind, name, err)
+		}
+	}
+	source := map[string]string{"sourcePath": "file://" + bigip.REST_DOWNLOAD_PATH + "/" + name}
+	if replace {
+		return patchEntity(client, source, "sys", "file", kind, "/"+partition+"/"+name)
+	}
+	source["name"] = name
+	source["partition"] = partition
+	return postEntity(client, source, "sys", "file", kind)
+}
+
+// checkSslFileContent empties the content of the state when the checksum of the installed certificate or key
+// doesn't match it, so that the configured content is installed again
+func checkSslFileContent(d *schema.ResourceData, checksum string) {
+	content := d.Get("content").(string)
+	if content == "" || !strings.HasPrefix(checksum, "SHA1:") {
+		return
+	}
+	if checksum != sslFileChecksum(content) {
+		log.Printf("[WARN] Content of %s was replaced on the device", d.Id())
+		d.Set("content", "")
+	}
+}
+
+// importSslFile imports a certificate or key by its name in Common, or by its full path, /Partition/name, in
+// another partition
+func importSslFile(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The default of the partition doesn't apply to the state imported
+	partition, name := DEFAULT_PARTITION, d.Id()
+	if strings.HasPrefix(d.Id(), "/") {
+		if _, errs := validateF5Name(d.Id(), "id"); len(errs) > 0 {
+			return nil, errs[0]
+		}
+		partition, name = parseF5Identifier(d.Id())
+	}
+	d.Set("partition", partition)
+	d.SetId(name)
+	return []*schema.ResourceData{d}, nil
+}
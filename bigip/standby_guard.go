@@ -0,0 +1,83 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Clients whose writes are refused while the device is STANDBY. Changes made on a
+// standby unit are overwritten by the next config sync from the active unit.
+var standbyGuardedClients sync.Map
+
+// getFailoverState returns the failover status of the device, e.g. ACTIVE, STANDBY or OFFLINE
+func getFailoverState(client *bigip.BigIP) (string, error) {
+	var fs stats
+	_, err := getForEntity(client, &fs, "cm", "failover-status")
+	if err != nil {
+		return "", err
+	}
+	for _, e := range fs.Entries {
+		if status, ok := e.NestedStats.Entries["status"]; ok {
+			return status.Description, nil
+		}
+	}
+	return "", fmt.Errorf("unable to determine failover status of %s", client.Host)
+}
+
+func checkNotStandby(client *bigip.BigIP) error {
+	if _, ok := standbyGuardedClients.Load(providerClient(client)); !ok {
+		return nil
+	}
+	state, err := getFailoverState(client)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(state, "STANDBY") {
+		return fmt.Errorf("refusing to write to %s: device is STANDBY and changes would be lost on the next config sync, "+
+			"apply against the active device or set allow_standby_writes = true", client.Host)
+	}
+	return nil
+}
+
+// guardStandbyWrites wraps the Create, Update and Delete functions of a resource with the standby check
+func guardStandbyWrites(r *schema.Resource) {
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkNotStandby(meta.(*bigip.BigIP)); err != nil {
+				return err
+			}
+			return create(d, meta)
+		}
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkNotStandby(meta.(*bigip.BigIP)); err != nil {
+				return err
+			}
+			return update(d, meta)
+		}
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkNotStandby(meta.(*bigip.BigIP)); err != nil {
+				return err
+			}
+			return del(d, meta)
+		}
+	}
+}
+
+func enableStandbyGuard(client *bigip.BigIP) {
+	log.Printf("[DEBUG] Refusing writes to %s while it is STANDBY", client.Host)
+	standbyGuardedClients.Store(client, true)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func failoverStatusHandler(state string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/cm/failover-status/0":{"nestedStats":{"entries":{
+			"color":{"description":"gray"},
+			"status":{"description":"%s"},
+			"summary":{"description":"1/1 standby"}}}}}}`, state)
+	}
+}
+
+func TestStandbyGuardRefusesStandby(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("STANDBY"))
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	assert.Nil(t, checkNotStandby(client), "unguarded clients are never refused")
+
+	enableStandbyGuard(client)
+	defer standbyGuardedClients.Delete(client)
+	err := checkNotStandby(client)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "device is STANDBY")
+	}
+}
+
+func TestStandbyGuardAllowsActive(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableStandbyGuard(client)
+	defer standbyGuardedClients.Delete(client)
+	assert.Nil(t, checkNotStandby(client))
+}
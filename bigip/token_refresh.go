@@ -0,0 +1,121 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+)
+
+// tokenRefreshTransport logs in again when the token of a client has expired, after 20 minutes by default,
+// and sends the refused request again with the new token, so applies outlasting a token do not fail halfway.
+// The client keeps sending the token it logged in with, which is read by parallel requests without locking,
+// the transport replaces it with the current token.
+type tokenRefreshTransport struct {
+	next          http.RoundTripper
+	client        *bigip.BigIP
+	loginProvider string
+
+	// Serializes the logins of parallel requests refused with the same expired token, and guards token
+	mu    sync.Mutex
+	token string
+}
+
+// enableTokenRefresh makes the token session of a client log in again with loginProvider when its token expires
+func enableTokenRefresh(client *bigip.BigIP, loginProvider string) {
+	if client.Token == "" || client.Transport == nil {
+		return
+	}
+	log.Printf("[DEBUG] Refreshing the token of %s when it expires", client.Host)
+	wrapClientTransport(client, func(next http.RoundTripper) http.RoundTripper {
+		return &tokenRefreshTransport{next: next, client: client, loginProvider: loginProvider, token: client.Token}
+	})
+}
+
+func (t *tokenRefreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get("X-F5-Auth-Token")
+	if token != "" {
+		t.mu.Lock()
+		current := t.token
+		t.mu.Unlock()
+		if token != current {
+			req = req.Clone(req.Context())
+			req.Header.Set("X-F5-Auth-Token", current)
+			token = current
+		}
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusUnauthorized || token == "" || (req.Body != nil && req.GetBody == nil) {
+		return res, err
+	}
+	refreshed, err := t.refresh(req, token)
+	if err != nil {
+		log.Printf("[WARN] Unable to refresh the token of %s: %v", t.client.Host, err)
+		return res, nil
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+
+	retry := req.Clone(req.Context())
+	retry.Header.Set("X-F5-Auth-Token", refreshed)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.next.RoundTrip(retry)
+}
+
+// refresh returns the token replacing expired, logging in unless a parallel request already did
+func (t *tokenRefreshTransport) refresh(req *http.Request, expired string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != expired {
+		return t.token, nil
+	}
+	log.Printf("[INFO] The token of %s has expired, logging in again", t.client.Host)
+
+	body, err := json.Marshal(map[string]string{
+		"username":          t.client.User,
+		"password":          t.client.Password,
+		"loginProviderName": t.loginProvider,
+	})
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("%s://%s/mgmt/shared/authn/login", req.URL.Scheme, req.URL.Host)
+	login, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	login = login.WithContext(req.Context())
+	login.Header.Set("Content-Type", "application/json")
+	res, err := t.next.RoundTrip(login)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("login answered %s", res.Status)
+	}
+	var auth authnLogin
+	if err := json.NewDecoder(res.Body).Decode(&auth); err != nil {
+		return "", err
+	}
+	if auth.Token.Token == "" {
+		return "", fmt.Errorf("login returned no token")
+	}
+	t.token = auth.Token.Token
+	return t.token, nil
+}
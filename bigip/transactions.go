@@ -0,0 +1,329 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The header queuing a request in an iControl REST transaction instead of applying it
+const transactionHeader = "X-F5-REST-Coordination-Id"
+
+// The writes that can't be queued in a transaction, they are sent once the writes queued before them are committed
+var nonTransactionalPaths = []string{
+	"/mgmt/tm/transaction",
+	"/mgmt/tm/asm/",
+	"/mgmt/tm/cm/",
+	"/mgmt/tm/util/",
+	"/mgmt/tm/sys/config",
+	"/mgmt/tm/sys/license",
+	"/mgmt/tm/sys/provision",
+	"/mgmt/tm/sys/software",
+	"/mgmt/tm/sys/ucs",
+}
+
+// transactionOperation queues the writes of an operation of a resource in an iControl REST transaction, committed
+// when the operation succeeds and discarded when it fails, so that an operation failing halfway does not leave half
+// of its objects on the device. Each operation sends its requests with a client of its own, whose transport is the
+// operation, so the requests of the other operations and plans running in parallel are never part of its
+// transaction. The reads of the operation are sent as they are, and see the configuration without the writes
+// queued; only a write that can't be queued commits the transaction before it is sent.
+type transactionOperation struct {
+	next http.RoundTripper
+	// Called before each request of the operation
+	onRequest func()
+
+	mu sync.Mutex
+	// The open transaction, 0 if none, and whether the operation changed the device, committing a transaction or
+	// sending a write that can't be queued
+	transID int64
+	applied bool
+	// The address and authentication of the requests of the operation, to open and commit its transaction
+	base   *url.URL
+	header http.Header
+}
+
+// The clients in transactional mode
+var transactionClients sync.Map
+
+// operationClient is a client an operation sends its requests with, made from the client of the provider
+type operationClient struct {
+	provider *bigip.BigIP
+	// Whether the writes of the operation are queued in a transaction
+	inTransaction bool
+}
+
+// The clients of the operations, by client
+var operationClients sync.Map
+
+type transaction struct {
+	TransID       int64  `json:"transId,omitempty"`
+	State         string `json:"state,omitempty"`
+	FailureReason string `json:"failureReason,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+// enableTransactions makes the client apply the writes of an operation of a resource in transactions
+func enableTransactions(client *bigip.BigIP) {
+	if client.Transport == nil {
+		log.Printf("[WARN] Transactions are not supported by the connection to %s", client.Host)
+		return
+	}
+	log.Printf("[DEBUG] Applying the changes to %s in transactions", client.Host)
+	transactionClients.Store(client, true)
+}
+
+// providerClient returns the client of the provider the client of an operation was made from, so that the
+// settings of the provider, held by client, apply to its operations
+func providerClient(client *bigip.BigIP) *bigip.BigIP {
+	if c, ok := operationClients.Load(client); ok {
+		return c.(operationClient).provider
+	}
+	return client
+}
+
+// inTransaction returns whether client is the client of an operation whose writes are queued in a transaction
+func inTransaction(client interface{}) bool {
+	c, ok := operationClients.Load(client)
+	return ok && c.(operationClient).inTransaction
+}
+
+// newOperationClient returns the client of an operation made from client, sending its requests through the round
+// tripper wrap returns for the transport of client. The client of an operation made from the client of another
+// one is part of it.
+func newOperationClient(client *bigip.BigIP, transaction bool, wrap func(next http.RoundTripper) http.RoundTripper) *bigip.BigIP {
+	op := operationClient{provider: client}
+	if c, ok := operationClients.Load(client); ok {
+		op = c.(operationClient)
+	}
+	op.inTransaction = op.inTransaction || transaction
+	var next http.RoundTripper = http.DefaultTransport
+	if client.Transport != nil {
+		next = client.Transport
+	}
+	rt := wrap(next)
+	transport := &http.Transport{}
+	transport.RegisterProtocol("https", rt)
+	transport.RegisterProtocol("http", rt)
+	opClient := *client
+	opClient.Transport = transport
+	operationClients.Store(&opClient, op)
+	return &opClient
+}
+
+// inTransactions wraps the Create, Update and Delete functions of a resource so that the writes of each operation
+// on a client in transactional mode are applied in a transaction. The reads of an operation don't see its writes
+// until they are committed, so the resource is read again once they are. A failed create that changed nothing
+// leaves no object, the resource is not kept in the state.
+func inTransactions(r *schema.Resource) {
+	read := r.Read
+	wrap := func(f func(*schema.ResourceData, interface{}) error, readBack func(*schema.ResourceData, interface{}) error, create bool) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client := meta.(*bigip.BigIP)
+			if _, ok := transactionClients.Load(client); !ok {
+				return f(d, meta)
+			}
+			// The reads of the operation can't find the objects queued, and may remove the resource from the state
+			id := d.Id()
+			opClient, op := newTransactionOperation(client)
+			defer operationClients.Delete(opClient)
+			op.onRequest = func() {
+				if d.Id() != "" {
+					id = d.Id()
+				}
+			}
+			applied, err := op.run(func() error { return f(d, opClient) })
+			if err != nil {
+				if create && !applied {
+					d.SetId("")
+				}
+				return err
+			}
+			if readBack == nil || id == "" {
+				return nil
+			}
+			d.SetId(id)
+			return readBack(d, client)
+		}
+	}
+	var readAfterWrite func(*schema.ResourceData, interface{}) error
+	if read != nil {
+		readAfterWrite = func(d *schema.ResourceData, meta interface{}) error {
+			return readAfterCreate(d, meta, read)
+		}
+	}
+	r.Create = wrap(r.Create, readAfterWrite, true)
+	r.Update = wrap(r.Update, read, false)
+	r.Delete = wrap(r.Delete, nil, false)
+}
+
+// newTransactionOperation returns the client of an operation made from client, which sends its requests through
+// the operation returned
+func newTransactionOperation(client *bigip.BigIP) (*bigip.BigIP, *transactionOperation) {
+	op := &transactionOperation{}
+	opClient := newOperationClient(client, true, func(next http.RoundTripper) http.RoundTripper {
+		op.next = next
+		return op
+	})
+	return opClient, op
+}
+
+// run runs an operation, committing the transaction it left open when it succeeds and discarding it when it
+// fails. It returns whether the operation changed the device.
+func (op *transactionOperation) run(f func() error) (bool, error) {
+	err := f()
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	if op.transID != 0 {
+		if err != nil {
+			op.discard()
+		} else {
+			err = op.commit()
+		}
+	}
+	return op.applied, err
+}
+
+func (op *transactionOperation) RoundTrip(req *http.Request) (*http.Response, error) {
+	if op.onRequest != nil {
+		op.onRequest()
+	}
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	if req.Method == http.MethodGet {
+		return op.next.RoundTrip(req)
+	}
+	if isTransactionalPath(req.URL.Path) {
+		if op.transID == 0 {
+			if err := op.begin(req); err != nil {
+				return nil, err
+			}
+		}
+		queued := req.Clone(req.Context())
+		queued.Header.Set(transactionHeader, fmt.Sprint(op.transID))
+		return op.next.RoundTrip(queued)
+	}
+	// The writes queued before are applied first
+	if op.transID != 0 {
+		if err := op.commit(); err != nil {
+			return nil, err
+		}
+	}
+	op.applied = true
+	return op.next.RoundTrip(req)
+}
+
+func isTransactionalPath(path string) bool {
+	if !strings.HasPrefix(path, "/mgmt/tm/") {
+		return false
+	}
+	for _, p := range nonTransactionalPaths {
+		if strings.HasPrefix(path, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// begin opens a transaction with the address and authentication of req, op.mu being held
+func (op *transactionOperation) begin(req *http.Request) error {
+	op.base = &url.URL{Scheme: req.URL.Scheme, Host: req.URL.Host}
+	op.header = http.Header{}
+	for _, h := range []string{"Authorization", "X-F5-Auth-Token"} {
+		if v := req.Header.Get(h); v != "" {
+			op.header.Set(h, v)
+		}
+	}
+	tr, err := op.send(http.MethodPost, "/mgmt/tm/transaction", transaction{})
+	if err != nil {
+		return fmt.Errorf("Error opening a transaction on %s: %s", op.base.Host, err)
+	}
+	if tr.TransID == 0 {
+		return fmt.Errorf("Error opening a transaction on %s: no transaction id", op.base.Host)
+	}
+	log.Printf("[DEBUG] Opened transaction %d on %s", tr.TransID, op.base.Host)
+	op.transID = tr.TransID
+	return nil
+}
+
+// commit applies the writes queued in the open transaction, all of them or none, op.mu being held
+func (op *transactionOperation) commit() error {
+	id := op.transID
+	op.transID = 0
+	tr, err := op.send(http.MethodPatch, fmt.Sprintf("/mgmt/tm/transaction/%d", id), transaction{State: "VALIDATING"})
+	if err != nil {
+		return fmt.Errorf("transaction %d failed, none of its changes were applied: %s", id, err)
+	}
+	if tr.State == "FAILED" {
+		return fmt.Errorf("transaction %d failed, none of its changes were applied: %s", id, tr.FailureReason)
+	}
+	log.Printf("[DEBUG] Committed transaction %d on %s", id, op.base.Host)
+	op.applied = true
+	return nil
+}
+
+// discard deletes the open transaction, the writes queued in it are not applied, op.mu being held
+func (op *transactionOperation) discard() {
+	id := op.transID
+	op.transID = 0
+	if _, err := op.send(http.MethodDelete, fmt.Sprintf("/mgmt/tm/transaction/%d", id), nil); err != nil {
+		log.Printf("[WARN] Unable to discard transaction %d on %s, it expires unapplied: %s", id, op.base.Host, err)
+		return
+	}
+	log.Printf("[INFO] Discarded transaction %d on %s, none of its changes were applied", id, op.base.Host)
+}
+
+func (op *transactionOperation) send(method, path string, body interface{}) (*transaction, error) {
+	var b []byte
+	if body != nil {
+		var err error
+		if b, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	u := *op.base
+	u.Path = path
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	for h := range op.header {
+		req.Header.Set(h, op.header.Get(h))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := op.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	out, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tr transaction
+	json.Unmarshal(out, &tr)
+	if res.StatusCode >= 300 {
+		if tr.Message == "" {
+			tr.Message = strings.TrimSpace(string(out))
+		}
+		return nil, fmt.Errorf("HTTP %d :: %s", res.StatusCode, tr.Message)
+	}
+	return &tr, nil
+}
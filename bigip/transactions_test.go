@@ -0,0 +1,332 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type queuedRequest struct {
+	method, path string
+	body         []byte
+}
+
+// transactionDevice answers the requests on the objects under /mgmt/tm/ like a device supporting transactions,
+// applying the requests queued in a transaction all together on commit, or none of them when one fails or its
+// path is refused. requests records the requests sent, the queued ones with their transaction.
+type transactionDevice struct {
+	mu       sync.Mutex
+	objects  map[string]map[string]interface{}
+	refused  string
+	requests []string
+
+	lastID int
+	queues map[int][]queuedRequest
+}
+
+func newTransactionDevice(objects map[string]map[string]interface{}) *transactionDevice {
+	return &transactionDevice{objects: objects, queues: map[int][]queuedRequest{}}
+}
+
+func (dev *transactionDevice) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	dev.mu.Lock()
+	defer dev.mu.Unlock()
+	body, _ := ioutil.ReadAll(r.Body)
+	if id := r.Header.Get(transactionHeader); id != "" {
+		var n int
+		fmt.Sscan(id, &n)
+		dev.requests = append(dev.requests, fmt.Sprintf("%s %s in %d", r.Method, r.URL.Path, n))
+		dev.queues[n] = append(dev.queues[n], queuedRequest{r.Method, r.URL.Path, body})
+		fmt.Fprintf(w, `{"transId":%d,"evalOrder":%d}`, n, len(dev.queues[n]))
+		return
+	}
+	dev.requests = append(dev.requests, r.Method+" "+r.URL.Path)
+	switch {
+	case r.URL.Path == "/mgmt/tm/transaction":
+		dev.lastID++
+		dev.queues[dev.lastID] = nil
+		fmt.Fprintf(w, `{"transId":%d,"state":"STARTED"}`, dev.lastID)
+	case strings.HasPrefix(r.URL.Path, "/mgmt/tm/transaction/"):
+		var n int
+		fmt.Sscan(strings.TrimPrefix(r.URL.Path, "/mgmt/tm/transaction/"), &n)
+		queue := dev.queues[n]
+		delete(dev.queues, n)
+		if r.Method == "DELETE" {
+			return
+		}
+		if string(body) != `{"state":"VALIDATING"}` {
+			w.WriteHeader(400)
+			fmt.Fprintf(w, `{"code":400,"message":"unexpected %s"}`, body)
+			return
+		}
+		objects := map[string]map[string]interface{}{}
+		for k, v := range dev.objects {
+			objects[k] = v
+		}
+		for _, q := range queue {
+			res := httptest.NewRecorder()
+			objectsHandler(objects).ServeHTTP(res, httptest.NewRequest(q.method, q.path, bytes.NewReader(q.body)))
+			if q.path == dev.refused || res.Code >= 300 {
+				w.WriteHeader(400)
+				fmt.Fprintf(w, `{"code":400,"message":"transaction failed:01070734:3: Configuration error: %s refused"}`, q.path)
+				return
+			}
+		}
+		for k := range dev.objects {
+			delete(dev.objects, k)
+		}
+		for k, v := range objects {
+			dev.objects[k] = v
+		}
+		fmt.Fprintf(w, `{"transId":%d,"state":"COMPLETED"}`, n)
+	default:
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		objectsHandler(dev.objects).ServeHTTP(w, r)
+	}
+}
+
+func TestTransactionQueuesWrites(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	opClient, op := newTransactionOperation(client)
+	applied, err := op.run(func() error {
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/web", "address": "10.1.1.1"}, "ltm", "node"))
+		assert.Nil(t, patchEntity(opClient, map[string]string{"description": "web"}, "ltm", "node", "/Common/web"))
+		assert.Empty(t, objects, "the writes are not applied before the commit")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, "web", objects["/mgmt/tm/ltm/node/~Common~web"]["description"])
+	assert.Equal(t, []string{
+		"POST /mgmt/tm/transaction",
+		"POST /mgmt/tm/ltm/node in 1",
+		"PATCH /mgmt/tm/ltm/node/~Common~web in 1",
+		"PATCH /mgmt/tm/transaction/1",
+	}, dev.requests)
+}
+
+func TestTransactionReadsNotCommitting(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	opClient, op := newTransactionOperation(client)
+	_, err := op.run(func() error {
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/web", "address": "10.1.1.1"}, "ltm", "node"))
+		ok, err := getForEntity(opClient, &struct{}{}, "ltm", "node", "/Common/web")
+		assert.False(t, ok, "the object queued is not seen before the commit")
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, objects, "/mgmt/tm/ltm/node/~Common~web")
+	assert.Equal(t, []string{
+		"POST /mgmt/tm/transaction",
+		"POST /mgmt/tm/ltm/node in 1",
+		"GET /mgmt/tm/ltm/node/~Common~web",
+		"PATCH /mgmt/tm/transaction/1",
+	}, dev.requests)
+}
+
+func TestTransactionCommittedBeforeNonTransactionalWrite(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	opClient, op := newTransactionOperation(client)
+	applied, err := op.run(func() error {
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/web", "address": "10.1.1.1"}, "ltm", "node"))
+		return postEntity(opClient, map[string]string{"name": "save", "command": "run"}, "util", "bash")
+	})
+	assert.Nil(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, []string{
+		"POST /mgmt/tm/transaction",
+		"POST /mgmt/tm/ltm/node in 1",
+		"PATCH /mgmt/tm/transaction/1",
+		"POST /mgmt/tm/util/bash",
+	}, dev.requests)
+}
+
+func TestTransactionConcurrentOperations(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	// Both operations queue their write before either of them ends
+	var queued, done sync.WaitGroup
+	queued.Add(2)
+	errs := make([]error, 2)
+	for i, name := range []string{"/Common/web", "/Common/app"} {
+		done.Add(1)
+		go func(i int, name string) {
+			defer done.Done()
+			opClient, op := newTransactionOperation(client)
+			_, errs[i] = op.run(func() error {
+				err := postEntity(opClient, map[string]string{"name": name, "address": "10.1.1.1"}, "ltm", "node")
+				queued.Done()
+				queued.Wait()
+				if err == nil && name == "/Common/app" {
+					err = errors.New("invalid monitor")
+				}
+				return err
+			})
+		}(i, name)
+	}
+	done.Wait()
+	assert.Nil(t, errs[0])
+	assert.EqualError(t, errs[1], "invalid monitor")
+	assert.Contains(t, objects, "/mgmt/tm/ltm/node/~Common~web")
+	assert.NotContains(t, objects, "/mgmt/tm/ltm/node/~Common~app", "the write of the failed operation is not committed by the other one")
+	assert.Contains(t, dev.requests, "POST /mgmt/tm/ltm/node in 1")
+	assert.Contains(t, dev.requests, "POST /mgmt/tm/ltm/node in 2")
+	assert.Empty(t, dev.queues)
+}
+
+func TestTransactionDiscardedOnError(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	opClient, op := newTransactionOperation(client)
+	applied, err := op.run(func() error {
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/web", "address": "10.1.1.1"}, "ltm", "node"))
+		return errors.New("invalid profile")
+	})
+	assert.EqualError(t, err, "invalid profile")
+	assert.False(t, applied)
+	assert.Empty(t, objects)
+	assert.Equal(t, "DELETE /mgmt/tm/transaction/1", dev.requests[len(dev.requests)-1])
+}
+
+func TestTransactionCommitFailure(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	dev.refused = "/mgmt/tm/ltm/pool/~Common~web/members"
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	opClient, op := newTransactionOperation(client)
+	applied, err := op.run(func() error {
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/web"}, "ltm", "pool"))
+		assert.Nil(t, postEntity(opClient, map[string]string{"name": "/Common/10.1.1.1:80"}, "ltm", "pool", "/Common/web", "members"))
+		return nil
+	})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "transaction 1 failed, none of its changes were applied")
+		assert.Contains(t, err.Error(), "/mgmt/tm/ltm/pool/~Common~web/members refused")
+	}
+	assert.False(t, applied)
+	assert.Empty(t, objects, "the pool is not left without its member")
+}
+
+func TestInTransactionsForgetsFailedCreate(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	dev.refused = "/mgmt/tm/ltm/node"
+	mux.Handle("/mgmt/tm/", dev)
+
+	client := bigip.NewSession(server.URL, "xxx", "xxx", nil)
+	enableTransactions(client)
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Required: true}},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			name := d.Get("name").(string)
+			if err := postEntity(meta.(*bigip.BigIP), map[string]string{"name": name, "address": "10.1.1.1"}, "ltm", "node"); err != nil {
+				return err
+			}
+			d.SetId(name)
+			return nil
+		},
+	}
+	inTransactions(r)
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "/Common/web"})
+	assert.NotNil(t, r.Create(d, client))
+	assert.Equal(t, "", d.Id(), "a create that applied nothing is not kept in the state")
+}
+
+func TestAccBigipTransactions(t *testing.T) {
+	objects := map[string]map[string]interface{}{}
+	setup()
+	defer teardown()
+	dev := newTransactionDevice(objects)
+	mux.HandleFunc("/mgmt/tm/net/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/failover-status", failoverStatusHandler("ACTIVE"))
+	mux.Handle("/mgmt/tm/", dev)
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bigip_sys_partition" "tenant" {
+						name = "Tenant"
+					}
+					provider "bigip" {
+						address = "%s"
+						username = "xxxx"
+						password = "xxxx"
+						transactions = true
+					}
+				`, server.URL),
+				Check: resource.TestCheckResourceAttr("bigip_sys_partition.tenant", "id", "Tenant"),
+			},
+		},
+	})
+	assert.Contains(t, dev.requests, "POST /mgmt/tm/auth/partition in 1")
+	assert.Contains(t, dev.requests, "DELETE /mgmt/tm/auth/partition/Tenant in 2")
+	assert.Empty(t, objects)
+}
+
+func TestTransactionalPaths(t *testing.T) {
+	assert.True(t, isTransactionalPath("/mgmt/tm/ltm/virtual"))
+	assert.True(t, isTransactionalPath("/mgmt/tm/sys/ntp"))
+	for _, path := range []string{"/mgmt/tm/util/bash", "/mgmt/tm/sys/config", "/mgmt/tm/asm/policies", "/mgmt/shared/appsvcs/declare", "/mgmt/tm/transaction"} {
+		assert.False(t, isTransactionalPath(path), "%s can't be queued", path)
+	}
+}
@@ -7,9 +7,13 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -56,9 +60,9 @@ func validateF5Name(value interface{}, field string) (ws []string, errors []erro
 	}
 
 	for _, v := range values {
-		match, _ := regexp.MatchString("^/[\\w_\\-.]+/[\\w_\\-.]+$", v)
+		match, _ := regexp.MatchString("^(/[\\w_\\-.]+){2,}$", v)
 		if !match {
-			errors = append(errors, fmt.Errorf("%q must match /Partition/Name and contain letters, numbers or [._-]. e.g. /Common/my-pool", field))
+			errors = append(errors, fmt.Errorf("%q must match /Partition/Name or /Partition/Folder/Name and contain letters, numbers or [._-]. e.g. /Common/my-pool", field))
 		}
 	}
 	return
@@ -84,9 +88,9 @@ func validatePoolMemberName(value interface{}, field string) (ws []string, error
 	}
 
 	for _, v := range values {
-		match, _ := regexp.MatchString("^\\/[\\w_\\-.]+\\/[\\w_\\-.]+:\\d+$", v)
+		match, _ := regexp.MatchString("^\\/[\\w_\\-.]+\\/[\\w_\\-.]+(%\\d+)?:\\d+$", v)
 		if !match {
-			errors = append(errors, fmt.Errorf("%q must match /Partition/Node_Name:Port and contain letters, numbers or [._-]. e.g. /Common/node1:80", field))
+			errors = append(errors, fmt.Errorf("%q must match /Partition/Node_Name:Port and contain letters, numbers or [._-], the node name may end with a route domain id. e.g. /Common/node1:80 or /Common/10.1.1.1%%2:80", field))
 		}
 	}
 	return
@@ -166,3 +170,187 @@ func validateDataGroupType(value interface{}, field string) (ws []string, errors
 	}
 	return
 }
+
+// validateGtmPoolMemberName validates a member of a GTM pool, a virtual server or, for CNAME pools, a DNS name
+func validateGtmPoolMemberName(value interface{}, field string) (ws []string, errors []error) {
+	var values []string
+	switch value.(type) {
+	case *schema.Set:
+		values = setToStringSlice(value.(*schema.Set))
+	case string:
+		values = []string{value.(string)}
+	default:
+		errors = append(errors, fmt.Errorf("Unknown type %v in validateGtmPoolMemberName", reflect.TypeOf(value)))
+	}
+
+	for _, v := range values {
+		match, _ := regexp.MatchString("^\\/[\\w_\\-.]+\\/[\\w_\\-.]+:[\\w_\\-.]+$", v)
+		if !match && !isGtmCnameTarget(v) {
+			errors = append(errors, fmt.Errorf("%q must match /Partition/Server_Name:Virtual_Server_Name and contain letters, numbers or [._-]. e.g. /Common/server1:vs1, or be a DNS name for CNAME pools", field))
+		}
+	}
+	return
+}
+
+// isGtmCnameTarget reports whether a GTM pool member is a DNS name, the members of CNAME pools
+func isGtmCnameTarget(member string) bool {
+	match, _ := regexp.MatchString("^[A-Za-z0-9_]([A-Za-z0-9_\\-]*\\.)+[A-Za-z0-9_\\-]+\\.?$", member)
+	return match
+}
+
+// gtmWideipFqdn matches the FQDN a wide IP answers for, which may contain the wildcards * (any characters)
+// and ? (any one character), e.g. *.example.com or app?.example.com
+const gtmWideipFqdn = "[\\w\\-*?]+(\\.[\\w\\-*?]+)+"
+
+// validateGtmWideipName validates the full path of a wide IP, /Partition/FQDN where the FQDN may contain wildcards
+func validateGtmWideipName(value interface{}, field string) (ws []string, errors []error) {
+	match, _ := regexp.MatchString("^/[\\w_\\-.]+/"+gtmWideipFqdn+"$", value.(string))
+	if !match {
+		errors = append(errors, fmt.Errorf("%q must match /Partition/FQDN, where the FQDN contains letters, numbers, [_-] or the wildcards * and ?. e.g. /Common/*.example.com", field))
+	}
+	return
+}
+
+// validateGtmWideipAlias validates an alias of a wide IP, a FQDN that may contain wildcards
+func validateGtmWideipAlias(value interface{}, field string) (ws []string, errors []error) {
+	match, _ := regexp.MatchString("^"+gtmWideipFqdn+"$", value.(string))
+	if !match {
+		errors = append(errors, fmt.Errorf("%q must be a FQDN that contains letters, numbers, [_-] or the wildcards * and ?. e.g. *.example.com", field))
+	}
+	return
+}
+
+// validatePortLockdown validates an allow-service entry of a SelfIP
+func validatePortLockdown(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^(all|default|[a-z0-9-]+:\d+)$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be all, default or <protocol>:<port>, e.g. tcp:22, got %q", k, value))
+	}
+	return
+}
+
+// validateWafPolicyJSON validates a declarative WAF policy, a JSON object with a policy object
+func validateWafPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
+	var policy struct {
+		Policy map[string]interface{} `json:"policy"`
+	}
+	if err := json.Unmarshal([]byte(v.(string)), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not valid JSON: %s", k, err))
+	} else if policy.Policy == nil {
+		errors = append(errors, fmt.Errorf("%q has no policy object, it is not a declarative WAF policy", k))
+	}
+	return
+}
+
+// validateAs3JSON validates an AS3 declaration, a JSON object of the ADC class, or of the AS3 class wrapping one
+func validateAs3JSON(v interface{}, k string) (ws []string, errors []error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not valid JSON: %s", k, err))
+	} else if as3ADC(declaration) == nil {
+		errors = append(errors, fmt.Errorf("%q is neither of the ADC nor of the AS3 class, it is not an AS3 declaration", k))
+	}
+	return
+}
+
+// validateDoJSON validates a DO declaration, a JSON object of the Device class, or of the DO class wrapping one
+func validateDoJSON(v interface{}, k string) (ws []string, errors []error) {
+	var declaration struct {
+		Class       string `json:"class"`
+		Declaration struct {
+			Class string `json:"class"`
+		} `json:"declaration"`
+	}
+	if err := json.Unmarshal([]byte(v.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not valid JSON: %s", k, err))
+	} else if declaration.Class != "Device" && (declaration.Class != "DO" || declaration.Declaration.Class != "Device") {
+		errors = append(errors, fmt.Errorf("%q is neither of the Device nor of the DO class, it is not a DO declaration", k))
+	}
+	return
+}
+
+// validateMACAddress validates a MAC address of six colon separated bytes, e.g. 00:50:56:8a:12:34
+func validateMACAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^([0-9a-fA-F]{1,2}:){5}[0-9a-fA-F]{1,2}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a MAC address of six colon separated bytes, e.g. 00:50:56:8a:12:34, got %q", k, value))
+	}
+	return
+}
+
+// validateAddressWithPrefix validates an IPv4 or IPv6 address with its prefix length, e.g. 10.1.1.5/24
+func validateAddressWithPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an address with its prefix length, e.g. 10.1.1.5/24, got %q", k, value))
+	}
+	return
+}
+
+// validateRegexp validates a regular expression
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := regexp.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a regular expression, got %q: %s", k, value, err))
+	}
+	return
+}
+
+// validateNamedName validates the name of a ZoneRunner view or zone, it is part of file names and commands
+func validateNamedName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if match, _ := regexp.MatchString(`^[\w.-]+$`, value); !match {
+		errors = append(errors, fmt.Errorf("%q must contain letters, numbers or [._-], got %q", k, value))
+	}
+	return
+}
+
+// validateNamedElement validates an element of a list of named.conf, e.g. 10.0.0.0/8, !10.1.1.1 or key tsig-key
+func validateNamedElement(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || strings.ContainsAny(value, ";{}\"'#\n") {
+		errors = append(errors, fmt.Errorf("%q elements must not be empty nor contain ; { } \" ' # or a newline, got %q", k, value))
+	}
+	return
+}
+
+// validatePartitionName validates the name of a partition other than Common, e.g. Tenant
+func validatePartitionName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if match, _ := regexp.MatchString(`^[A-Za-z][\w.-]*$`, value); !match {
+		errors = append(errors, fmt.Errorf("%q must start with a letter and contain letters, numbers or [._-], got %q", k, value))
+	} else if value == "Common" {
+		errors = append(errors, fmt.Errorf("%q must not be Common, it always exists", k))
+	}
+	return
+}
+
+// validateTsJSON validates a Telemetry Streaming declaration, a JSON object of the Telemetry class
+func validateTsJSON(v interface{}, k string) (ws []string, errors []error) {
+	var declaration struct {
+		Class string `json:"class"`
+	}
+	if err := json.Unmarshal([]byte(v.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not valid JSON: %s", k, err))
+	} else if declaration.Class != "Telemetry" {
+		errors = append(errors, fmt.Errorf("%q is not of the Telemetry class, it is not a TS declaration", k))
+	}
+	return
+}
+
+// validateJSONObject validates a JSON object, e.g. the parameters of a template
+func validateJSONObject(v interface{}, k string) (ws []string, errors []error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &object); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid JSON object: %s", k, err))
+	}
+	return
+}
+
+// validateBase64 validates base64 encoded content, e.g. the one of filebase64() for a binary file
+func validateBase64(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not base64 encoded: %s", k, err))
+	}
+	return
+}
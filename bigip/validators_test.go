@@ -27,10 +27,13 @@ func TestF5NameString(t *testing.T) {
 	data := map[string]int{
 		"/Common/foo":                           0,
 		"/My-Partition_name/object-name_string": 0,
+		"/Tenant/app1/foo":                      0,
+		"/Tenant/app1/sub.folder/foo":           0,
 		"Common/foo":                            1,
 		"/Common/foo/":                          1,
 		"foo":                                   1,
 		"//":                                    1,
+		"/Tenant//foo":                          1,
 		"/":                                     1,
 	}
 	for d, ec := range data {
@@ -100,3 +103,233 @@ func TestValidateReqPrefDisabledString(t *testing.T) {
 		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
 	}
 }
+
+func TestValidateMACAddress(t *testing.T) {
+	data := map[string]int{
+		"00:50:56:8a:12:34": 0,
+		"0:50:56:8A:12:34":  0,
+		"00-50-56-8a-12-34": 1,
+		"00:50:56:8a:12":    1,
+		"00:50:56:8a:12:zz": 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateMACAddress(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateGtmPoolMemberName(t *testing.T) {
+	data := map[string]int{
+		"/Common/server1:vs1": 0,
+		"app.example.com":     0,
+		"app.example.com.":    0,
+		"server1:vs1":         1,
+		"server1":             1,
+		"/Common/server1":     1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateGtmPoolMemberName(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateGtmWideipName(t *testing.T) {
+	data := map[string]int{
+		"/Common/www.example.com":  0,
+		"/Common/*.example.com":    0,
+		"/Common/app?.example.com": 0,
+		"/Common/www":              1,
+		"/Common/*..example.com":   1,
+		"*.example.com":            1,
+		"/Common/www.example.com.": 1,
+		"/Common/www example.com":  1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateGtmWideipName(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateGtmWideipAlias(t *testing.T) {
+	data := map[string]int{
+		"www.example.com":         0,
+		"*.example.com":           0,
+		"tenant-??.example.com":   0,
+		"/Common/www.example.com": 1,
+		"www":                     1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateGtmWideipAlias(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateAddressWithPrefix(t *testing.T) {
+	data := map[string]int{
+		"10.1.1.5/24":    0,
+		"2001:db8::5/64": 0,
+		"10.1.1.5":       1,
+		"10.1.1.5/33":    1,
+		"mgmt/24":        1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateAddressWithPrefix(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateRegexp(t *testing.T) {
+	data := map[string]int{
+		"":             0,
+		"^value true$": 0,
+		"Syntax Error": 0,
+		"value (true":  1,
+		"[a-":          1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateRegexp(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateNamedName(t *testing.T) {
+	data := map[string]int{
+		"internal":    0,
+		"example.com": 0,
+		"my_view-2":   0,
+		"":            1,
+		"a b":         1,
+		"x;rm -rf":    1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateNamedName(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateNamedElement(t *testing.T) {
+	data := map[string]int{
+		"10.0.0.0/8":   0,
+		"!10.1.1.1":    0,
+		"key tsig-key": 0,
+		"any":          0,
+		"":             1,
+		"any; }; x {":  1,
+		"'quoted'":     1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateNamedElement(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidatePartitionName(t *testing.T) {
+	data := map[string]int{
+		"Tenant":    0,
+		"tenant_01": 0,
+		"Common":    1,
+		"/Tenant":   1,
+		"1tenant":   1,
+		"":          1,
+	}
+
+	for d, ec := range data {
+		_, errs := validatePartitionName(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateAs3JSON(t *testing.T) {
+	data := map[string]int{
+		`{"class":"ADC","schemaVersion":"3.0.0","Tenant":{"class":"Tenant"}}`:   0,
+		`{"class":"AS3","declaration":{"class":"ADC","schemaVersion":"3.0.0"}}`: 0,
+		`{"class":"AS3","action":"deploy"}`:                                     1,
+		`{"class":"Tenant"}`:                                                    1,
+		`{"class":`:                                                             1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateAs3JSON(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateDoJSON(t *testing.T) {
+	data := map[string]int{
+		`{"class":"Device","schemaVersion":"1.0.0","Common":{"class":"Tenant"}}`:  0,
+		`{"class":"DO","declaration":{"class":"Device","schemaVersion":"1.0.0"}}`: 0,
+		`{"class":"DO","declaration":{"class":"ADC"}}`:                            1,
+		`{"class":"ADC","schemaVersion":"3.0.0"}`:                                 1,
+		`{"class":`: 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateDoJSON(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateTsJSON(t *testing.T) {
+	data := map[string]int{
+		`{"class":"Telemetry","My_Consumer":{"class":"Telemetry_Consumer","type":"default"}}`: 0,
+		`{"class":"Telemetry"}`:                      0,
+		`{"class":"Device","schemaVersion":"1.0.0"}`: 1,
+		`{"class":`: 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateTsJSON(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateJSONObject(t *testing.T) {
+	data := map[string]int{
+		`{"tenant_name":"Tenant","app_name":"web","virtual_port":443}`: 0,
+		`{}`:              0,
+		`["Tenant"]`:      1,
+		`{"tenant_name":`: 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateJSONObject(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateBase64(t *testing.T) {
+	data := map[string]int{
+		"MIIJqQIBAzCCCW8GCSqGSIb3DQEHAaCCCWAEgglcMIIJWDCCBA8GCSqGSIb3DQEHBqCCBAAwggP8AgEA": 0,
+		"YWJj":            0,
+		"YWJj=":           1,
+		"-----BEGIN PKCS": 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateBase64(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidatePoolMemberName(t *testing.T) {
+	data := map[string]int{
+		"/Common/node1:80":       0,
+		"/Common/10.1.1.1%2:443": 0,
+		"/Common/10.1.1.1%:443":  1,
+		"/Common/node1":          1,
+		"node1:80":               1,
+	}
+
+	for d, ec := range data {
+		_, errs := validatePoolMemberName(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/f5devcentral/go-bigip"
+)
+
+// ZoneRunner has no REST API, it edits the configuration of the local BIND, named, which runs chrooted in
+// namedChroot. The zonerunner resources do the same through util/bash, editing their own statements only.
+const (
+	namedChroot  = "/var/named"
+	namedConf    = "/config/named.conf"
+	namedZoneDir = "/config/namedb"
+	// Echoed by the scripts changing the configuration once all their commands succeeded
+	namedDone = "zonerunner-done"
+)
+
+// Serializes the edits of named.conf, each of them reads, changes and writes back the whole file
+var namedConfLock sync.Mutex
+
+// namedStatement is a statement of named.conf, e.g. view "external" { ... };, located by offsets in the text
+type namedStatement struct {
+	// Words before the braces, e.g. view and external, without quotes
+	words []string
+	// The statement is text[start:end], end is after its semicolon
+	start, end int
+	// The body of the braces is text[bodyStart:bodyEnd], -1 when the statement has none
+	bodyStart, bodyEnd int
+}
+
+func (s *namedStatement) word(i int) string {
+	if i < len(s.words) {
+		return s.words[i]
+	}
+	return ""
+}
+
+// parseNamedStatements returns the statements of text[from:to], the whole file or the body of a statement
+func parseNamedStatements(text string, from, to int) ([]namedStatement, error) {
+	var statements []namedStatement
+	var s *namedStatement
+	depth := 0
+	for i := from; i < to; i++ {
+		c := text[i]
+		switch {
+		case c == '#' || strings.HasPrefix(text[i:to], "//"):
+			for i < to && text[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(text[i:to], "/*"):
+			end := strings.Index(text[i+2:to], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment in named.conf")
+			}
+			i += end + 3
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			continue
+		}
+		if s == nil {
+			s = &namedStatement{start: i, bodyStart: -1, bodyEnd: -1}
+		}
+		switch c {
+		case '"':
+			end := strings.IndexByte(text[i+1:to], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string in named.conf")
+			}
+			if depth == 0 && s.bodyStart < 0 {
+				s.words = append(s.words, text[i+1:i+1+end])
+			}
+			i += end + 1
+		case '{':
+			if depth == 0 && s.bodyStart < 0 {
+				s.bodyStart = i + 1
+			}
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced braces in named.conf")
+			}
+			if depth == 0 && s.bodyEnd < 0 {
+				s.bodyEnd = i
+			}
+		case ';':
+			if depth == 0 {
+				s.end = i + 1
+				statements = append(statements, *s)
+				s = nil
+			}
+		default:
+			j := i
+			for j < to && !strings.ContainsRune(" \t\r\n{};\"", rune(text[j])) {
+				j++
+			}
+			if depth == 0 && s.bodyStart < 0 {
+				s.words = append(s.words, text[i:j])
+			}
+			i = j - 1
+		}
+	}
+	if s != nil || depth != 0 {
+		return nil, fmt.Errorf("unterminated statement in named.conf")
+	}
+	return statements, nil
+}
+
+// findNamedStatement returns the statement with the keyword and name, zone names compared without their
+// trailing dot, or nil
+func findNamedStatement(statements []namedStatement, keyword, name string) *namedStatement {
+	for i := range statements {
+		s := &statements[i]
+		if s.word(0) == keyword && strings.TrimSuffix(s.word(1), ".") == strings.TrimSuffix(name, ".") {
+			return s
+		}
+	}
+	return nil
+}
+
+// namedList returns the elements of the list of a statement, e.g. 10.1.1.1 and key tsig of
+// allow-transfer { 10.1.1.1; key "tsig"; };
+func namedList(text string, s *namedStatement) ([]string, error) {
+	if s == nil || s.bodyStart < 0 {
+		return nil, nil
+	}
+	elements, err := parseNamedStatements(text, s.bodyStart, s.bodyEnd)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]string, 0, len(elements))
+	for _, e := range elements {
+		list = append(list, strings.Join(e.words, " "))
+	}
+	return list, nil
+}
+
+// namedListStatement returns a list statement, e.g. allow-transfer { 10.1.1.1; };
+func namedListStatement(keyword string, values []string) string {
+	var b strings.Builder
+	b.WriteString(keyword + " {")
+	for _, v := range values {
+		b.WriteString(" " + v + ";")
+	}
+	b.WriteString(" };")
+	return b.String()
+}
+
+// removeNamedStatement returns text without the statement and the end of its line
+func removeNamedStatement(text string, s *namedStatement) string {
+	end := s.end
+	if end < len(text) && text[end] == '\n' {
+		end++
+	}
+	start := s.start
+	for start > 0 && (text[start-1] == ' ' || text[start-1] == '\t') {
+		start--
+	}
+	return text[:start] + text[end:]
+}
+
+// namedZoneFile is the file of a zone, relative to namedZoneDir, named the way ZoneRunner names it
+func namedZoneFile(view, zone string) string {
+	return fmt.Sprintf("db.%s.%s.", view, strings.TrimSuffix(zone, "."))
+}
+
+// runNamedScript runs a shell script changing the configuration of named, failing with its output unless
+// all its commands succeeded
+func runNamedScript(client *bigip.BigIP, script string) error {
+	out, err := runBash(client, script+" && echo "+namedDone)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(out, namedDone) {
+		return fmt.Errorf("%s", strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// readNamedConf returns named.conf
+func readNamedConf(client *bigip.BigIP) (string, error) {
+	path := namedChroot + namedConf
+	out, err := runBash(client, "cat "+path)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(out, "cat: ") {
+		return "", fmt.Errorf("Error reading %s: %s", path, strings.TrimSpace(out))
+	}
+	return out, nil
+}
+
+// updateNamedConf applies edit to named.conf, checks the result with named-checkconf before replacing the
+// file, then reloads named
+func updateNamedConf(client *bigip.BigIP, edit func(conf string) (string, error)) error {
+	namedConfLock.Lock()
+	defer namedConfLock.Unlock()
+
+	conf, err := readNamedConf(client)
+	if err != nil {
+		return err
+	}
+	conf, err = edit(conf)
+	if err != nil {
+		return err
+	}
+	path := namedChroot + namedConf
+	err = runNamedScript(client, fmt.Sprintf(
+		"echo %s | base64 -d > %s.new && named-checkconf -t %s %s.new 2>&1 && chown named:named %s.new && mv -f %s.new %s && rndc reload >/dev/null 2>&1 || { rm -f %s.new; false; }",
+		base64.StdEncoding.EncodeToString([]byte(conf)), path, namedChroot, namedConf, path, path, path, path))
+	if err != nil {
+		return fmt.Errorf("Error writing %s: %s", path, err)
+	}
+	return nil
+}
+
+// writeNamedZoneFile writes the file of a zone, checked with named-checkzone before replacing the file
+func writeNamedZoneFile(client *bigip.BigIP, zone, file, content string) error {
+	path := namedChroot + namedZoneDir + "/" + file
+	err := runNamedScript(client, fmt.Sprintf(
+		"echo %s | base64 -d > %s.new && named-checkzone %s %s.new 2>&1 && chown named:named %s.new && mv -f %s.new %s || { rm -f %s.new; false; }",
+		base64.StdEncoding.EncodeToString([]byte(content)), path, zone, path, path, path, path, path))
+	if err != nil {
+		return fmt.Errorf("Error writing zone file %s: %s", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The named.conf of a device with the ZoneRunner defaults
+const testNamedConf = `// named.conf written by ZoneRunner
+options {
+	listen-on port 53 { 127.0.0.1; };
+	directory "/config/namedb";
+	/* no recursion { for clients; } */
+	recursion no;
+};
+view "external" {
+	match-clients { "any"; };
+	zone "." IN {
+		type hint;
+		file "named.root";
+	};
+	# zone "0.0.127.in-addr.arpa." is not served
+};
+`
+
+// namedHandler answers util/bash like a device, keeping named.conf and the zone files in files by path
+func namedHandler(t *testing.T, files map[string]string) http.HandlerFunc {
+	write := regexp.MustCompile(`^echo (\S+) \| base64 -d > (\S+)\.new `)
+	remove := regexp.MustCompile(`^rm -f (\S+) `)
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		b, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		script := strings.TrimSuffix(strings.TrimPrefix(body["utilCmdArgs"], "-c '"), "'")
+		var out string
+		if strings.HasPrefix(script, "cat ") {
+			path := strings.TrimPrefix(script, "cat ")
+			var ok bool
+			if out, ok = files[path]; !ok {
+				out = "cat: " + path + ": No such file or directory\n"
+			}
+		} else if m := write.FindStringSubmatch(script); m != nil {
+			content, err := base64.StdEncoding.DecodeString(m[1])
+			assert.Nil(t, err)
+			files[m[2]] = string(content)
+			out = namedDone + "\n"
+		} else if m := remove.FindStringSubmatch(script); m != nil {
+			delete(files, m[1])
+			out = namedDone + "\n"
+		}
+		res, _ := json.Marshal(map[string]string{"command": "run", "commandResult": out})
+		w.Write(res)
+	}
+}
+
+func TestParseNamedStatements(t *testing.T) {
+	statements, err := parseNamedStatements(testNamedConf, 0, len(testNamedConf))
+	if !assert.Nil(t, err) || !assert.Len(t, statements, 2) {
+		return
+	}
+	assert.Equal(t, []string{"options"}, statements[0].words)
+	view := findNamedStatement(statements, "view", "external")
+	if !assert.NotNil(t, view) {
+		return
+	}
+	assert.Equal(t, "view \"external\" {", testNamedConf[view.start:view.bodyStart])
+	assert.Equal(t, "};", testNamedConf[view.bodyEnd:view.end])
+
+	body, err := parseNamedStatements(testNamedConf, view.bodyStart, view.bodyEnd)
+	if !assert.Nil(t, err) || !assert.Len(t, body, 2) {
+		return
+	}
+	clients, err := namedList(testNamedConf, findNamedStatement(body, "match-clients", ""))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"any"}, clients)
+	assert.NotNil(t, findNamedStatement(body, "zone", "."))
+
+	for _, text := range []string{"view \"a\" {", "view a { };\n}", "zone \"a {};", "/* view"} {
+		_, err = parseNamedStatements(text, 0, len(text))
+		assert.NotNil(t, err, "%s is not valid", text)
+	}
+}
+
+func TestNamedListStatement(t *testing.T) {
+	assert.Equal(t, "allow-transfer { 10.1.1.1; key tsig; };", namedListStatement("allow-transfer", []string{"10.1.1.1", "key tsig"}))
+	text := `allow-transfer { 10.1.1.1; key "tsig"; };`
+	statements, _ := parseNamedStatements(text, 0, len(text))
+	list, err := namedList(text, &statements[0])
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.1.1.1", "key tsig"}, list)
+}
@@ -11,9 +11,9 @@ provider "bigip" {
 }
 
 
-// tenant_name is used to set the identity of as3 resource which is unique for resource.
+// The tenant deployed, and the id of the resource, is the one of the declaration, Sample_new.
+// Set tenant_name to deploy one of the tenants of a declaration declaring several.
 resource "bigip_as3"  "as3-example1" {
      as3_json = "${file("example1.json")}" 
-     tenant_name = "as3"
  }
 
@@ -8,6 +8,7 @@ module github.com/terraform-providers/terraform-provider-bigip
 require (
 	github.com/f5devcentral/go-bigip v0.0.0-20190813232614-cb399c531a76
 	github.com/hashicorp/go-hclog v0.7.0 // indirect
+	github.com/hashicorp/go-uuid v1.0.1
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/hashicorp/hil v0.0.0-20190212132231-97b3a9cdfa93 // indirect
 	github.com/hashicorp/terraform v0.12.0